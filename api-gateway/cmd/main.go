@@ -17,6 +17,7 @@ import (
 	"github.com/amirhasanpour/task-manager/api-gateway/internal/tracing"
 	"github.com/amirhasanpour/task-manager/api-gateway/pkg/logger"
 	"github.com/amirhasanpour/task-manager/api-gateway/pkg/metrics"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
@@ -47,6 +48,7 @@ func main() {
 		zap.String("version", "1.0.0"),
 		zap.String("environment", os.Getenv("APP_ENV")),
 	)
+	log.Debug("Configuration loaded", zap.Any("config", cfg.Redacted()))
 
 	// Initialize tracing
 	ctx := context.Background()
@@ -70,9 +72,11 @@ func main() {
 
 	// Initialize gRPC clients
 	userClient, err := client.NewUserClient(client.UserConfig{
-		Host:    cfg.Services.User.Host,
-		Port:    cfg.Services.User.Port,
-		Timeout: cfg.Services.User.Timeout,
+		Host:      cfg.Services.User.Host,
+		Port:      cfg.Services.User.Port,
+		Timeout:   cfg.Services.User.Timeout,
+		AuthToken: cfg.Services.User.AuthToken,
+		Retry:     client.RetryConfig(cfg.Services.User.Retry),
 	})
 	if err != nil {
 		log.Error("Failed to create user client", zap.Error(err))
@@ -81,9 +85,11 @@ func main() {
 	defer userClient.Close()
 
 	todoClient, err := client.NewTodoClient(client.TodoConfig{
-		Host:    cfg.Services.Todo.Host,
-		Port:    cfg.Services.Todo.Port,
-		Timeout: cfg.Services.Todo.Timeout,
+		Host:      cfg.Services.Todo.Host,
+		Port:      cfg.Services.Todo.Port,
+		Timeout:   cfg.Services.Todo.Timeout,
+		AuthToken: cfg.Services.Todo.AuthToken,
+		Retry:     client.RetryConfig(cfg.Services.Todo.Retry),
 	})
 	if err != nil {
 		log.Error("Failed to create todo client", zap.Error(err))
@@ -92,15 +98,38 @@ func main() {
 	defer todoClient.Close()
 
 	// Initialize handlers
-	healthHandler := handler.NewHealthHandler()
+	healthHandler := handler.NewHealthHandler(userClient, todoClient, cfg.Health.Timeout)
 	authHandler := handler.NewAuthHandler(userClient)
-	userHandler := handler.NewUserHandler(userClient)
+	userHandler := handler.NewUserHandler(userClient, todoClient, cfg.Admin.HardDeleteEnabled)
 	taskHandler := handler.NewTaskHandler(todoClient)
+	webhookHandler := handler.NewWebhookHandler(todoClient)
+	adminHandler := handler.NewAdminHandler(config.Sanitize(cfg))
+	exportHandler := handler.NewExportHandler(userClient, todoClient, cfg.Export.BatchSize, cfg.Export.MaxSelected)
+	calendarHandler := handler.NewCalendarHandler(userClient, todoClient, cfg.Calendar.BatchSize)
+	feedTokenHandler := handler.NewFeedTokenHandler(userClient)
 
 	// Initialize middleware
-	loggingMiddleware := middleware.NewLoggingMiddleware()
+	loggingMiddleware := middleware.NewLoggingMiddleware(cfg.Logging.SlowRequestThreshold, cfg.Logging.SkipPaths)
 	metricsMiddleware := middleware.NewMetricsMiddleware(metricsCollector)
 	authMiddleware := middleware.NewAuthMiddleware(userClient, cfg.JWT.Secret)
+	if cfg.Redis.Enabled {
+		redisClient := redis.NewClient(&redis.Options{
+			Addr:     fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+		defer redisClient.Close()
+		authMiddleware.SetDenylist(middleware.NewRedisTokenDenylist(redisClient))
+	}
+
+	var rateLimiter *middleware.RateLimiter
+	if cfg.RateLimit.Enabled {
+		rateLimiter = middleware.NewRateLimiter(map[string]middleware.RateLimit{
+			middleware.RateLimitGroupAuth:   {RequestsPerMinute: cfg.RateLimit.Auth.RequestsPerMinute, Burst: cfg.RateLimit.Auth.Burst},
+			middleware.RateLimitGroupReads:  {RequestsPerMinute: cfg.RateLimit.Reads.RequestsPerMinute, Burst: cfg.RateLimit.Reads.Burst},
+			middleware.RateLimitGroupWrites: {RequestsPerMinute: cfg.RateLimit.Writes.RequestsPerMinute, Burst: cfg.RateLimit.Writes.Burst},
+		})
+	}
 
 	// Create router
 	ginRouter := router.NewRouter(router.Config{
@@ -108,6 +137,11 @@ func main() {
 		UserHandler:       userHandler,
 		AuthHandler:       authHandler,
 		TaskHandler:       taskHandler,
+		WebhookHandler:    webhookHandler,
+		AdminHandler:      adminHandler,
+		ExportHandler:     exportHandler,
+		CalendarHandler:   calendarHandler,
+		FeedTokenHandler:  feedTokenHandler,
 		HealthHandler:     healthHandler,
 		LoggingMiddleware: loggingMiddleware,
 		MetricsMiddleware: metricsMiddleware,
@@ -121,6 +155,11 @@ func main() {
 		},
 		SwaggerEnabled: cfg.Swagger.Enabled,
 		SwaggerPath:    cfg.Swagger.Path,
+		AdminConfigEnabled: cfg.Admin.ConfigEndpointEnabled,
+		ExportEnabled:      cfg.Export.Enabled,
+		CalendarEnabled:    cfg.Calendar.Enabled,
+		DetailedHealthEnabled: cfg.Health.DetailedEnabled,
+		RateLimiter:           rateLimiter,
 	})
 
 	// Create HTTP server
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/viper"
@@ -10,12 +11,18 @@ import (
 type Config struct {
 	Server   ServerConfig
 	Services ServicesConfig
+	Redis    RedisConfig
 	JWT      JWTConfig
 	Logging  LoggingConfig
 	Metrics  MetricsConfig
 	OTel     OTelConfig
 	CORS     CORSConfig
 	Swagger  SwaggerConfig
+	Admin    AdminConfig
+	Export   ExportConfig
+	Calendar CalendarConfig
+	Health   HealthConfig
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
 }
 
 type ServerConfig struct {
@@ -30,14 +37,39 @@ type ServicesConfig struct {
 }
 
 type ServiceConfig struct {
-	Host    string
-	Port    int
-	Timeout time.Duration
+	Host      string
+	Port      int
+	Timeout   time.Duration
+	AuthToken string `mapstructure:"auth_token" sensitive:"true"`
+	Retry     RetryConfig
+}
+
+// RetryConfig retries calls that fail with UNAVAILABLE with exponential
+// backoff. MaxAttempts includes the first call; below 2 disables retries.
+type RetryConfig struct {
+	MaxAttempts       int           `mapstructure:"max_attempts"`
+	InitialBackoff    time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff        time.Duration `mapstructure:"max_backoff"`
+	BackoffMultiplier float64       `mapstructure:"backoff_multiplier"`
+}
+
+// RedisConfig points at the Redis the user service keeps its token
+// denylist in. When enabled, tokens revoked by logout are rejected here too.
+type RedisConfig struct {
+	Enabled  bool
+	Host     string
+	Port     int
+	Password string `sensitive:"true"`
+	DB       int
 }
 
 type JWTConfig struct {
-	Secret        string
+	Secret        string `sensitive:"true"`
 	TokenLifetime time.Duration
+	// MinSecretLength is the shortest secret, in bytes, Validate accepts.
+	MinSecretLength int `mapstructure:"min_secret_length"`
+	// MinSecretDistinct is how many distinct characters the secret must use.
+	MinSecretDistinct int `mapstructure:"min_secret_distinct"`
 }
 
 type LoggingConfig struct {
@@ -45,6 +77,9 @@ type LoggingConfig struct {
 	Encoding        string
 	OutputPaths     []string
 	ErrorOutputPaths []string
+	SlowRequestThreshold time.Duration `mapstructure:"slow_request_threshold"`
+	// SkipPaths are request paths that are only logged on server errors.
+	SkipPaths []string `mapstructure:"skip_paths"`
 }
 
 type MetricsConfig struct {
@@ -70,6 +105,52 @@ type SwaggerConfig struct {
 	APIPath string
 }
 
+// AdminConfig switches on the operator-only endpoints.
+type AdminConfig struct {
+	ConfigEndpointEnabled bool `mapstructure:"config_endpoint_enabled"`
+	// HardDeleteEnabled allows DELETE /users/:id?hard=true to erase a user
+	// together with all of their data.
+	HardDeleteEnabled bool `mapstructure:"hard_delete_enabled"`
+}
+
+// CalendarConfig controls the iCalendar feed of due tasks.
+type CalendarConfig struct {
+	Enabled   bool
+	BatchSize int `mapstructure:"batch_size"`
+}
+
+// ExportConfig controls the personal data and selected task export
+// endpoints. MaxSelected caps how many ids one selected export may name.
+type ExportConfig struct {
+	Enabled     bool
+	BatchSize   int `mapstructure:"batch_size"`
+	MaxSelected int `mapstructure:"max_selected"`
+}
+
+// HealthConfig controls the detailed health endpoint. Timeout bounds each
+// backend probe so one hung dependency cannot stall the whole report.
+type HealthConfig struct {
+	DetailedEnabled bool          `mapstructure:"detailed_enabled"`
+	Timeout         time.Duration
+}
+
+// RateLimitConfig gives each endpoint group its own token buckets, one per
+// user (or per IP before login). Auth and writes are kept stricter than
+// reads.
+type RateLimitConfig struct {
+	Enabled bool
+	Auth    RateLimitGroupConfig
+	Reads   RateLimitGroupConfig
+	Writes  RateLimitGroupConfig
+}
+
+// RateLimitGroupConfig allows Burst requests at once, refilled at
+// RequestsPerMinute. Zero requests per minute leaves the group unlimited.
+type RateLimitGroupConfig struct {
+	RequestsPerMinute int `mapstructure:"requests_per_minute"`
+	Burst             int
+}
+
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -95,6 +176,9 @@ func LoadConfig() (*Config, error) {
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("error unmarshaling config: %w", err)
 	}
+	if err := config.Validate(os.Getenv("APP_ENV")); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
@@ -107,18 +191,38 @@ func setDefaults() {
 	viper.SetDefault("services.user.host", "user-service")
 	viper.SetDefault("services.user.port", 50051)
 	viper.SetDefault("services.user.timeout", "5s")
+	viper.SetDefault("services.user.auth_token", "")
+	viper.SetDefault("services.user.retry.max_attempts", 3)
+	viper.SetDefault("services.user.retry.initial_backoff", "100ms")
+	viper.SetDefault("services.user.retry.max_backoff", "1s")
+	viper.SetDefault("services.user.retry.backoff_multiplier", 2)
 
 	viper.SetDefault("services.todo.host", "todo-service")
 	viper.SetDefault("services.todo.port", 50052)
 	viper.SetDefault("services.todo.timeout", "5s")
+	viper.SetDefault("services.todo.auth_token", "")
+	viper.SetDefault("services.todo.retry.max_attempts", 3)
+	viper.SetDefault("services.todo.retry.initial_backoff", "100ms")
+	viper.SetDefault("services.todo.retry.max_backoff", "1s")
+	viper.SetDefault("services.todo.retry.backoff_multiplier", 2)
+
+	viper.SetDefault("redis.enabled", false)
+	viper.SetDefault("redis.host", "redis")
+	viper.SetDefault("redis.port", 6379)
+	viper.SetDefault("redis.password", "")
+	viper.SetDefault("redis.db", 0)
 
-	viper.SetDefault("jwt.secret", "your-super-secret-jwt-key-change-in-production")
+	viper.SetDefault("jwt.secret", DefaultJWTSecret)
 	viper.SetDefault("jwt.token_lifetime", "24h")
+	viper.SetDefault("jwt.min_secret_length", 32)
+	viper.SetDefault("jwt.min_secret_distinct", 10)
 
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.encoding", "json")
 	viper.SetDefault("logging.output_paths", []string{"stdout"})
 	viper.SetDefault("logging.error_output_paths", []string{"stderr"})
+	viper.SetDefault("logging.slow_request_threshold", "1s")
+	viper.SetDefault("logging.skip_paths", []string{"/metrics", "/api/v1/health", "/api/v1/ready"})
 
 	viper.SetDefault("metrics.port", 9091)
 
@@ -134,4 +238,23 @@ func setDefaults() {
 	viper.SetDefault("swagger.enabled", true)
 	viper.SetDefault("swagger.path", "/swagger/*")
 	viper.SetDefault("swagger.api_path", "/swagger/api.json")
+
+	viper.SetDefault("admin.config_endpoint_enabled", false)
+	viper.SetDefault("admin.hard_delete_enabled", false)
+
+	viper.SetDefault("export.enabled", true)
+	viper.SetDefault("export.batch_size", 100)
+	viper.SetDefault("export.max_selected", 100)
+	viper.SetDefault("calendar.enabled", true)
+	viper.SetDefault("calendar.batch_size", 100)
+	viper.SetDefault("health.detailed_enabled", true)
+	viper.SetDefault("health.timeout", "2s")
+
+	viper.SetDefault("rate_limit.enabled", true)
+	viper.SetDefault("rate_limit.auth.requests_per_minute", 10)
+	viper.SetDefault("rate_limit.auth.burst", 5)
+	viper.SetDefault("rate_limit.reads.requests_per_minute", 300)
+	viper.SetDefault("rate_limit.reads.burst", 60)
+	viper.SetDefault("rate_limit.writes.requests_per_minute", 60)
+	viper.SetDefault("rate_limit.writes.burst", 20)
 }
\ No newline at end of file
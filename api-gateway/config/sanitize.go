@@ -0,0 +1,45 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Sanitize returns the redacted config as a nested map keyed the way viper
+// reads it.
+func Sanitize(cfg *Config) map[string]any {
+	return structToMap(reflect.ValueOf(cfg.Redacted()).Elem())
+}
+
+func structToMap(v reflect.Value) map[string]any {
+	t := v.Type()
+	out := make(map[string]any, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		out[configKey(field)] = mapValue(v.Field(i))
+	}
+	return out
+}
+
+func mapValue(v reflect.Value) any {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+	if v.Kind() == reflect.Struct {
+		return structToMap(v)
+	}
+	return v.Interface()
+}
+
+// configKey mirrors viper's key matching: the mapstructure tag when present,
+// otherwise the lower-cased field name.
+func configKey(field reflect.StructField) string {
+	if tag := field.Tag.Get("mapstructure"); tag != "" {
+		return tag
+	}
+	return strings.ToLower(field.Name)
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultJWTSecret is the placeholder jwt.secret shipped for local
+// development. It must never sign tokens in production.
+const DefaultJWTSecret = "your-super-secret-jwt-key-change-in-production"
+
+// InsecureJWTSecretError is returned by Validate when the JWT secret is too
+// short or too repetitive to sign tokens with, or is the placeholder in
+// production.
+type InsecureJWTSecretError struct {
+	Reason string
+}
+
+func (e *InsecureJWTSecretError) Error() string {
+	return fmt.Sprintf("insecure jwt.secret: %s", e.Reason)
+}
+
+// IsProduction reports whether env, the value of APP_ENV, names a
+// production deployment.
+func IsProduction(env string) bool {
+	switch strings.ToLower(env) {
+	case "production", "prod":
+		return true
+	}
+	return false
+}
+
+// Validate checks the JWT secret against the configured minimum length
+// and number of distinct characters, a crude guard against low-entropy
+// secrets such as a repeated word. When env names a production deployment
+// it also rejects the development placeholder.
+func (c *Config) Validate(env string) error {
+	secret := c.JWT.Secret
+
+	if IsProduction(env) && secret == DefaultJWTSecret {
+		return &InsecureJWTSecretError{Reason: fmt.Sprintf("is the development default, which APP_ENV=%s does not allow", env)}
+	}
+	if len(secret) < c.JWT.MinSecretLength {
+		return &InsecureJWTSecretError{Reason: fmt.Sprintf("is %d bytes, shorter than the minimum of %d", len(secret), c.JWT.MinSecretLength)}
+	}
+	if distinct := distinctBytes(secret); distinct < c.JWT.MinSecretDistinct {
+		return &InsecureJWTSecretError{Reason: fmt.Sprintf("uses %d distinct characters, fewer than the minimum of %d", distinct, c.JWT.MinSecretDistinct)}
+	}
+	return nil
+}
+
+func distinctBytes(s string) int {
+	var seen [256]bool
+	count := 0
+	for i := 0; i < len(s); i++ {
+		if !seen[s[i]] {
+			seen[s[i]] = true
+			count++
+		}
+	}
+	return count
+}
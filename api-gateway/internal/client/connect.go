@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// waitForReady starts connecting conn and waits up to timeout for it to
+// become ready, so a backend that is down fails startup instead of the
+// first request. A timeout of zero skips the check.
+func waitForReady(conn *grpc.ClientConn, timeout time.Duration) error {
+	if timeout <= 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection not ready after %s (last state %s)", timeout, state)
+		}
+	}
+}
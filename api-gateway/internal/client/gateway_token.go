@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// gatewayTokenMetadataKey must match the key the backend auth interceptors
+// look for.
+const gatewayTokenMetadataKey = "x-gateway-token"
+
+// gatewayTokenInterceptor attaches the shared gateway token to every call so
+// backends with authentication enabled accept it.
+func gatewayTokenInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, gatewayTokenMetadataKey, token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
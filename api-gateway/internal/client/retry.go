@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// RetryConfig retries calls that fail with UNAVAILABLE, waiting a random
+// time up to InitialBackoff, then growing by BackoffMultiplier up to
+// MaxBackoff. MaxAttempts counts the first call; below 2 disables retries.
+type RetryConfig struct {
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+}
+
+// dialOptions are the options both backend clients connect with.
+func dialOptions(timeout time.Duration, authToken string, retry RetryConfig) []grpc.DialOption {
+	interceptors := []grpc.UnaryClientInterceptor{timeoutInterceptor(timeout), requestIDInterceptor()}
+	if authToken != "" {
+		interceptors = append(interceptors, gatewayTokenInterceptor(authToken))
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(interceptors...),
+	}
+	if retry.MaxAttempts > 1 {
+		opts = append(opts, grpc.WithDefaultServiceConfig(retryServiceConfig(retry)))
+	}
+	return opts
+}
+
+// timeoutInterceptor bounds every call, retries included, by timeout unless
+// the caller's context already ends sooner.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// retryServiceConfig applies retry to every method of the connection.
+func retryServiceConfig(retry RetryConfig) string {
+	type retryPolicy struct {
+		MaxAttempts          int      `json:"maxAttempts"`
+		InitialBackoff       string   `json:"initialBackoff"`
+		MaxBackoff           string   `json:"maxBackoff"`
+		BackoffMultiplier    float64  `json:"backoffMultiplier"`
+		RetryableStatusCodes []string `json:"retryableStatusCodes"`
+	}
+	type methodConfig struct {
+		Name        []struct{}  `json:"name"`
+		RetryPolicy retryPolicy `json:"retryPolicy"`
+	}
+
+	config := struct {
+		MethodConfig []methodConfig `json:"methodConfig"`
+	}{
+		MethodConfig: []methodConfig{{
+			Name: []struct{}{{}},
+			RetryPolicy: retryPolicy{
+				MaxAttempts:          retry.MaxAttempts,
+				InitialBackoff:       durationString(retry.InitialBackoff),
+				MaxBackoff:           durationString(retry.MaxBackoff),
+				BackoffMultiplier:    retry.BackoffMultiplier,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}},
+	}
+
+	data, _ := json.Marshal(config)
+	return string(data)
+}
+
+// durationString formats d the way service configs expect, e.g. "0.1s".
+func durationString(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
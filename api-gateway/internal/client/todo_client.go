@@ -11,43 +11,85 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type TodoClient interface {
 	CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error)
 	GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error)
+	GetTaskByUser(ctx context.Context, req *pb.GetTaskByUserRequest) (*pb.GetTaskResponse, error)
+	GetTasksByIDs(ctx context.Context, req *pb.GetTasksByIDsRequest) (*pb.GetTasksByIDsResponse, error)
 	UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error)
+	CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error)
+	ArchiveTask(ctx context.Context, req *pb.ArchiveTaskRequest) (*pb.ArchiveTaskResponse, error)
+	UnarchiveTask(ctx context.Context, req *pb.UnarchiveTaskRequest) (*pb.UnarchiveTaskResponse, error)
 	DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error)
+	DeleteTaskByUser(ctx context.Context, req *pb.DeleteTaskByUserRequest) (*pb.DeleteTaskResponse, error)
+	RestoreTask(ctx context.Context, req *pb.RestoreTaskRequest) (*pb.RestoreTaskResponse, error)
+	PurgeTask(ctx context.Context, req *pb.PurgeTaskRequest) (*pb.DeleteTaskResponse, error)
+	RestoreAllTasks(ctx context.Context, req *pb.RestoreAllTasksRequest) (*pb.RestoreAllTasksResponse, error)
+	MoveTaskOnBoard(ctx context.Context, req *pb.MoveTaskOnBoardRequest) (*pb.MoveTaskOnBoardResponse, error)
+	ReorderTask(ctx context.Context, req *pb.ReorderTaskRequest) (*pb.ReorderTaskResponse, error)
+	BulkSetDueDate(ctx context.Context, req *pb.BulkSetDueDateRequest) (*pb.BulkSetDueDateResponse, error)
+	BulkAddTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error)
+	BulkRemoveTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error)
 	ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error)
 	ListTasksByUser(ctx context.Context, req *pb.ListTasksByUserRequest) (*pb.ListTasksByUserResponse, error)
+	ListTasksModifiedBy(ctx context.Context, req *pb.ListTasksModifiedByRequest) (*pb.ListTasksModifiedByResponse, error)
+	ListOverdueTasks(ctx context.Context, req *pb.ListOverdueTasksRequest) (*pb.ListOverdueTasksResponse, error)
+	ListDeletedTasks(ctx context.Context, req *pb.ListDeletedTasksRequest) (*pb.ListDeletedTasksResponse, error)
+	SnoozeOverdue(ctx context.Context, req *pb.SnoozeOverdueRequest) (*pb.SnoozeOverdueResponse, error)
+	DeleteCompletedTasks(ctx context.Context, req *pb.DeleteCompletedTasksRequest) (*pb.DeleteCompletedTasksResponse, error)
+	SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error)
+	MarkAllSeen(ctx context.Context, req *pb.MarkAllSeenRequest) (*pb.MarkAllSeenResponse, error)
+	GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error)
+	GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.GetBoardResponse, error)
+	CountTasks(ctx context.Context, req *pb.CountTasksRequest) (*pb.CountTasksResponse, error)
+	GetTaskMatrix(ctx context.Context, req *pb.GetTaskMatrixRequest) (*pb.GetTaskMatrixResponse, error)
+	GetTaskStats(ctx context.Context, req *pb.GetTaskStatsRequest) (*pb.GetTaskStatsResponse, error)
+	GetCompletionTrends(ctx context.Context, req *pb.GetCompletionTrendsRequest) (*pb.GetCompletionTrendsResponse, error)
+	GetUserTaskMetrics(ctx context.Context, req *pb.GetUserTaskMetricsRequest) (*pb.GetUserTaskMetricsResponse, error)
+	ListTasksNeedingAttention(ctx context.Context, req *pb.ListTasksNeedingAttentionRequest) (*pb.ListTasksNeedingAttentionResponse, error)
+	PreviewRecurrence(ctx context.Context, req *pb.PreviewRecurrenceRequest) (*pb.PreviewRecurrenceResponse, error)
+	PurgeUserData(ctx context.Context, req *pb.PurgeUserDataRequest) (*pb.PurgeUserDataResponse, error)
+	CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error)
+	GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error)
+	ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error)
+	GetHealthDetails(ctx context.Context, req *pb.GetTodoHealthDetailsRequest) (*pb.GetTodoHealthDetailsResponse, error)
+	CheckHealth(ctx context.Context) error
 	Close() error
 }
 
 type todoClient struct {
-	conn   *grpc.ClientConn
-	client pb.TodoServiceClient
-	logger *zap.Logger
-	tracer trace.Tracer
+	conn     *grpc.ClientConn
+	client   pb.TodoServiceClient
+	webhooks pb.WebhookServiceClient
+	health   grpc_health_v1.HealthClient
+	logger   *zap.Logger
+	tracer   trace.Tracer
 }
 
 type TodoConfig struct {
-	Host    string
-	Port    int
-	Timeout time.Duration
+	Host      string
+	Port      int
+	Timeout   time.Duration
+	AuthToken string
+	Retry     RetryConfig
 }
 
 func NewTodoClient(cfg TodoConfig) (TodoClient, error) {
 	address := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	
-	conn, err := grpc.NewClient(
-		address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithTimeout(cfg.Timeout),
-	)
+	conn, err := grpc.NewClient(address, dialOptions(cfg.Timeout, cfg.AuthToken, cfg.Retry)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to todo service: %w", err)
 	}
+	if err := waitForReady(conn, cfg.Timeout); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to todo service at %s: %w", address, err)
+	}
 
 	client := pb.NewTodoServiceClient(conn)
 	
@@ -55,10 +97,12 @@ func NewTodoClient(cfg TodoConfig) (TodoClient, error) {
 	logger.Info("Connected to todo service", zap.String("address", address))
 
 	return &todoClient{
-		conn:   conn,
-		client: client,
-		logger: logger,
-		tracer: otel.Tracer("todo-client"),
+		conn:     conn,
+		client:   client,
+		webhooks: pb.NewWebhookServiceClient(conn),
+		health:   grpc_health_v1.NewHealthClient(conn),
+		logger:   logger,
+		tracer:   otel.Tracer("todo-client"),
 	}, nil
 }
 
@@ -83,6 +127,27 @@ func (c *todoClient) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.G
 	return c.client.GetTask(ctx, req)
 }
 
+func (c *todoClient) GetTaskByUser(ctx context.Context, req *pb.GetTaskByUserRequest) (*pb.GetTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetTaskByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Getting task by user", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.GetTaskByUser(ctx, req)
+}
+
+func (c *todoClient) GetTasksByIDs(ctx context.Context, req *pb.GetTasksByIDsRequest) (*pb.GetTasksByIDsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetTasksByIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("task.count", len(req.Ids)))
+	c.logger.Debug("Getting tasks by IDs", zap.Int("count", len(req.Ids)))
+	return c.client.GetTasksByIDs(ctx, req)
+}
+
 func (c *todoClient) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	ctx, span := c.tracer.Start(ctx, "TodoClient.UpdateTask")
 	defer span.End()
@@ -104,6 +169,154 @@ func (c *todoClient) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 	return c.client.DeleteTask(ctx, req)
 }
 
+func (c *todoClient) DeleteTaskByUser(ctx context.Context, req *pb.DeleteTaskByUserRequest) (*pb.DeleteTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.DeleteTaskByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Deleting task by user", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.DeleteTaskByUser(ctx, req)
+}
+
+func (c *todoClient) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.CompleteTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Completing task", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.CompleteTask(ctx, req)
+}
+
+func (c *todoClient) ArchiveTask(ctx context.Context, req *pb.ArchiveTaskRequest) (*pb.ArchiveTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ArchiveTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Archiving task", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.ArchiveTask(ctx, req)
+}
+
+func (c *todoClient) UnarchiveTask(ctx context.Context, req *pb.UnarchiveTaskRequest) (*pb.UnarchiveTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.UnarchiveTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Unarchiving task", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.UnarchiveTask(ctx, req)
+}
+
+func (c *todoClient) RestoreTask(ctx context.Context, req *pb.RestoreTaskRequest) (*pb.RestoreTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.RestoreTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Restoring task", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.RestoreTask(ctx, req)
+}
+
+func (c *todoClient) PurgeTask(ctx context.Context, req *pb.PurgeTaskRequest) (*pb.DeleteTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.PurgeTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Purging task", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return c.client.PurgeTask(ctx, req)
+}
+
+func (c *todoClient) RestoreAllTasks(ctx context.Context, req *pb.RestoreAllTasksRequest) (*pb.RestoreAllTasksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.RestoreAllTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Restoring all deleted tasks", zap.String("user_id", req.UserId))
+	return c.client.RestoreAllTasks(ctx, req)
+}
+
+func (c *todoClient) ReorderTask(ctx context.Context, req *pb.ReorderTaskRequest) (*pb.ReorderTaskResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ReorderTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Reordering task",
+		zap.String("id", req.Id),
+		zap.Int32("position", req.Position),
+	)
+	return c.client.ReorderTask(ctx, req)
+}
+
+func (c *todoClient) MoveTaskOnBoard(ctx context.Context, req *pb.MoveTaskOnBoardRequest) (*pb.MoveTaskOnBoardResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.MoveTaskOnBoard")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+	c.logger.Debug("Moving task on board",
+		zap.String("id", req.Id),
+		zap.String("status", req.Status.String()),
+		zap.Int32("position", req.Position),
+	)
+	return c.client.MoveTaskOnBoard(ctx, req)
+}
+
+func (c *todoClient) BulkSetDueDate(ctx context.Context, req *pb.BulkSetDueDateRequest) (*pb.BulkSetDueDateResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.BulkSetDueDate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("task.count", len(req.Ids)),
+	)
+	c.logger.Debug("Setting due dates in bulk", zap.String("user_id", req.UserId), zap.Int("count", len(req.Ids)))
+	return c.client.BulkSetDueDate(ctx, req)
+}
+
+func (c *todoClient) BulkAddTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.BulkAddTags")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("task.count", len(req.Ids)),
+	)
+	c.logger.Debug("Adding tags in bulk", zap.String("user_id", req.UserId), zap.Int("count", len(req.Ids)))
+	return c.client.BulkAddTags(ctx, req)
+}
+
+func (c *todoClient) BulkRemoveTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.BulkRemoveTags")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("task.count", len(req.Ids)),
+	)
+	c.logger.Debug("Removing tags in bulk", zap.String("user_id", req.UserId), zap.Int("count", len(req.Ids)))
+	return c.client.BulkRemoveTags(ctx, req)
+}
+
 func (c *todoClient) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
 	ctx, span := c.tracer.Start(ctx, "TodoClient.ListTasks")
 	defer span.End()
@@ -138,6 +351,236 @@ func (c *todoClient) ListTasksByUser(ctx context.Context, req *pb.ListTasksByUse
 	return c.client.ListTasksByUser(ctx, req)
 }
 
+func (c *todoClient) ListTasksModifiedBy(ctx context.Context, req *pb.ListTasksModifiedByRequest) (*pb.ListTasksModifiedByResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ListTasksModifiedBy")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Listing tasks by last modifier", zap.String("user_id", req.UserId))
+	return c.client.ListTasksModifiedBy(ctx, req)
+}
+
+func (c *todoClient) ListOverdueTasks(ctx context.Context, req *pb.ListOverdueTasksRequest) (*pb.ListOverdueTasksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ListOverdueTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Listing overdue tasks", zap.String("user_id", req.UserId))
+	return c.client.ListOverdueTasks(ctx, req)
+}
+
+func (c *todoClient) ListDeletedTasks(ctx context.Context, req *pb.ListDeletedTasksRequest) (*pb.ListDeletedTasksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ListDeletedTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Listing deleted tasks", zap.String("user_id", req.UserId))
+	return c.client.ListDeletedTasks(ctx, req)
+}
+
+func (c *todoClient) SnoozeOverdue(ctx context.Context, req *pb.SnoozeOverdueRequest) (*pb.SnoozeOverdueResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.SnoozeOverdue")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Snoozing overdue tasks", zap.String("user_id", req.UserId), zap.Int64("duration_seconds", req.DurationSeconds))
+	return c.client.SnoozeOverdue(ctx, req)
+}
+
+func (c *todoClient) DeleteCompletedTasks(ctx context.Context, req *pb.DeleteCompletedTasksRequest) (*pb.DeleteCompletedTasksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.DeleteCompletedTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Deleting completed tasks", zap.String("user_id", req.UserId))
+	return c.client.DeleteCompletedTasks(ctx, req)
+}
+
+func (c *todoClient) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.SearchTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Searching tasks", zap.String("user_id", req.UserId))
+	return c.client.SearchTasks(ctx, req)
+}
+
+func (c *todoClient) MarkAllSeen(ctx context.Context, req *pb.MarkAllSeenRequest) (*pb.MarkAllSeenResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.MarkAllSeen")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Marking all tasks as seen", zap.String("user_id", req.UserId))
+	return c.client.MarkAllSeen(ctx, req)
+}
+
+func (c *todoClient) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetUnreadCount")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Counting unread tasks", zap.String("user_id", req.UserId))
+	return c.client.GetUnreadCount(ctx, req)
+}
+
+func (c *todoClient) CountTasks(ctx context.Context, req *pb.CountTasksRequest) (*pb.CountTasksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.CountTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Counting tasks", zap.String("user_id", req.UserId))
+	return c.client.CountTasks(ctx, req)
+}
+
+func (c *todoClient) GetTaskMatrix(ctx context.Context, req *pb.GetTaskMatrixRequest) (*pb.GetTaskMatrixResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetTaskMatrix")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Getting task matrix", zap.String("user_id", req.UserId))
+	return c.client.GetTaskMatrix(ctx, req)
+}
+
+func (c *todoClient) GetTaskStats(ctx context.Context, req *pb.GetTaskStatsRequest) (*pb.GetTaskStatsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetTaskStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Getting task stats", zap.String("user_id", req.UserId))
+	return c.client.GetTaskStats(ctx, req)
+}
+
+func (c *todoClient) GetCompletionTrends(ctx context.Context, req *pb.GetCompletionTrendsRequest) (*pb.GetCompletionTrendsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetCompletionTrends")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Getting completion trends",
+		zap.String("user_id", req.UserId),
+		zap.String("granularity", req.Granularity),
+	)
+	return c.client.GetCompletionTrends(ctx, req)
+}
+
+func (c *todoClient) GetUserTaskMetrics(ctx context.Context, req *pb.GetUserTaskMetricsRequest) (*pb.GetUserTaskMetricsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetUserTaskMetrics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Getting user task metrics", zap.String("user_id", req.UserId))
+	return c.client.GetUserTaskMetrics(ctx, req)
+}
+
+func (c *todoClient) ListTasksNeedingAttention(ctx context.Context, req *pb.ListTasksNeedingAttentionRequest) (*pb.ListTasksNeedingAttentionResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ListTasksNeedingAttention")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Listing tasks needing attention", zap.String("user_id", req.UserId))
+	return c.client.ListTasksNeedingAttention(ctx, req)
+}
+
+func (c *todoClient) PreviewRecurrence(ctx context.Context, req *pb.PreviewRecurrenceRequest) (*pb.PreviewRecurrenceResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.PreviewRecurrence")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("recurrence.rule", req.Rule))
+	c.logger.Debug("Previewing recurrence", zap.String("rule", req.Rule))
+	return c.client.PreviewRecurrence(ctx, req)
+}
+
+func (c *todoClient) PurgeUserData(ctx context.Context, req *pb.PurgeUserDataRequest) (*pb.PurgeUserDataResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.PurgeUserData")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Purging user data", zap.String("user_id", req.UserId))
+	return c.client.PurgeUserData(ctx, req)
+}
+
+func (c *todoClient) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.GetBoardResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetBoard")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("per_column", int(req.PerColumn)),
+	)
+	c.logger.Debug("Getting task board", 
+		zap.String("user_id", req.UserId),
+		zap.Int32("per_column", req.PerColumn),
+	)
+	return c.client.GetBoard(ctx, req)
+}
+
+func (c *todoClient) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.CreateWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Creating webhook", zap.String("user_id", req.UserId))
+	return c.webhooks.CreateWebhook(ctx, req)
+}
+
+func (c *todoClient) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.id", req.Id))
+	c.logger.Debug("Getting webhook", zap.String("id", req.Id))
+	return c.webhooks.GetWebhook(ctx, req)
+}
+
+func (c *todoClient) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.UpdateWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.id", req.Id))
+	c.logger.Debug("Updating webhook", zap.String("id", req.Id))
+	return c.webhooks.UpdateWebhook(ctx, req)
+}
+
+func (c *todoClient) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.DeleteWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.id", req.Id))
+	c.logger.Debug("Deleting webhook", zap.String("id", req.Id))
+	return c.webhooks.DeleteWebhook(ctx, req)
+}
+
+func (c *todoClient) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.ListWebhooks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+	c.logger.Debug("Listing webhooks", zap.String("user_id", req.UserId))
+	return c.webhooks.ListWebhooks(ctx, req)
+}
+
+func (c *todoClient) GetHealthDetails(ctx context.Context, req *pb.GetTodoHealthDetailsRequest) (*pb.GetTodoHealthDetailsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.GetHealthDetails")
+	defer span.End()
+
+	return c.client.GetHealthDetails(ctx, req)
+}
+
+// CheckHealth asks the standard gRPC health service whether the todo-service
+// is serving.
+func (c *todoClient) CheckHealth(ctx context.Context) error {
+	ctx, span := c.tracer.Start(ctx, "TodoClient.CheckHealth")
+	defer span.End()
+
+	resp, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "todo-service"})
+	if err != nil {
+		return err
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("todo-service is %s", resp.Status)
+	}
+	return nil
+}
+
 func (c *todoClient) Close() error {
 	c.logger.Info("Closing todo client connection")
 	return c.conn.Close()
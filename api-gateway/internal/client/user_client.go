@@ -11,28 +11,43 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // UserClient interface defines the methods for user service client
 type UserClient interface {
 	CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error)
 	GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error)
+	GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.GetUserResponse, error)
+	GetUserByEmail(ctx context.Context, req *pb.GetUserByEmailRequest) (*pb.GetUserResponse, error)
 	UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error)
 	ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error)
+	BatchGetPublicProfiles(ctx context.Context, req *pb.BatchGetPublicProfilesRequest) (*pb.BatchGetPublicProfilesResponse, error)
 	Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error)
 	Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error)
+	RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error)
+	Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error)
 	ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error)
+	RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error)
+	CreateFeedToken(ctx context.Context, req *pb.CreateFeedTokenRequest) (*pb.CreateFeedTokenResponse, error)
+	ListFeedTokens(ctx context.Context, req *pb.ListFeedTokensRequest) (*pb.ListFeedTokensResponse, error)
+	RevokeFeedToken(ctx context.Context, req *pb.RevokeFeedTokenRequest) (*pb.RevokeFeedTokenResponse, error)
+	ValidateFeedToken(ctx context.Context, req *pb.ValidateFeedTokenRequest) (*pb.ValidateFeedTokenResponse, error)
+	GetHealthDetails(ctx context.Context, req *pb.GetUserHealthDetailsRequest) (*pb.GetUserHealthDetailsResponse, error)
+	CheckHealth(ctx context.Context) error
 	Close() error
 }
 
 // userClientImpl is the actual implementation
 type userClientImpl struct {
-	conn   *grpc.ClientConn
-	client pb.UserServiceClient
-	logger *zap.Logger
-	tracer trace.Tracer
+	conn       *grpc.ClientConn
+	client     pb.UserServiceClient
+	feedTokens pb.FeedTokenServiceClient
+	health     grpc_health_v1.HealthClient
+	logger     *zap.Logger
+	tracer     trace.Tracer
 }
 
 // UserClientImpl is the exported type
@@ -41,22 +56,24 @@ type UserClientImpl struct {
 }
 
 type UserConfig struct {
-	Host    string
-	Port    int
-	Timeout time.Duration
+	Host      string
+	Port      int
+	Timeout   time.Duration
+	AuthToken string
+	Retry     RetryConfig
 }
 
 func NewUserClient(cfg UserConfig) (UserClient, error) {
 	address := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	
-	conn, err := grpc.NewClient(
-		address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithTimeout(cfg.Timeout),
-	)
+	conn, err := grpc.NewClient(address, dialOptions(cfg.Timeout, cfg.AuthToken, cfg.Retry)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to user service: %w", err)
 	}
+	if err := waitForReady(conn, cfg.Timeout); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to user service at %s: %w", address, err)
+	}
 
 	client := pb.NewUserServiceClient(conn)
 	
@@ -64,10 +81,12 @@ func NewUserClient(cfg UserConfig) (UserClient, error) {
 	logger.Info("Connected to user service", zap.String("address", address))
 
 	impl := &userClientImpl{
-		conn:   conn,
-		client: client,
-		logger: logger,
-		tracer: otel.Tracer("user-client"),
+		conn:       conn,
+		client:     client,
+		feedTokens: pb.NewFeedTokenServiceClient(conn),
+		logger:     logger,
+		health:     grpc_health_v1.NewHealthClient(conn),
+		tracer:     otel.Tracer("user-client"),
 	}
 
 	return &UserClientImpl{impl}, nil
@@ -95,6 +114,24 @@ func (c *userClientImpl) GetUser(ctx context.Context, req *pb.GetUserRequest) (*
 	return c.client.GetUser(ctx, req)
 }
 
+func (c *userClientImpl) GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.GetUserResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.GetUserByUsername")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.username", req.Username))
+	c.logger.Debug("Getting user by username", zap.String("username", req.Username))
+	return c.client.GetUserByUsername(ctx, req)
+}
+
+func (c *userClientImpl) GetUserByEmail(ctx context.Context, req *pb.GetUserByEmailRequest) (*pb.GetUserResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.GetUserByEmail")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.email", req.Email))
+	c.logger.Debug("Getting user by email", zap.String("email", req.Email))
+	return c.client.GetUserByEmail(ctx, req)
+}
+
 func (c *userClientImpl) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	ctx, span := c.tracer.Start(ctx, "UserClient.UpdateUser")
 	defer span.End()
@@ -146,6 +183,86 @@ func (c *userClientImpl) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 	return c.client.Login(ctx, req)
 }
 
+func (c *userClientImpl) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.RefreshToken")
+	defer span.End()
+
+	c.logger.Debug("Refreshing access token")
+	return c.client.RefreshToken(ctx, req)
+}
+
+func (c *userClientImpl) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.Logout")
+	defer span.End()
+
+	c.logger.Debug("Logging out")
+	return c.client.Logout(ctx, req)
+}
+
+func (c *userClientImpl) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.RequestPasswordReset")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.email", req.Email))
+	c.logger.Debug("Requesting password reset", zap.String("email", req.Email))
+	return c.client.RequestPasswordReset(ctx, req)
+}
+
+func (c *userClientImpl) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.ResetPassword")
+	defer span.End()
+
+	c.logger.Debug("Resetting password")
+	return c.client.ResetPassword(ctx, req)
+}
+
+func (c *userClientImpl) CreateFeedToken(ctx context.Context, req *pb.CreateFeedTokenRequest) (*pb.CreateFeedTokenResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.CreateFeedToken")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	c.logger.Debug("Creating feed token", zap.String("user_id", req.UserId))
+	return c.feedTokens.CreateFeedToken(ctx, req)
+}
+
+func (c *userClientImpl) ListFeedTokens(ctx context.Context, req *pb.ListFeedTokensRequest) (*pb.ListFeedTokensResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.ListFeedTokens")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	c.logger.Debug("Listing feed tokens", zap.String("user_id", req.UserId))
+	return c.feedTokens.ListFeedTokens(ctx, req)
+}
+
+func (c *userClientImpl) RevokeFeedToken(ctx context.Context, req *pb.RevokeFeedTokenRequest) (*pb.RevokeFeedTokenResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.RevokeFeedToken")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("feed_token.id", req.Id))
+
+	c.logger.Debug("Revoking feed token", zap.String("id", req.Id))
+	return c.feedTokens.RevokeFeedToken(ctx, req)
+}
+
+func (c *userClientImpl) ValidateFeedToken(ctx context.Context, req *pb.ValidateFeedTokenRequest) (*pb.ValidateFeedTokenResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.ValidateFeedToken")
+	defer span.End()
+
+	c.logger.Debug("Validating feed token")
+	return c.feedTokens.ValidateFeedToken(ctx, req)
+}
+
+func (c *userClientImpl) BatchGetPublicProfiles(ctx context.Context, req *pb.BatchGetPublicProfilesRequest) (*pb.BatchGetPublicProfilesResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.BatchGetPublicProfiles")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("ids.count", len(req.Ids)))
+	c.logger.Debug("Getting public profiles", zap.Int("count", len(req.Ids)))
+	return c.client.BatchGetPublicProfiles(ctx, req)
+}
+
 func (c *userClientImpl) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
 	ctx, span := c.tracer.Start(ctx, "UserClient.ValidateToken")
 	defer span.End()
@@ -154,6 +271,29 @@ func (c *userClientImpl) ValidateToken(ctx context.Context, req *pb.ValidateToke
 	return c.client.ValidateToken(ctx, req)
 }
 
+func (c *userClientImpl) GetHealthDetails(ctx context.Context, req *pb.GetUserHealthDetailsRequest) (*pb.GetUserHealthDetailsResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "UserClient.GetHealthDetails")
+	defer span.End()
+
+	return c.client.GetHealthDetails(ctx, req)
+}
+
+// CheckHealth asks the standard gRPC health service whether the user-service
+// is serving.
+func (c *userClientImpl) CheckHealth(ctx context.Context) error {
+	ctx, span := c.tracer.Start(ctx, "UserClient.CheckHealth")
+	defer span.End()
+
+	resp, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "user-service"})
+	if err != nil {
+		return err
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("user-service is %s", resp.Status)
+	}
+	return nil
+}
+
 func (c *userClientImpl) Close() error {
 	c.logger.Info("Closing user client connection")
 	return c.conn.Close()
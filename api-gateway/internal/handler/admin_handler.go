@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type AdminHandler struct {
+	effectiveConfig map[string]any
+	logger          *zap.Logger
+}
+
+// NewAdminHandler takes the already sanitized configuration; the handler
+// only serves it.
+func NewAdminHandler(effectiveConfig map[string]any) *AdminHandler {
+	return &AdminHandler{
+		effectiveConfig: effectiveConfig,
+		logger:          zap.L().Named("admin_handler"),
+	}
+}
+
+func (h *AdminHandler) GetConfig(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	h.logger.Info("Effective configuration requested", zap.Any("user_id", userID))
+
+	c.JSON(http.StatusOK, h.effectiveConfig)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
 	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthHandler struct {
@@ -41,14 +43,19 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	resp, err := h.userClient.Register(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to register user", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		if status.Code(err) == codes.ResourceExhausted {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Account temporarily locked"})
+			return
+		}
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
 	// Convert response
 	authResp := AuthResponse{
-		User:  userProtoToResponse(resp.User),
-		Token: resp.Token,
+		User:         userProtoToResponse(resp.User),
+		Token:        resp.Token,
+		RefreshToken: resp.RefreshToken,
 	}
 
 	h.logger.Info("User registered successfully", zap.String("user_id", resp.User.Id))
@@ -73,20 +80,128 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	resp, err := h.userClient.Login(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to login user", zap.Error(err), zap.String("email", req.Email))
+		if status.Code(err) == codes.ResourceExhausted {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Account temporarily locked"})
+			return
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
 		return
 	}
 
 	// Convert response
 	authResp := AuthResponse{
-		User:  userProtoToResponse(resp.User),
-		Token: resp.Token,
+		User:         userProtoToResponse(resp.User),
+		Token:        resp.Token,
+		RefreshToken: resp.RefreshToken,
 	}
 
 	h.logger.Info("User logged in successfully", zap.String("user_id", resp.User.Id))
 	c.JSON(http.StatusOK, authResp)
 }
 
+// RefreshToken trades a refresh token for a new access token.
+func (h *AuthHandler) RefreshToken(c *gin.Context) {
+	var req RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid refresh token request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call user service
+	resp, err := h.userClient.RefreshToken(c.Request.Context(), &pb.RefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to refresh token", zap.Error(err))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, RefreshTokenResponse{Token: resp.Token})
+}
+
+// Logout revokes the caller's access token and, if given, their refresh
+// token.
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req LogoutRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Debug("Invalid logout request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	// Call user service
+	_, err := h.userClient.Logout(c.Request.Context(), &pb.LogoutRequest{
+		Token:        c.GetString("token"),
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to logout", zap.Error(err))
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "Logout is not enabled"})
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+		default:
+			c.JSON(grpcErrorToHTTP(err))
+		}
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	h.logger.Info("User logged out", zap.Any("user_id", userID))
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out"})
+}
+
+// ForgotPassword starts a password reset. It answers the same way whether
+// or not the email belongs to an account.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid forgot password request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call user service
+	_, err := h.userClient.RequestPasswordReset(c.Request.Context(), &pb.RequestPasswordResetRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		h.logger.Error("Failed to request password reset", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "If the email is registered, a reset link has been sent"})
+}
+
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid reset password request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call user service
+	_, err := h.userClient.ResetPassword(c.Request.Context(), &pb.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to reset password", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Password reset successfully")
+	c.JSON(http.StatusOK, gin.H{"message": "Password has been reset"})
+}
+
 func (h *AuthHandler) ValidateToken(c *gin.Context) {
 	var req ValidateTokenRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// icsTimeFormat is the UTC DATE-TIME form of RFC 5545.
+const icsTimeFormat = "20060102T150405Z"
+
+type CalendarHandler struct {
+	userClient client.UserClient
+	todoClient client.TodoClient
+	batchSize  int
+	logger     *zap.Logger
+}
+
+// NewCalendarHandler fetches tasks batchSize at a time while building a feed.
+func NewCalendarHandler(userClient client.UserClient, todoClient client.TodoClient, batchSize int) *CalendarHandler {
+	return &CalendarHandler{
+		userClient: userClient,
+		todoClient: todoClient,
+		batchSize:  batchSize,
+		logger:     zap.L().Named("calendar_handler"),
+	}
+}
+
+// Feed serves the tasks with a due date as an iCalendar document, one event
+// per task. Calendar apps cannot send a bearer token, so the caller is
+// identified by a feed token in the query string instead.
+func (h *CalendarHandler) Feed(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "token is required"})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	userResp, err := h.userClient.ValidateFeedToken(ctx, &pb.ValidateFeedTokenRequest{Token: token})
+	if err != nil {
+		h.logger.Warn("Failed to validate feed token", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+	userID := userResp.User.Id
+
+	var tasks []*pb.Task
+	for pageNum := 1; ; pageNum++ {
+		page, err := h.todoClient.ListTasksByUser(ctx, &pb.ListTasksByUserRequest{
+			UserId:   userID,
+			Page:     int32(pageNum),
+			PageSize: int32(h.batchSize),
+			SortBy:   "due_date",
+			DueAfter: timestamppb.New(time.Unix(0, 0)),
+		})
+		if err != nil {
+			h.logger.Error("Failed to list tasks for calendar", zap.Error(err), zap.Int("page", pageNum))
+			c.JSON(grpcErrorToHTTP(err))
+			return
+		}
+
+		tasks = append(tasks, page.Tasks...)
+		if len(page.Tasks) == 0 || len(tasks) >= int(page.Total) {
+			break
+		}
+	}
+
+	c.Header("Content-Disposition", `inline; filename="tasks.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(buildCalendar(tasks, time.Now())))
+
+	h.logger.Debug("Calendar feed served",
+		zap.String("user_id", userID),
+		zap.Int("tasks", len(tasks)),
+	)
+}
+
+// buildCalendar renders tasks as a VCALENDAR with a VEVENT per task that has
+// a due date. Lines end in CRLF and are folded at 75 octets as RFC 5545
+// requires.
+func buildCalendar(tasks []*pb.Task, now time.Time) string {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(foldICSLine(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//task-manager//tasks//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:Tasks")
+
+	for _, task := range tasks {
+		if task.DueDate == nil {
+			continue
+		}
+		stamp := now
+		if task.UpdatedAt != nil {
+			stamp = task.UpdatedAt.AsTime()
+		}
+
+		line("BEGIN:VEVENT")
+		line("UID:" + task.Id + "@task-manager")
+		line("DTSTAMP:" + stamp.UTC().Format(icsTimeFormat))
+		line("DTSTART:" + task.DueDate.AsTime().UTC().Format(icsTimeFormat))
+		line("SUMMARY:" + escapeICSText(task.Title))
+		if task.Description != "" {
+			line("DESCRIPTION:" + escapeICSText(task.Description))
+		}
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+	return b.String()
+}
+
+var icsTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+func escapeICSText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+// foldICSLine splits s into lines of at most 75 octets, continuing each with
+// a leading space. It never splits a UTF-8 sequence.
+func foldICSLine(s string) string {
+	const limit = 75
+	if len(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			// The leading space counts towards the continuation's length
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
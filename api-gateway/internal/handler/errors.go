@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcHTTPStatus lists the gRPC codes whose messages are safe to show to
+// clients, with the HTTP status each maps to.
+var grpcHTTPStatus = map[codes.Code]int{
+	codes.InvalidArgument:  http.StatusBadRequest,
+	codes.Unauthenticated:  http.StatusUnauthorized,
+	codes.PermissionDenied: http.StatusForbidden,
+	codes.NotFound:         http.StatusNotFound,
+	codes.AlreadyExists:    http.StatusConflict,
+	codes.Aborted:          http.StatusConflict,
+}
+
+// grpcErrorToHTTP maps a gRPC error to an HTTP status and error body
+// carrying the gRPC message. Anything else becomes a 500 with a generic
+// message so internal details don't leak.
+func grpcErrorToHTTP(err error) (int, gin.H) {
+	if st, ok := status.FromError(err); ok {
+		if code, ok := grpcHTTPStatus[st.Code()]; ok {
+			return code, gin.H{"error": st.Message()}
+		}
+	}
+	return http.StatusInternalServerError, gin.H{"error": "Internal server error"}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type ExportHandler struct {
+	userClient  client.UserClient
+	todoClient  client.TodoClient
+	batchSize   int
+	maxSelected int
+	logger      *zap.Logger
+}
+
+// NewExportHandler fetches tasks batchSize at a time while streaming an
+// export and accepts at most maxSelected ids in a selected task export.
+func NewExportHandler(userClient client.UserClient, todoClient client.TodoClient, batchSize, maxSelected int) *ExportHandler {
+	return &ExportHandler{
+		userClient:  userClient,
+		todoClient:  todoClient,
+		batchSize:   batchSize,
+		maxSelected: maxSelected,
+		logger:      zap.L().Named("export_handler"),
+	}
+}
+
+// ExportMyData streams the caller's profile and all of their tasks as a
+// downloadable JSON document. Tasks are fetched page by page so the full
+// set is never held in memory.
+func (h *ExportHandler) ExportMyData(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Fetch the profile and first page up front so failures can still be
+	// reported with a proper status code
+	userResp, err := h.userClient.GetUser(ctx, &pb.GetUserRequest{Id: userID.(string)})
+	if err != nil {
+		h.logger.Error("Failed to get user for export", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	page, err := h.listTasks(c, userID.(string), 1)
+	if err != nil {
+		h.logger.Error("Failed to list tasks for export", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	c.Header("Content-Type", "application/json")
+	c.Header("Content-Disposition", `attachment; filename="export.json"`)
+	c.Status(http.StatusOK)
+
+	w := c.Writer
+	encoder := json.NewEncoder(w)
+
+	w.WriteString(`{"exported_at":`)
+	encoder.Encode(time.Now().UTC())
+	w.WriteString(`,"user":`)
+	encoder.Encode(userProtoToResponse(userResp.User))
+	w.WriteString(`,"tasks":[`)
+
+	written := 0
+	for pageNum := 1; ; pageNum++ {
+		if pageNum > 1 {
+			page, err = h.listTasks(c, userID.(string), pageNum)
+			if err != nil {
+				// Headers are already sent; cut the body short so the
+				// client sees an invalid document rather than a partial one
+				h.logger.Error("Failed to list tasks during export", zap.Error(err), zap.Int("page", pageNum))
+				c.Abort()
+				return
+			}
+		}
+
+		for _, task := range page.Tasks {
+			if written > 0 {
+				w.WriteString(",")
+			}
+			encoder.Encode(taskProtoToResponse(task))
+			written++
+		}
+		w.Flush()
+
+		if len(page.Tasks) == 0 || written >= int(page.Total) {
+			break
+		}
+	}
+
+	w.WriteString("]}")
+
+	h.logger.Info("User data exported",
+		zap.String("user_id", userID.(string)),
+		zap.Int("tasks", written),
+	)
+}
+
+func (h *ExportHandler) listTasks(c *gin.Context, userID string, page int) (*pb.ListTasksByUserResponse, error) {
+	return h.todoClient.ListTasksByUser(c.Request.Context(), &pb.ListTasksByUserRequest{
+		UserId:          userID,
+		Page:            int32(page),
+		PageSize:        int32(h.batchSize),
+		SortBy:          "created_at",
+		IncludeArchived: true,
+	})
+}
+
+// ExportSelectedTasks returns the requested tasks as a downloadable JSON or
+// CSV file, in request order. Ids of tasks that do not exist, are deleted
+// or belong to someone else are left out, as GetTask hides them too.
+func (h *ExportHandler) ExportSelectedTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var req ExportSelectedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid export selected request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.IDs) > h.maxSelected {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("at most %d tasks can be exported at once", h.maxSelected)})
+		return
+	}
+
+	resp, err := h.todoClient.GetTasksByIDs(c.Request.Context(), &pb.GetTasksByIDsRequest{Ids: req.IDs})
+	if err != nil {
+		h.logger.Error("Failed to get tasks for export", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	tasks := make([]TaskResponse, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		if task.UserId == userID.(string) {
+			tasks = append(tasks, taskProtoToResponse(task))
+		}
+	}
+
+	if req.Format == "csv" {
+		c.Header("Content-Disposition", `attachment; filename="tasks.csv"`)
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", tasksCSV(tasks))
+	} else {
+		c.Header("Content-Disposition", `attachment; filename="tasks.json"`)
+		c.JSON(http.StatusOK, gin.H{"exported_at": time.Now().UTC(), "tasks": tasks})
+	}
+
+	h.logger.Info("Selected tasks exported",
+		zap.String("user_id", userID.(string)),
+		zap.Int("requested", len(req.IDs)),
+		zap.Int("tasks", len(tasks)),
+	)
+}
+
+// tasksCSV renders tasks as CSV with a header row. Tags are joined with
+// ";" and times are RFC 3339 in UTC, empty when unset.
+func tasksCSV(tasks []TaskResponse) []byte {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write([]string{"id", "title", "description", "status", "priority", "due_date", "tags", "created_at", "updated_at", "completed_at"})
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	for _, task := range tasks {
+		w.Write([]string{
+			task.ID,
+			task.Title,
+			task.Description,
+			task.Status,
+			task.Priority,
+			formatTime(task.DueDate),
+			strings.Join(task.Tags, ";"),
+			formatTime(&task.CreatedAt),
+			formatTime(&task.UpdatedAt),
+			formatTime(task.CompletedAt),
+		})
+	}
+	w.Flush()
+	return []byte(b.String())
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// FeedTokenHandler manages the caller's feed tokens. Feed tokens are only
+// accepted by feed endpoints such as the task calendar; the auth middleware
+// never accepts them, so they cannot be used with any other API.
+type FeedTokenHandler struct {
+	userClient client.UserClient
+	logger     *zap.Logger
+}
+
+func NewFeedTokenHandler(userClient client.UserClient) *FeedTokenHandler {
+	return &FeedTokenHandler{
+		userClient: userClient,
+		logger:     zap.L().Named("feed_token_handler"),
+	}
+}
+
+func (h *FeedTokenHandler) CreateFeedToken(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var req CreateFeedTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid create feed token request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.userClient.CreateFeedToken(c.Request.Context(), &pb.CreateFeedTokenRequest{
+		UserId: userID.(string),
+		Name:   req.Name,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create feed token", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// The token is only ever returned here
+	feedTokenResp := feedTokenProtoToResponse(resp.FeedToken)
+	feedTokenResp.Token = resp.Token
+
+	h.logger.Info("Feed token created successfully", zap.String("feed_token_id", resp.FeedToken.Id))
+	c.JSON(http.StatusCreated, feedTokenResp)
+}
+
+func (h *FeedTokenHandler) ListFeedTokens(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	resp, err := h.userClient.ListFeedTokens(c.Request.Context(), &pb.ListFeedTokensRequest{
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list feed tokens", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	feedTokens := make([]FeedTokenResponse, len(resp.FeedTokens))
+	for i, feedToken := range resp.FeedTokens {
+		feedTokens[i] = feedTokenProtoToResponse(feedToken)
+	}
+
+	c.JSON(http.StatusOK, ListFeedTokensResponse{FeedTokens: feedTokens})
+}
+
+func (h *FeedTokenHandler) RevokeFeedToken(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	feedTokenID := c.Param("id")
+	if feedTokenID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Feed token ID is required"})
+		return
+	}
+
+	resp, err := h.userClient.RevokeFeedToken(c.Request.Context(), &pb.RevokeFeedTokenRequest{
+		Id:     feedTokenID,
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to revoke feed token", zap.Error(err), zap.String("feed_token_id", feedTokenID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Feed token revoked successfully", zap.String("feed_token_id", feedTokenID))
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
@@ -1,19 +1,48 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"sync"
+	"time"
 
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
+// Statuses reported by the detailed health check. Backends report their own
+// components as "ok", "unavailable" or "disabled"; disabled components do
+// not degrade the overall status.
+const (
+	healthOK          = "ok"
+	healthDegraded    = "degraded"
+	healthUnavailable = "unavailable"
+	healthDisabled    = "disabled"
+)
+
+// Statuses reported by the readiness check.
+const (
+	readinessReady    = "ready"
+	readinessNotReady = "not_ready"
+)
+
 type HealthHandler struct {
-	logger *zap.Logger
+	userClient client.UserClient
+	todoClient client.TodoClient
+	timeout    time.Duration
+	logger     *zap.Logger
 }
 
-func NewHealthHandler() *HealthHandler {
+// NewHealthHandler bounds every backend probe of the detailed health check
+// by timeout.
+func NewHealthHandler(userClient client.UserClient, todoClient client.TodoClient, timeout time.Duration) *HealthHandler {
 	return &HealthHandler{
-		logger: zap.L().Named("health_handler"),
+		userClient: userClient,
+		todoClient: todoClient,
+		timeout:    timeout,
+		logger:     zap.L().Named("health_handler"),
 	}
 }
 
@@ -25,4 +54,116 @@ func (h *HealthHandler) Health(c *gin.Context) {
 		"service": "api-gateway",
 		"timestamp": c.GetTime("request_time"),
 	})
-}
\ No newline at end of file
+}
+
+// Readiness reports whether the gateway can serve traffic: both backends
+// must answer their gRPC health check with SERVING, which they only do
+// while their own dependencies are up. Any other answer makes the response
+// a 503. Health stays the liveness check and never calls the backends.
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	checks := map[string]func(context.Context) error{
+		"user-service": h.userClient.CheckHealth,
+		"todo-service": h.todoClient.CheckHealth,
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		services = make(map[string]string, len(checks))
+	)
+	for service, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result := healthOK
+			if err := check(ctx); err != nil {
+				h.logger.Warn("Service not ready", zap.String("service", service), zap.Error(err))
+				result = healthUnavailable
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			services[service] = result
+		}()
+	}
+	wg.Wait()
+
+	overall, code := readinessReady, http.StatusOK
+	for _, result := range services {
+		if result != healthOK {
+			overall, code = readinessNotReady, http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	c.JSON(code, gin.H{
+		"status":   overall,
+		"services": services,
+	})
+}
+
+// HealthDetailed reports the gRPC health of each backend service together
+// with the database and Redis status the services report for themselves.
+// Components are keyed "<service>" and "<service>.<dependency>". Any
+// unavailable component makes the overall status "degraded" and the
+// response a 503.
+func (h *HealthHandler) HealthDetailed(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		components = make(map[string]string)
+	)
+	probe := func(service string, check func(context.Context) error, details func(context.Context) (map[string]string, error)) {
+		defer wg.Done()
+
+		result := map[string]string{service: healthOK}
+		if err := check(ctx); err != nil {
+			h.logger.Warn("Service health check failed", zap.String("service", service), zap.Error(err))
+			result[service] = healthUnavailable
+		} else if deps, err := details(ctx); err != nil {
+			h.logger.Warn("Failed to get service health details", zap.String("service", service), zap.Error(err))
+			result[service] = healthUnavailable
+		} else {
+			for name, status := range deps {
+				result[service+"."+name] = status
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for name, status := range result {
+			components[name] = status
+		}
+	}
+
+	wg.Add(2)
+	go probe("user-service", h.userClient.CheckHealth, func(ctx context.Context) (map[string]string, error) {
+		resp, err := h.userClient.GetHealthDetails(ctx, &pb.GetUserHealthDetailsRequest{})
+		return resp.GetComponents(), err
+	})
+	go probe("todo-service", h.todoClient.CheckHealth, func(ctx context.Context) (map[string]string, error) {
+		resp, err := h.todoClient.GetHealthDetails(ctx, &pb.GetTodoHealthDetailsRequest{})
+		return resp.GetComponents(), err
+	})
+	wg.Wait()
+
+	overall, code := healthOK, http.StatusOK
+	for _, status := range components {
+		if status != healthOK && status != healthDisabled {
+			overall, code = healthDegraded, http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	c.JSON(code, gin.H{
+		"status":     overall,
+		"components": components,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,6 +21,8 @@ type UpdateUserRequest struct {
 	Email    *string `json:"email,omitempty" binding:"omitempty,email"`
 	Password *string `json:"password,omitempty" binding:"omitempty,min=8"`
 	FullName *string `json:"full_name,omitempty" binding:"omitempty,max=200"`
+	// AvatarURL must be an http(s) URL; "" removes the avatar.
+	AvatarURL *string `json:"avatar_url,omitempty" binding:"omitempty,url,max=2048"`
 }
 
 type UserResponse struct {
@@ -27,15 +30,45 @@ type UserResponse struct {
 	Username  string    `json:"username"`
 	Email     string    `json:"email"`
 	FullName  string    `json:"full_name"`
+	AvatarURL string    `json:"avatar_url,omitempty"`
+	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// ListUsersQuery narrows and orders the user list; page and page_size are
+// read separately.
+type ListUsersQuery struct {
+	Search   string `form:"search" binding:"omitempty,max=100"`
+	SortBy   string `form:"sort_by" binding:"omitempty,oneof=username email full_name created_at updated_at"`
+	SortDesc bool   `form:"sort_desc"`
+}
+
+// PublicProfilesRequest asks for the public profiles of up to 100 users.
+type PublicProfilesRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1,max=100,dive,required"`
+}
+
+// PublicProfileResponse is what anyone may see of a user, e.g. next to a
+// mention. It never carries the email or role.
+type PublicProfileResponse struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+type PublicProfilesResponse struct {
+	Profiles []PublicProfileResponse `json:"profiles"`
+}
+
 type ListUsersResponse struct {
-	Users    []UserResponse `json:"users"`
-	Total    int64          `json:"total"`
-	Page     int            `json:"page"`
-	PageSize int            `json:"page_size"`
+	Users      []UserResponse `json:"users"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	TotalPages int            `json:"total_pages"`
+	HasNext    bool           `json:"has_next"`
 }
 
 // Auth models
@@ -51,9 +84,33 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
+
 type AuthResponse struct {
-	User  UserResponse `json:"user"`
-	Token string       `json:"token"`
+	User         UserResponse `json:"user"`
+	Token        string       `json:"token"`
+	RefreshToken string       `json:"refresh_token,omitempty"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LogoutRequest optionally names a refresh token to revoke together with
+// the access token that authenticated the call.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshTokenResponse struct {
+	Token string `json:"token"`
 }
 
 type ValidateTokenRequest struct {
@@ -72,6 +129,10 @@ type CreateTaskRequest struct {
 	Status      string     `json:"status" binding:"omitempty,oneof=TODO IN_PROGRESS DONE ARCHIVED"`
 	Priority    string     `json:"priority" binding:"omitempty,oneof=LOW MEDIUM HIGH URGENT"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
+	Tags        []string   `json:"tags,omitempty" binding:"omitempty,max=20,dive,min=1,max=50"`
+	// RecurrenceRule is DAILY, WEEKLY or MONTHLY, optionally followed by
+	// ";INTERVAL=n".
+	RecurrenceRule string  `json:"recurrence_rule,omitempty" binding:"omitempty,max=64"`
 }
 
 type UpdateTaskRequest struct {
@@ -80,6 +141,13 @@ type UpdateTaskRequest struct {
 	Status      *string    `json:"status,omitempty" binding:"omitempty,oneof=TODO IN_PROGRESS DONE ARCHIVED"`
 	Priority    *string    `json:"priority,omitempty" binding:"omitempty,oneof=LOW MEDIUM HIGH URGENT"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
+	// Tags replaces the task's tags when present; [] removes them all.
+	Tags        *[]string  `json:"tags,omitempty" binding:"omitempty,max=20,dive,min=1,max=50"`
+	// RecurrenceRule replaces the task's rule when present; "" stops it recurring.
+	RecurrenceRule *string `json:"recurrence_rule,omitempty" binding:"omitempty,max=64"`
+	// Version is the task version the client read; when present, the update
+	// fails with 409 if the task has changed since.
+	Version *int32 `json:"version,omitempty" binding:"omitempty,min=1"`
 }
 
 type TaskResponse struct {
@@ -92,6 +160,96 @@ type TaskResponse struct {
 	DueDate     *time.Time `json:"due_date,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	// IsNew is only computed when listing the caller's own tasks, and is
+	// left out everywhere else.
+	IsNew       *bool      `json:"is_new,omitempty"`
+	Tags        []string   `json:"tags"`
+	// TagsTruncated marks list entries whose tags were cut short; the task
+	// itself returns them all.
+	TagsTruncated bool     `json:"tags_truncated,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	Deleted     bool       `json:"deleted,omitempty"`
+	UpdatedBy   string     `json:"updated_by,omitempty"`
+	RecurrenceRule string  `json:"recurrence_rule,omitempty"`
+	ParentTaskID   string  `json:"parent_task_id,omitempty"`
+	Position       int32   `json:"position"`
+	Version        int32   `json:"version"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+	// UrgencyScore is only set when listing with sort_by=urgency.
+	UrgencyScore   *float64   `json:"urgency_score,omitempty"`
+}
+
+// BoardMoveRequest moves a task to position within the status column.
+// Positions past the end of the column put the task last.
+type BoardMoveRequest struct {
+	Status   string `json:"status" binding:"required,oneof=TODO IN_PROGRESS DONE ARCHIVED"`
+	Position *int32 `json:"position" binding:"required,min=0"`
+}
+
+// ReorderTaskRequest moves a task to position within its current status
+// column. Positions past the end of the column put the task last.
+type ReorderTaskRequest struct {
+	Position *int32 `json:"position" binding:"required,min=0"`
+}
+
+// BulkSetDueDateRequest sets due_date on every listed task; a null or
+// missing due_date clears it.
+type BulkSetDueDateRequest struct {
+	IDs     []string   `json:"ids" binding:"required,min=1,max=100,dive,uuid"`
+	DueDate *time.Time `json:"due_date"`
+}
+
+type BulkSetDueDateResponse struct {
+	Updated int `json:"updated"`
+}
+
+// BulkTagsRequest adds tags to every listed task that lacks them, or
+// removes them, depending on action.
+type BulkTagsRequest struct {
+	IDs    []string `json:"ids" binding:"required,min=1,max=100,dive,uuid"`
+	Tags   []string `json:"tags" binding:"required,min=1,max=20"`
+	Action string   `json:"action" binding:"required,oneof=add remove"`
+}
+
+type BulkTagsResponse struct {
+	Updated int `json:"updated"`
+}
+
+// SnoozeOverdueRequest pushes every overdue task's due date forward by
+// duration, a Go duration such as "24h" or "90m".
+type SnoozeOverdueRequest struct {
+	Duration string `json:"duration" binding:"required"`
+}
+
+type SnoozeOverdueResponse struct {
+	Snoozed int `json:"snoozed"`
+}
+
+// CompleteTaskResponse carries the completed task and, when it recurs, the
+// next occurrence created for it.
+type CompleteTaskResponse struct {
+	Completed TaskResponse  `json:"completed"`
+	Next      *TaskResponse `json:"next,omitempty"`
+}
+
+type DeleteCompletedTasksResponse struct {
+	Deleted int64 `json:"deleted"`
+}
+
+type RestoreAllTasksResponse struct {
+	Restored int64 `json:"restored"`
+}
+
+// PreviewRecurrenceRequest asks for the next count occurrences of rule,
+// e.g. "WEEKLY" or "MONTHLY;INTERVAL=3", after from (default now).
+type PreviewRecurrenceRequest struct {
+	Rule  string     `json:"rule" binding:"required"`
+	From  *time.Time `json:"from"`
+	Count int        `json:"count" binding:"required,min=1"`
+}
+
+type PreviewRecurrenceResponse struct {
+	Occurrences []time.Time `json:"occurrences"`
 }
 
 type ListTasksRequest struct {
@@ -99,15 +257,187 @@ type ListTasksRequest struct {
 	PageSize       int    `form:"page_size" binding:"omitempty,min=1,max=100"`
 	FilterByStatus string `form:"filter_by_status" binding:"omitempty,oneof=TODO IN_PROGRESS DONE ARCHIVED"`
 	FilterByPriority string `form:"filter_by_priority" binding:"omitempty,oneof=LOW MEDIUM HIGH URGENT"`
-	SortBy         string `form:"sort_by" binding:"omitempty,oneof=title status priority due_date created_at updated_at"`
+	SortBy         string `form:"sort_by" binding:"omitempty,oneof=title status priority due_date created_at updated_at urgency position"`
 	SortDesc       bool   `form:"sort_desc"`
+	DueBefore      *time.Time `form:"due_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	DueAfter       *time.Time `form:"due_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	// CompletedAfter and CompletedBefore keep only tasks completed in the
+	// window; tasks that are not done drop out once either is set.
+	CompletedAfter  *time.Time `form:"completed_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CompletedBefore *time.Time `form:"completed_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	Search         string     `form:"search" binding:"omitempty,max=100"`
+	IncludeDeleted bool       `form:"include_deleted"`
+	// IncludeArchived also lists archived tasks, which are hidden by default.
+	IncludeArchived bool      `form:"include_archived"`
+	Tags           []string   `form:"tags" binding:"omitempty,max=20"`
+	// Cursor switches to newest-first cursor paging; pass it empty to start.
+	Cursor         *string    `form:"cursor" binding:"omitempty,max=200"`
 }
 
 type ListTasksResponse struct {
-	Tasks    []TaskResponse `json:"tasks"`
-	Total    int64          `json:"total"`
-	Page     int            `json:"page"`
-	PageSize int            `json:"page_size"`
+	Tasks      []TaskResponse `json:"tasks"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	TotalPages int            `json:"total_pages"`
+	HasNext    bool           `json:"has_next"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+type ListTasksModifiedByRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type ListOverdueTasksRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type ListDeletedTasksRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type SearchTasksRequest struct {
+	Query    string `form:"q" binding:"required"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type GetBoardRequest struct {
+	PerColumn int `form:"per_column" binding:"omitempty,min=1"`
+}
+
+type NeedsAttentionRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1"`
+}
+
+// AttentionTaskResponse is a task with its attention score and the reasons
+// behind it: "overdue", "due_soon" or "high_priority_untouched".
+type AttentionTaskResponse struct {
+	Task    TaskResponse `json:"task"`
+	Score   float64      `json:"score"`
+	Reasons []string     `json:"reasons"`
+}
+
+type NeedsAttentionResponse struct {
+	Tasks []AttentionTaskResponse `json:"tasks"`
+}
+
+type BoardResponse struct {
+	Columns map[string][]TaskResponse `json:"columns"`
+}
+
+type CountTasksRequest struct {
+	FilterByStatus   string `form:"filter_by_status" binding:"omitempty,oneof=TODO IN_PROGRESS DONE ARCHIVED"`
+	FilterByPriority string `form:"filter_by_priority" binding:"omitempty,oneof=LOW MEDIUM HIGH URGENT"`
+	// IncludeArchived also counts archived tasks, which are left out by default.
+	IncludeArchived bool `form:"include_archived"`
+}
+
+type CountTasksResponse struct {
+	Count int64 `json:"count"`
+}
+
+// TaskMatrixResponse counts tasks per status, then per priority. Every
+// status and priority is present, including zero cells.
+type TaskMatrixResponse struct {
+	Matrix map[string]map[string]int64 `json:"matrix"`
+}
+
+// TaskStatsResponse counts tasks per status, including zero counts.
+type TaskStatsResponse struct {
+	Counts map[string]int64 `json:"counts"`
+}
+
+// ExportSelectedRequest names the tasks to export and the file format,
+// json (the default) or csv.
+type ExportSelectedRequest struct {
+	IDs    []string `json:"ids" binding:"required,min=1,dive,required"`
+	Format string   `json:"format" binding:"omitempty,oneof=json csv"`
+}
+
+// CompletionTrendsRequest asks for completions per day, week or month
+// between from and to.
+type CompletionTrendsRequest struct {
+	From        *time.Time `form:"from" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          *time.Time `form:"to" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	Granularity string     `form:"granularity" binding:"omitempty,oneof=day week month"`
+}
+
+// CompletionTrendPoint counts the tasks completed in the period starting at Start.
+type CompletionTrendPoint struct {
+	Start     time.Time `json:"start"`
+	Completed int64     `json:"completed"`
+}
+
+// CompletionTrendsResponse has one point per period, oldest first, with
+// zero for periods without completions.
+type CompletionTrendsResponse struct {
+	Granularity string                 `json:"granularity"`
+	Points      []CompletionTrendPoint `json:"points"`
+}
+
+// UserMetricsResponse summarises a user's tasks. Overdue tasks are past due
+// and neither done nor archived.
+type UserMetricsResponse struct {
+	UserID         string     `json:"user_id"`
+	Total          int64      `json:"total"`
+	Completed      int64      `json:"completed"`
+	Overdue        int64      `json:"overdue"`
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+}
+
+type MarkAllSeenResponse struct {
+	SeenAt time.Time `json:"seen_at"`
+}
+
+// UnreadCountResponse counts the tasks updated since LastSeen, or every
+// task when the user has never marked them as seen.
+type UnreadCountResponse struct {
+	Count    int64      `json:"count"`
+	LastSeen *time.Time `json:"last_seen,omitempty"`
+}
+
+// Webhook models
+type CreateWebhookRequest struct {
+	URL string `json:"url" binding:"required,url,max=2048"`
+}
+
+type UpdateWebhookRequest struct {
+	URL    *string `json:"url,omitempty" binding:"omitempty,url,max=2048"`
+	Active *bool   `json:"active,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListWebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+// Feed token models
+type CreateFeedTokenRequest struct {
+	Name string `json:"name" binding:"max=100"`
+}
+
+type FeedTokenResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Token      string     `json:"token,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+type ListFeedTokensResponse struct {
+	FeedTokens []FeedTokenResponse `json:"feed_tokens"`
 }
 
 // Helper functions for conversion
@@ -117,6 +447,8 @@ func userProtoToResponse(user *proto.User) UserResponse {
 		Username:  user.Username,
 		Email:     user.Email,
 		FullName:  user.FullName,
+		AvatarURL: user.AvatarUrl,
+		Role:      user.Role,
 		CreatedAt: user.CreatedAt.AsTime(),
 		UpdatedAt: user.UpdatedAt.AsTime(),
 	}
@@ -132,16 +464,66 @@ func taskProtoToResponse(task *proto.Task) TaskResponse {
 		Priority:    task.Priority.String(),
 		CreatedAt:   task.CreatedAt.AsTime(),
 		UpdatedAt:   task.UpdatedAt.AsTime(),
+		Tags:        task.Tags,
+		TagsTruncated: task.TagsTruncated,
+		UpdatedBy:   task.UpdatedBy,
+		RecurrenceRule: task.RecurrenceRule,
+		ParentTaskID:   task.ParentTaskId,
+		Position:       task.Position,
+		Version:        task.Version,
+	}
+	if resp.Tags == nil {
+		resp.Tags = []string{}
 	}
 
 	if task.DueDate != nil {
 		dueDate := task.DueDate.AsTime()
 		resp.DueDate = &dueDate
 	}
+	if task.CompletedAt != nil {
+		completedAt := task.CompletedAt.AsTime()
+		resp.CompletedAt = &completedAt
+	}
+	if task.DeletedAt != nil {
+		deletedAt := task.DeletedAt.AsTime()
+		resp.DeletedAt = &deletedAt
+		resp.Deleted = true
+	}
+	resp.UrgencyScore = task.UrgencyScore
 
 	return resp
 }
 
+func feedTokenProtoToResponse(feedToken *proto.FeedToken) FeedTokenResponse {
+	resp := FeedTokenResponse{
+		ID:        feedToken.Id,
+		Name:      feedToken.Name,
+		CreatedAt: feedToken.CreatedAt.AsTime(),
+	}
+	if feedToken.LastUsedAt != nil {
+		lastUsedAt := feedToken.LastUsedAt.AsTime()
+		resp.LastUsedAt = &lastUsedAt
+	}
+	return resp
+}
+
+func webhookProtoToResponse(webhook *proto.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:        webhook.Id,
+		URL:       webhook.Url,
+		Active:    webhook.Active,
+		CreatedAt: webhook.CreatedAt.AsTime(),
+		UpdatedAt: webhook.UpdatedAt.AsTime(),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
 func createTaskRequestToProto(req *CreateTaskRequest, userID string) *proto.CreateTaskRequest {
 	protoReq := &proto.CreateTaskRequest{
 		UserId:      userID,
@@ -164,30 +546,84 @@ func createTaskRequestToProto(req *CreateTaskRequest, userID string) *proto.Crea
 		protoReq.DueDate = timestamppb.New(*req.DueDate)
 	}
 
+	protoReq.Tags = req.Tags
+	protoReq.RecurrenceRule = req.RecurrenceRule
+
+	return protoReq
+}
+
+// updateUserRequestToProto masks the update to the fields present in req,
+// so a field sent as "" is cleared rather than ignored.
+func updateUserRequestToProto(req *UpdateUserRequest, userID string) *proto.UpdateUserRequest {
+	protoReq := &proto.UpdateUserRequest{
+		Id:         userID,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+
+	if req.Username != nil {
+		protoReq.Username = *req.Username
+		protoReq.UpdateMask.Paths = append(protoReq.UpdateMask.Paths, "username")
+	}
+	if req.Email != nil {
+		protoReq.Email = *req.Email
+		protoReq.UpdateMask.Paths = append(protoReq.UpdateMask.Paths, "email")
+	}
+	if req.FullName != nil {
+		protoReq.FullName = *req.FullName
+		protoReq.UpdateMask.Paths = append(protoReq.UpdateMask.Paths, "full_name")
+	}
+	if req.AvatarURL != nil {
+		protoReq.AvatarUrl = *req.AvatarURL
+		protoReq.UpdateMask.Paths = append(protoReq.UpdateMask.Paths, "avatar_url")
+	}
+	if req.Password != nil {
+		protoReq.Password = *req.Password
+		protoReq.UpdateMask.Paths = append(protoReq.UpdateMask.Paths, "password")
+	}
+
 	return protoReq
 }
 
 func updateTaskRequestToProto(req *UpdateTaskRequest, taskID, userID string) *proto.UpdateTaskRequest {
 	protoReq := &proto.UpdateTaskRequest{
-		Id:     taskID,
-		UserId: userID,
+		Id:         taskID,
+		UserId:     userID,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	mask := func(path string) {
+		protoReq.UpdateMask.Paths = append(protoReq.UpdateMask.Paths, path)
 	}
 
 	if req.Title != nil {
 		protoReq.Title = *req.Title
+		mask("title")
 	}
 	if req.Description != nil {
-		protoReq.Description = *req.Description
+		protoReq.Description = req.Description
+		mask("description")
 	}
 	if req.Status != nil {
-		protoReq.Status = proto.TaskStatus(proto.TaskStatus_value[*req.Status])
+		protoReq.Status = proto.TaskStatus(proto.TaskStatus_value[*req.Status]).Enum()
+		mask("status")
 	}
 	if req.Priority != nil {
-		protoReq.Priority = proto.TaskPriority(proto.TaskPriority_value[*req.Priority])
+		protoReq.Priority = proto.TaskPriority(proto.TaskPriority_value[*req.Priority]).Enum()
+		mask("priority")
 	}
 	if req.DueDate != nil {
 		protoReq.DueDate = timestamppb.New(*req.DueDate)
+		mask("due_date")
+	}
+	if req.Tags != nil {
+		protoReq.Tags = *req.Tags
+		protoReq.ClearTags = len(*req.Tags) == 0
+		mask("tags")
 	}
+	if req.RecurrenceRule != nil {
+		protoReq.RecurrenceRule = req.RecurrenceRule
+		mask("recurrence_rule")
+	}
+	protoReq.ExpectedVersion = req.Version
 
 	return protoReq
-}
\ No newline at end of file
+}
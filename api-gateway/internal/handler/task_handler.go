@@ -2,11 +2,15 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
 	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TaskHandler struct {
@@ -43,7 +47,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 	resp, err := h.todoClient.CreateTask(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to create task", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -57,6 +61,8 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 	c.JSON(http.StatusCreated, taskResp)
 }
 
+// GetTask returns one of the caller's tasks. Admins may read any task. With
+// include_deleted=true owners also see their soft-deleted tasks.
 func (h *TaskHandler) GetTask(c *gin.Context) {
 	taskID := c.Param("id")
 	if taskID == "" {
@@ -64,16 +70,28 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 		return
 	}
 
-	// Convert to proto request
-	protoReq := &pb.GetTaskRequest{
-		Id: taskID,
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
 	}
 
-	// Call todo service
-	resp, err := h.todoClient.GetTask(c.Request.Context(), protoReq)
+	// Call todo service, scoped to the caller unless they are an admin
+	var resp *pb.GetTaskResponse
+	var err error
+	if c.GetString("user_role") == middleware.RoleAdmin {
+		resp, err = h.todoClient.GetTask(c.Request.Context(), &pb.GetTaskRequest{Id: taskID})
+	} else {
+		resp, err = h.todoClient.GetTaskByUser(c.Request.Context(), &pb.GetTaskByUserRequest{
+			Id:             taskID,
+			UserId:         userID.(string),
+			IncludeDeleted: c.Query("include_deleted") == "true",
+		})
+	}
 	if err != nil {
 		h.logger.Error("Failed to get task", zap.Error(err), zap.String("task_id", taskID))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get task"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -112,7 +130,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	resp, err := h.todoClient.UpdateTask(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to update task", zap.Error(err), zap.String("task_id", taskID))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -123,6 +141,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	c.JSON(http.StatusOK, taskResp)
 }
 
+// DeleteTask deletes one of the caller's tasks. Admins may delete any task.
 func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	taskID := c.Param("id")
 	if taskID == "" {
@@ -130,16 +149,27 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 		return
 	}
 
-	// Convert to proto request
-	protoReq := &pb.DeleteTaskRequest{
-		Id: taskID,
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
 	}
 
-	// Call todo service
-	resp, err := h.todoClient.DeleteTask(c.Request.Context(), protoReq)
+	// Call todo service, scoped to the caller unless they are an admin
+	var resp *pb.DeleteTaskResponse
+	var err error
+	if c.GetString("user_role") == middleware.RoleAdmin {
+		resp, err = h.todoClient.DeleteTask(c.Request.Context(), &pb.DeleteTaskRequest{Id: taskID})
+	} else {
+		resp, err = h.todoClient.DeleteTaskByUser(c.Request.Context(), &pb.DeleteTaskByUserRequest{
+			Id:     taskID,
+			UserId: userID.(string),
+		})
+	}
 	if err != nil {
 		h.logger.Error("Failed to delete task", zap.Error(err), zap.String("task_id", taskID))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -147,6 +177,346 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
 }
 
+// CompleteTask marks the caller's task done. Completing a recurring task
+// also creates its next occurrence, returned alongside.
+func (h *TaskHandler) CompleteTask(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.CompleteTask(c.Request.Context(), &pb.CompleteTaskRequest{
+		Id:     taskID,
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to complete task", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	response := CompleteTaskResponse{Completed: taskProtoToResponse(resp.Completed)}
+	if resp.Next != nil {
+		next := taskProtoToResponse(resp.Next)
+		response.Next = &next
+	}
+
+	h.logger.Info("Task completed successfully", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, response)
+}
+
+// ArchiveTask sets the caller's task ARCHIVED, hiding it from task lists
+// unless they pass include_archived=true.
+func (h *TaskHandler) ArchiveTask(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ArchiveTask(c.Request.Context(), &pb.ArchiveTaskRequest{
+		Id:     taskID,
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to archive task", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Task archived successfully", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, taskProtoToResponse(resp.Task))
+}
+
+// UnarchiveTask moves the caller's archived task back to TODO.
+func (h *TaskHandler) UnarchiveTask(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.UnarchiveTask(c.Request.Context(), &pb.UnarchiveTaskRequest{
+		Id:     taskID,
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to unarchive task", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Task unarchived successfully", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, taskProtoToResponse(resp.Task))
+}
+
+func (h *TaskHandler) RestoreTask(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.RestoreTask(c.Request.Context(), &pb.RestoreTaskRequest{
+		Id:     taskID,
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to restore task", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Task restored successfully", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, taskProtoToResponse(resp.Task))
+}
+
+// PurgeTask permanently deletes one of the caller's soft-deleted tasks. Live
+// tasks have to be deleted first.
+func (h *TaskHandler) PurgeTask(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.PurgeTask(c.Request.Context(), &pb.PurgeTaskRequest{
+		Id:     taskID,
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to purge task", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Task purged successfully", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// MoveTaskOnBoard handles a board drag and drop, changing the task's status
+// and its position within the column together.
+func (h *TaskHandler) MoveTaskOnBoard(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	var req BoardMoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid board move request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.MoveTaskOnBoard(c.Request.Context(), &pb.MoveTaskOnBoardRequest{
+		Id:       taskID,
+		UserId:   userID.(string),
+		Status:   pb.TaskStatus(pb.TaskStatus_value[req.Status]),
+		Position: *req.Position,
+	})
+	if err != nil {
+		h.logger.Error("Failed to move task on board", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Task moved on board", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, taskProtoToResponse(resp.Task))
+}
+
+// ReorderTask moves the caller's task within its status column, keeping the
+// column's positions contiguous.
+func (h *TaskHandler) ReorderTask(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	taskID := c.Param("id")
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Task ID is required"})
+		return
+	}
+
+	var req ReorderTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid reorder task request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ReorderTask(c.Request.Context(), &pb.ReorderTaskRequest{
+		Id:       taskID,
+		UserId:   userID.(string),
+		Position: *req.Position,
+	})
+	if err != nil {
+		h.logger.Error("Failed to reorder task", zap.Error(err), zap.String("task_id", taskID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Task reordered", zap.String("task_id", taskID))
+	c.JSON(http.StatusOK, taskProtoToResponse(resp.Task))
+}
+
+func (h *TaskHandler) BulkSetDueDate(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var req BulkSetDueDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid bulk due date request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.BulkSetDueDate(c.Request.Context(), &pb.BulkSetDueDateRequest{
+		UserId:  userID.(string),
+		Ids:     req.IDs,
+		DueDate: optionalTimestamp(req.DueDate),
+	})
+	if err != nil {
+		h.logger.Error("Failed to set due dates", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Due dates set in bulk",
+		zap.String("user_id", userID.(string)),
+		zap.Int32("updated", resp.Updated),
+	)
+	c.JSON(http.StatusOK, BulkSetDueDateResponse{Updated: int(resp.Updated)})
+}
+
+// BulkTags adds tags to or removes them from several of the caller's tasks
+// at once.
+func (h *TaskHandler) BulkTags(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var req BulkTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid bulk tags request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call todo service
+	bulkTags := h.todoClient.BulkAddTags
+	if req.Action == "remove" {
+		bulkTags = h.todoClient.BulkRemoveTags
+	}
+	resp, err := bulkTags(c.Request.Context(), &pb.BulkTagsRequest{
+		UserId: userID.(string),
+		Ids:    req.IDs,
+		Tags:   req.Tags,
+	})
+	if err != nil {
+		h.logger.Error("Failed to update tags", zap.Error(err), zap.String("action", req.Action))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Tags updated in bulk",
+		zap.String("user_id", userID.(string)),
+		zap.String("action", req.Action),
+		zap.Int32("updated", resp.Updated),
+	)
+	c.JSON(http.StatusOK, BulkTagsResponse{Updated: int(resp.Updated)})
+}
+
+func (h *TaskHandler) PreviewRecurrence(c *gin.Context) {
+	var req PreviewRecurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid preview recurrence request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.PreviewRecurrence(c.Request.Context(), &pb.PreviewRecurrenceRequest{
+		Rule:  req.Rule,
+		From:  optionalTimestamp(req.From),
+		Count: int32(req.Count),
+	})
+	if err != nil {
+		h.logger.Debug("Failed to preview recurrence", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	occurrences := make([]time.Time, len(resp.Occurrences))
+	for i, occurrence := range resp.Occurrences {
+		occurrences[i] = occurrence.AsTime()
+	}
+
+	c.JSON(http.StatusOK, PreviewRecurrenceResponse{Occurrences: occurrences})
+}
+
 func (h *TaskHandler) ListTasks(c *gin.Context) {
 	// Parse query parameters
 	var query ListTasksRequest
@@ -176,13 +546,22 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 		FilterByUserId:    "", // Admin only - will be empty for regular users
 		SortBy:            query.SortBy,
 		SortDesc:          query.SortDesc,
+		DueBefore:         optionalTimestamp(query.DueBefore),
+		DueAfter:          optionalTimestamp(query.DueAfter),
+		CompletedAfter:    optionalTimestamp(query.CompletedAfter),
+		CompletedBefore:   optionalTimestamp(query.CompletedBefore),
+		Search:            query.Search,
+		IncludeDeleted:    query.IncludeDeleted,
+		IncludeArchived:   query.IncludeArchived,
+		Tags:              query.Tags,
+		Cursor:            query.Cursor,
 	}
 
 	// Call todo service
 	resp, err := h.todoClient.ListTasks(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to list tasks", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list tasks"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -193,28 +572,27 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 	}
 
 	listResp := ListTasksResponse{
-		Tasks:    tasks,
-		Total:    int64(resp.Total),
-		Page:     int(resp.Page),
-		PageSize: int(resp.PageSize),
+		Tasks:      tasks,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
+		NextCursor: resp.NextCursor,
 	}
 
 	h.logger.Debug("Tasks listed", zap.Int("count", len(tasks)))
 	c.JSON(http.StatusOK, listResp)
 }
 
-func (h *TaskHandler) ListMyTasks(c *gin.Context) {
-	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get("user_id")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
-		return
-	}
+// ListTasksModifiedBy lists the tasks a user modified last, whoever owns
+// them.
+func (h *TaskHandler) ListTasksModifiedBy(c *gin.Context) {
+	userID := c.Param("user_id")
 
-	// Parse query parameters
-	var query ListTasksRequest
+	var query ListTasksModifiedByRequest
 	if err := c.ShouldBindQuery(&query); err != nil {
-		h.logger.Debug("Invalid list my tasks query", zap.Error(err))
+		h.logger.Debug("Invalid list tasks modified by query", zap.Error(err))
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
@@ -226,26 +604,16 @@ func (h *TaskHandler) ListMyTasks(c *gin.Context) {
 	if query.PageSize == 0 {
 		query.PageSize = 10
 	}
-	if query.PageSize > 100 {
-		query.PageSize = 100
-	}
-
-	// Convert to proto request
-	protoReq := &pb.ListTasksByUserRequest{
-		UserId:           userID.(string),
-		Page:             int32(query.Page),
-		PageSize:         int32(query.PageSize),
-		FilterByStatus:   query.FilterByStatus,
-		FilterByPriority: query.FilterByPriority,
-		SortBy:           query.SortBy,
-		SortDesc:         query.SortDesc,
-	}
 
 	// Call todo service
-	resp, err := h.todoClient.ListTasksByUser(c.Request.Context(), protoReq)
+	resp, err := h.todoClient.ListTasksModifiedBy(c.Request.Context(), &pb.ListTasksModifiedByRequest{
+		UserId:   userID,
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+	})
 	if err != nil {
-		h.logger.Error("Failed to list my tasks", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list tasks"})
+		h.logger.Error("Failed to list tasks modified by user", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -255,16 +623,622 @@ func (h *TaskHandler) ListMyTasks(c *gin.Context) {
 		tasks[i] = taskProtoToResponse(task)
 	}
 
-	listResp := ListTasksResponse{
-		Tasks:    tasks,
-		Total:    int64(resp.Total),
-		Page:     int(resp.Page),
-		PageSize: int(resp.PageSize),
-	}
+	c.JSON(http.StatusOK, ListTasksResponse{
+		Tasks:      tasks,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
+	})
+}
 
-	h.logger.Debug("My tasks listed", 
+// SnoozeOverdue pushes the due date of each of the caller's overdue tasks
+// forward by the requested duration.
+func (h *TaskHandler) SnoozeOverdue(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var req SnoozeOverdueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid snooze overdue request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil || duration < time.Second {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "duration must be at least 1s, e.g. \"24h\""})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.SnoozeOverdue(c.Request.Context(), &pb.SnoozeOverdueRequest{
+		UserId:          userID.(string),
+		DurationSeconds: int64(duration / time.Second),
+	})
+	if err != nil {
+		h.logger.Error("Failed to snooze overdue tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Overdue tasks snoozed",
+		zap.String("user_id", userID.(string)),
+		zap.Int32("snoozed", resp.Snoozed),
+	)
+	c.JSON(http.StatusOK, SnoozeOverdueResponse{Snoozed: int(resp.Snoozed)})
+}
+
+// DeleteCompletedTasks moves all of the caller's done and archived tasks to
+// the trash.
+func (h *TaskHandler) DeleteCompletedTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.DeleteCompletedTasks(c.Request.Context(), &pb.DeleteCompletedTasksRequest{
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to delete completed tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Completed tasks deleted",
+		zap.String("user_id", userID.(string)),
+		zap.Int64("deleted", resp.Deleted),
+	)
+	c.JSON(http.StatusOK, DeleteCompletedTasksResponse{Deleted: resp.Deleted})
+}
+
+// RestoreAllTasks brings back the caller's deleted tasks in one go.
+func (h *TaskHandler) RestoreAllTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.RestoreAllTasks(c.Request.Context(), &pb.RestoreAllTasksRequest{
+		UserId: userID.(string),
+	})
+	if err != nil {
+		h.logger.Error("Failed to restore all tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Deleted tasks restored",
+		zap.String("user_id", userID.(string)),
+		zap.Int64("restored", resp.Restored),
+	)
+	c.JSON(http.StatusOK, RestoreAllTasksResponse{Restored: resp.Restored})
+}
+
+// ListOverdueTasks lists the caller's tasks past due that are neither done
+// nor archived, most overdue first.
+func (h *TaskHandler) ListOverdueTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query ListOverdueTasksRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid list overdue tasks query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set defaults
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 10
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ListOverdueTasks(c.Request.Context(), &pb.ListOverdueTasksRequest{
+		UserId:   userID.(string),
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list overdue tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// Convert response
+	tasks := make([]TaskResponse, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = taskProtoToResponse(task)
+	}
+
+	c.JSON(http.StatusOK, ListTasksResponse{
+		Tasks:      tasks,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
+	})
+}
+
+// ListDeletedTasks lists the caller's trash, most recently deleted first.
+// Each task carries its deleted_at.
+func (h *TaskHandler) ListDeletedTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query ListDeletedTasksRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid list deleted tasks query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set defaults
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 10
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ListDeletedTasks(c.Request.Context(), &pb.ListDeletedTasksRequest{
+		UserId:   userID.(string),
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list deleted tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// Convert response
+	tasks := make([]TaskResponse, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = taskProtoToResponse(task)
+	}
+
+	c.JSON(http.StatusOK, ListTasksResponse{
+		Tasks:      tasks,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
+	})
+}
+
+// SearchTasks searches the caller's tasks by title, description and tags,
+// best matches first.
+func (h *TaskHandler) SearchTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var query SearchTasksRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid search tasks query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set defaults
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 10
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.SearchTasks(c.Request.Context(), &pb.SearchTasksRequest{
+		UserId:   userID.(string),
+		Query:    query.Query,
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+	})
+	if err != nil {
+		h.logger.Error("Failed to search tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// Convert response
+	tasks := make([]TaskResponse, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = taskProtoToResponse(task)
+	}
+
+	c.JSON(http.StatusOK, ListTasksResponse{
+		Tasks:      tasks,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
+	})
+}
+
+func (h *TaskHandler) ListMyTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query ListTasksRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid list my tasks query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set defaults
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 10
+	}
+	if query.PageSize > 100 {
+		query.PageSize = 100
+	}
+
+	// Convert to proto request
+	protoReq := &pb.ListTasksByUserRequest{
+		UserId:           userID.(string),
+		Page:             int32(query.Page),
+		PageSize:         int32(query.PageSize),
+		FilterByStatus:   query.FilterByStatus,
+		FilterByPriority: query.FilterByPriority,
+		SortBy:           query.SortBy,
+		SortDesc:         query.SortDesc,
+		DueBefore:        optionalTimestamp(query.DueBefore),
+		DueAfter:         optionalTimestamp(query.DueAfter),
+		CompletedAfter:   optionalTimestamp(query.CompletedAfter),
+		CompletedBefore:  optionalTimestamp(query.CompletedBefore),
+		Search:           query.Search,
+		IncludeDeleted:   query.IncludeDeleted,
+		IncludeArchived:  query.IncludeArchived,
+		Tags:             query.Tags,
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ListTasksByUser(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to list my tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// Convert response
+	tasks := make([]TaskResponse, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = taskProtoToResponse(task)
+		isNew := task.IsNew
+		tasks[i].IsNew = &isNew
+	}
+
+	listResp := ListTasksResponse{
+		Tasks:      tasks,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
+	}
+
+	h.logger.Debug("My tasks listed", 
 		zap.String("user_id", userID.(string)),
 		zap.Int("count", len(tasks)),
 	)
 	c.JSON(http.StatusOK, listResp)
+}
+
+func (h *TaskHandler) GetBoard(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query GetBoardRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid board query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set defaults
+	if query.PerColumn == 0 {
+		query.PerColumn = 10
+	}
+
+	// Convert to proto request
+	protoReq := &pb.GetBoardRequest{
+		UserId:    userID.(string),
+		PerColumn: int32(query.PerColumn),
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetBoard(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to get board", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// Convert response
+	boardResp := BoardResponse{
+		Columns: make(map[string][]TaskResponse, len(resp.Columns)),
+	}
+	for _, column := range resp.Columns {
+		tasks := make([]TaskResponse, len(column.Tasks))
+		for i, task := range column.Tasks {
+			tasks[i] = taskProtoToResponse(task)
+		}
+		boardResp.Columns[column.Status.String()] = tasks
+	}
+
+	h.logger.Debug("Board retrieved", zap.String("user_id", userID.(string)))
+	c.JSON(http.StatusOK, boardResp)
+}
+
+func (h *TaskHandler) CountTasks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query CountTasksRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid count query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	protoReq := &pb.CountTasksRequest{
+		UserId:           userID.(string),
+		FilterByStatus:   query.FilterByStatus,
+		FilterByPriority: query.FilterByPriority,
+		IncludeArchived:  query.IncludeArchived,
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.CountTasks(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to count tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, CountTasksResponse{Count: resp.Count})
+}
+
+func (h *TaskHandler) GetTaskMatrix(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetTaskMatrix(c.Request.Context(), &pb.GetTaskMatrixRequest{UserId: userID.(string)})
+	if err != nil {
+		h.logger.Error("Failed to get task matrix", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	matrix := make(map[string]map[string]int64, len(resp.Statuses))
+	for status, row := range resp.Statuses {
+		matrix[status] = row.GetPriorities()
+	}
+
+	c.JSON(http.StatusOK, TaskMatrixResponse{Matrix: matrix})
+}
+
+func (h *TaskHandler) GetTaskStats(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetTaskStats(c.Request.Context(), &pb.GetTaskStatsRequest{UserId: userID.(string)})
+	if err != nil {
+		h.logger.Error("Failed to get task stats", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, TaskStatsResponse{Counts: resp.Counts})
+}
+
+// GetCompletionTrends counts the caller's completed tasks per day, week or
+// month between the from and to query parameters, filling empty periods
+// with zero.
+func (h *TaskHandler) GetCompletionTrends(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query CompletionTrendsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid completion trends query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if query.Granularity == "" {
+		query.Granularity = "day"
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetCompletionTrends(c.Request.Context(), &pb.GetCompletionTrendsRequest{
+		UserId:      userID.(string),
+		From:        optionalTimestamp(query.From),
+		To:          optionalTimestamp(query.To),
+		Granularity: query.Granularity,
+	})
+	if err != nil {
+		h.logger.Error("Failed to get completion trends", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	trends := CompletionTrendsResponse{
+		Granularity: query.Granularity,
+		Points:      make([]CompletionTrendPoint, 0, len(resp.Points)),
+	}
+	for _, point := range resp.Points {
+		trends.Points = append(trends.Points, CompletionTrendPoint{
+			Start:     point.Start.AsTime(),
+			Completed: point.Completed,
+		})
+	}
+
+	c.JSON(http.StatusOK, trends)
+}
+
+// ListTasksNeedingAttention returns the caller's open tasks that most need
+// attention, highest score first.
+func (h *TaskHandler) ListTasksNeedingAttention(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	// Parse query parameters
+	var query NeedsAttentionRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid needs attention query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ListTasksNeedingAttention(c.Request.Context(), &pb.ListTasksNeedingAttentionRequest{
+		UserId: userID.(string),
+		Limit:  int32(query.Limit),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list tasks needing attention", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// Convert response
+	attentionResp := NeedsAttentionResponse{
+		Tasks: make([]AttentionTaskResponse, len(resp.Tasks)),
+	}
+	for i, item := range resp.Tasks {
+		attentionResp.Tasks[i] = AttentionTaskResponse{
+			Task:    taskProtoToResponse(item.Task),
+			Score:   item.Score,
+			Reasons: item.Reasons,
+		}
+	}
+
+	c.JSON(http.StatusOK, attentionResp)
+}
+
+func (h *TaskHandler) GetUnreadCount(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	protoReq := &pb.GetUnreadCountRequest{
+		UserId: userID.(string),
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetUnreadCount(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to count unread tasks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	unreadResp := UnreadCountResponse{Count: resp.Count}
+	if resp.LastSeen != nil {
+		lastSeen := resp.LastSeen.AsTime()
+		unreadResp.LastSeen = &lastSeen
+	}
+	c.JSON(http.StatusOK, unreadResp)
+}
+
+func (h *TaskHandler) MarkAllSeen(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	protoReq := &pb.MarkAllSeenRequest{
+		UserId: userID.(string),
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.MarkAllSeen(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to mark tasks as seen", zap.Error(err))
+		if status.Code(err) == codes.Unavailable {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Marking tasks as seen is temporarily unavailable"})
+			return
+		}
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Debug("Tasks marked as seen", zap.String("user_id", userID.(string)))
+	c.JSON(http.StatusOK, MarkAllSeenResponse{SeenAt: resp.SeenAt.AsTime()})
 }
\ No newline at end of file
@@ -6,19 +6,28 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
 	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
 	"go.uber.org/zap"
 )
 
 type UserHandler struct {
-	userClient client.UserClient
-	logger     *zap.Logger
+	userClient        client.UserClient
+	todoClient        client.TodoClient
+	hardDeleteEnabled bool
+	logger            *zap.Logger
+	auditLogger       *zap.Logger
 }
 
-func NewUserHandler(userClient client.UserClient) *UserHandler {
+// NewUserHandler needs the todo client only to erase a user's tasks when
+// hard deletes are enabled.
+func NewUserHandler(userClient client.UserClient, todoClient client.TodoClient, hardDeleteEnabled bool) *UserHandler {
 	return &UserHandler{
-		userClient: userClient,
-		logger:     zap.L().Named("user_handler"),
+		userClient:        userClient,
+		todoClient:        todoClient,
+		hardDeleteEnabled: hardDeleteEnabled,
+		logger:            zap.L().Named("user_handler"),
+		auditLogger:       zap.L().Named("audit"),
 	}
 }
 
@@ -42,8 +51,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	resp, err := h.userClient.CreateUser(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to create user", zap.Error(err))
-		// TODO: Handle specific gRPC errors
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -70,8 +78,7 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 	resp, err := h.userClient.GetUser(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to get user", zap.Error(err), zap.String("user_id", userID))
-		// TODO: Handle not found errors
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -82,6 +89,104 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 	c.JSON(http.StatusOK, userResp)
 }
 
+// BatchGetPublicProfiles returns the public profiles of a set of users, for
+// mention UIs that must not see emails.
+func (h *UserHandler) BatchGetPublicProfiles(c *gin.Context) {
+	var req PublicProfilesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid public profiles request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Call user service
+	resp, err := h.userClient.BatchGetPublicProfiles(c.Request.Context(), &pb.BatchGetPublicProfilesRequest{
+		Ids: req.IDs,
+	})
+	if err != nil {
+		h.logger.Error("Failed to get public profiles", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	profiles := make([]PublicProfileResponse, len(resp.Profiles))
+	for i, profile := range resp.Profiles {
+		profiles[i] = PublicProfileResponse{
+			ID:        profile.Id,
+			Username:  profile.Username,
+			FullName:  profile.FullName,
+			AvatarURL: profile.AvatarUrl,
+		}
+	}
+	c.JSON(http.StatusOK, PublicProfilesResponse{Profiles: profiles})
+}
+
+// GetUserByUsername looks a user up by handle, e.g. to resolve a mention.
+func (h *UserHandler) GetUserByUsername(c *gin.Context) {
+	username := c.Param("username")
+	if username == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Username is required"})
+		return
+	}
+
+	// Call user service
+	resp, err := h.userClient.GetUserByUsername(c.Request.Context(), &pb.GetUserByUsernameRequest{
+		Username: username,
+	})
+	if err != nil {
+		h.logger.Debug("Failed to get user by username", zap.Error(err), zap.String("username", username))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, userProtoToResponse(resp.User))
+}
+
+// GetUserMetrics returns a user's task metrics. Users may only read their
+// own; admins may read anyone's.
+func (h *UserHandler) GetUserMetrics(c *gin.Context) {
+	userID := c.Param("id")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "User ID is required"})
+		return
+	}
+
+	if userID != c.GetString("user_id") {
+		if c.GetString("user_role") != middleware.RoleAdmin {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			return
+		}
+
+		// Unknown users would otherwise read as having no tasks
+		if _, err := h.userClient.GetUser(c.Request.Context(), &pb.GetUserRequest{Id: userID}); err != nil {
+			h.logger.Error("Failed to get user", zap.Error(err), zap.String("user_id", userID))
+			c.JSON(grpcErrorToHTTP(err))
+			return
+		}
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetUserTaskMetrics(c.Request.Context(), &pb.GetUserTaskMetricsRequest{UserId: userID})
+	if err != nil {
+		h.logger.Error("Failed to get user metrics", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	metricsResp := UserMetricsResponse{
+		UserID:    userID,
+		Total:     resp.Total,
+		Completed: resp.Completed,
+		Overdue:   resp.Overdue,
+	}
+	if resp.LastActivityAt != nil {
+		lastActivityAt := resp.LastActivityAt.AsTime()
+		metricsResp.LastActivityAt = &lastActivityAt
+	}
+
+	c.JSON(http.StatusOK, metricsResp)
+}
+
 func (h *UserHandler) UpdateUser(c *gin.Context) {
 	userID := c.Param("id")
 	if userID == "" {
@@ -97,33 +202,13 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 	}
 
 	// Convert to proto request
-	protoReq := &pb.UpdateUserRequest{
-		Id:       userID,
-		Username: "",
-		Email:    "",
-		FullName: "",
-		Password: "",
-	}
-
-	// Only set fields that are provided
-	if req.Username != nil {
-		protoReq.Username = *req.Username
-	}
-	if req.Email != nil {
-		protoReq.Email = *req.Email
-	}
-	if req.FullName != nil {
-		protoReq.FullName = *req.FullName
-	}
-	if req.Password != nil {
-		protoReq.Password = *req.Password
-	}
+	protoReq := updateUserRequestToProto(&req, userID)
 
 	// Call user service
 	resp, err := h.userClient.UpdateUser(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to update user", zap.Error(err), zap.String("user_id", userID))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -141,6 +226,25 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 		return
 	}
 
+	hard := c.Query("hard") == "true"
+	if hard && !h.hardDeleteEnabled {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Hard delete is disabled"})
+		return
+	}
+
+	// Erase the user's todo data first: if deleting the account then fails
+	// the request can simply be retried
+	var purged *pb.PurgeUserDataResponse
+	if hard {
+		var err error
+		purged, err = h.todoClient.PurgeUserData(c.Request.Context(), &pb.PurgeUserDataRequest{UserId: userID})
+		if err != nil {
+			h.logger.Error("Failed to purge user data", zap.Error(err), zap.String("user_id", userID))
+			c.JSON(grpcErrorToHTTP(err))
+			return
+		}
+	}
+
 	// Convert to proto request
 	protoReq := &pb.DeleteUserRequest{
 		Id: userID,
@@ -150,10 +254,21 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 	resp, err := h.userClient.DeleteUser(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to delete user", zap.Error(err), zap.String("user_id", userID))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
+	if hard {
+		// Audit entries carry ids and counts only, no personal data
+		requestedBy, _ := c.Get("user_id")
+		h.auditLogger.Info("User erased",
+			zap.String("user_id", userID),
+			zap.Any("requested_by", requestedBy),
+			zap.Int64("tasks_deleted", purged.TasksDeleted),
+			zap.Int64("webhooks_deleted", purged.WebhooksDeleted),
+		)
+	}
+
 	h.logger.Info("User deleted successfully", zap.String("user_id", userID))
 	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
 }
@@ -174,17 +289,27 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 		pageSize = 100
 	}
 
+	var query ListUsersQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.logger.Debug("Invalid list users query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	// Convert to proto request
 	protoReq := &pb.ListUsersRequest{
 		Page:     int32(page),
 		PageSize: int32(pageSize),
+		Search:   query.Search,
+		SortBy:   query.SortBy,
+		SortDesc: query.SortDesc,
 	}
 
 	// Call user service
 	resp, err := h.userClient.ListUsers(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to list users", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list users"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -195,10 +320,12 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 	}
 
 	listResp := ListUsersResponse{
-		Users:    users,
-		Total:    int64(resp.Total),
-		Page:     int(resp.Page),
-		PageSize: int(resp.PageSize),
+		Users:      users,
+		Total:      int64(resp.Total),
+		Page:       int(resp.Page),
+		PageSize:   int(resp.PageSize),
+		TotalPages: int(resp.TotalPages),
+		HasNext:    resp.HasNext,
 	}
 
 	h.logger.Debug("Users listed", zap.Int("count", len(users)))
@@ -222,7 +349,7 @@ func (h *UserHandler) GetCurrentUser(c *gin.Context) {
 	resp, err := h.userClient.GetUser(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to get current user", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -249,33 +376,13 @@ func (h *UserHandler) UpdateCurrentUser(c *gin.Context) {
 	}
 
 	// Convert to proto request
-	protoReq := &pb.UpdateUserRequest{
-		Id:       userID.(string),
-		Username: "",
-		Email:    "",
-		FullName: "",
-		Password: "",
-	}
-
-	// Only set fields that are provided
-	if req.Username != nil {
-		protoReq.Username = *req.Username
-	}
-	if req.Email != nil {
-		protoReq.Email = *req.Email
-	}
-	if req.FullName != nil {
-		protoReq.FullName = *req.FullName
-	}
-	if req.Password != nil {
-		protoReq.Password = *req.Password
-	}
+	protoReq := updateUserRequestToProto(&req, userID.(string))
 
 	// Call user service
 	resp, err := h.userClient.UpdateUser(c.Request.Context(), protoReq)
 	if err != nil {
 		h.logger.Error("Failed to update current user", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		c.JSON(grpcErrorToHTTP(err))
 		return
 	}
 
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type WebhookHandler struct {
+	todoClient client.TodoClient
+	logger     *zap.Logger
+}
+
+func NewWebhookHandler(todoClient client.TodoClient) *WebhookHandler {
+	return &WebhookHandler{
+		todoClient: todoClient,
+		logger:     zap.L().Named("webhook_handler"),
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	var req CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid create webhook request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	protoReq := &pb.CreateWebhookRequest{
+		UserId: userID.(string),
+		Url:    req.URL,
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.CreateWebhook(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to create webhook", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	// The secret is only ever returned here
+	webhookResp := webhookProtoToResponse(resp.Webhook)
+	webhookResp.Secret = resp.Secret
+
+	h.logger.Info("Webhook created successfully", zap.String("webhook_id", resp.Webhook.Id))
+	c.JSON(http.StatusCreated, webhookResp)
+}
+
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	webhookID := c.Param("id")
+	if webhookID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Webhook ID is required"})
+		return
+	}
+
+	protoReq := &pb.GetWebhookRequest{
+		Id:     webhookID,
+		UserId: userID.(string),
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.GetWebhook(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to get webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookProtoToResponse(resp.Webhook))
+}
+
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	webhookID := c.Param("id")
+	if webhookID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Webhook ID is required"})
+		return
+	}
+
+	var req UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Debug("Invalid update webhook request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	protoReq := &pb.UpdateWebhookRequest{
+		Id:     webhookID,
+		UserId: userID.(string),
+		Active: req.Active,
+	}
+	if req.URL != nil {
+		protoReq.Url = *req.URL
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.UpdateWebhook(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to update webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Webhook updated successfully", zap.String("webhook_id", webhookID))
+	c.JSON(http.StatusOK, webhookProtoToResponse(resp.Webhook))
+}
+
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	webhookID := c.Param("id")
+	if webhookID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Webhook ID is required"})
+		return
+	}
+
+	protoReq := &pb.DeleteWebhookRequest{
+		Id:     webhookID,
+		UserId: userID.(string),
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.DeleteWebhook(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to delete webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	h.logger.Info("Webhook deleted successfully", zap.String("webhook_id", webhookID))
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	protoReq := &pb.ListWebhooksRequest{
+		UserId: userID.(string),
+	}
+
+	// Call todo service
+	resp, err := h.todoClient.ListWebhooks(c.Request.Context(), protoReq)
+	if err != nil {
+		h.logger.Error("Failed to list webhooks", zap.Error(err))
+		c.JSON(grpcErrorToHTTP(err))
+		return
+	}
+
+	webhooks := make([]WebhookResponse, len(resp.Webhooks))
+	for i, webhook := range resp.Webhooks {
+		webhooks[i] = webhookProtoToResponse(webhook)
+	}
+
+	c.JSON(http.StatusOK, ListWebhooksResponse{Webhooks: webhooks})
+}
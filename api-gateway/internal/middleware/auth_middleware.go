@@ -17,6 +17,7 @@ import (
 type AuthMiddleware struct {
 	userClient client.UserClient
 	jwtSecret  string
+	denylist   TokenDenylist
 	logger     *zap.Logger
 }
 
@@ -28,6 +29,12 @@ func NewAuthMiddleware(userClient client.UserClient, jwtSecret string) *AuthMidd
 	}
 }
 
+// SetDenylist makes the middleware reject tokens revoked by Logout. Without
+// one, locally verified tokens stay valid until they expire.
+func (m *AuthMiddleware) SetDenylist(denylist TokenDenylist) {
+	m.denylist = denylist
+}
+
 func (m *AuthMiddleware) Handler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Skip authentication for public endpoints
@@ -74,6 +81,7 @@ func (m *AuthMiddleware) Handler() gin.HandlerFunc {
 		c.Set("user_email", user.Email)
 		c.Set("user_username", user.Username)
 		c.Set("user_full_name", user.FullName)
+		c.Set("user_role", user.Role)
 		c.Set("token", tokenString)
 
 		m.logger.Debug("User authenticated successfully",
@@ -94,6 +102,17 @@ func (m *AuthMiddleware) validateToken(ctx context.Context, tokenString string)
 		return m.validateWithUserService(ctx, tokenString)
 	}
 
+	// Refresh and password reset tokens carry a token_type; only access
+	// tokens (which have none) may authenticate a request
+	if tokenType := m.getStringFromClaims(claims, "token_type"); tokenType != "" {
+		m.logger.Debug("Rejecting non-access token", zap.String("token_type", tokenType))
+		return nil, errors.New("not an access token")
+	}
+
+	if m.isRevoked(ctx, m.getStringFromClaims(claims, "jti")) {
+		return nil, errors.New("token has been revoked")
+	}
+
 	// Extract user info from claims
 	userID, ok := claims["user_id"].(string)
 	if !ok {
@@ -107,9 +126,25 @@ func (m *AuthMiddleware) validateToken(ctx context.Context, tokenString string)
 		Username: m.getStringFromClaims(claims, "username"),
 		Email:    m.getStringFromClaims(claims, "email"),
 		FullName: m.getStringFromClaims(claims, "full_name"),
+		Role:     m.getStringFromClaims(claims, "role"),
 	}, nil
 }
 
+// isRevoked checks the denylist. A denylist outage lets the token through
+// rather than locking every user out.
+func (m *AuthMiddleware) isRevoked(ctx context.Context, jti string) bool {
+	if m.denylist == nil || jti == "" {
+		return false
+	}
+
+	revoked, err := m.denylist.IsRevoked(ctx, jti)
+	if err != nil {
+		m.logger.Warn("Failed to check token denylist", zap.Error(err))
+		return false
+	}
+	return revoked
+}
+
 func (m *AuthMiddleware) parseJWT(tokenString string) (jwt.MapClaims, error) {
 	m.logger.Debug("Attempting to parse JWT token", 
 		zap.String("token_prefix", tokenString[:min(50, len(tokenString))]),
@@ -124,9 +159,14 @@ func (m *AuthMiddleware) parseJWT(tokenString string) (jwt.MapClaims, error) {
 	})
 
 	if err != nil {
+		// token is nil when the input is not a JWT at all
+		var header map[string]interface{}
+		if token != nil {
+			header = token.Header
+		}
 		m.logger.Error("JWT parse error", 
 			zap.Error(err),
-			zap.Any("token_header", token.Header),
+			zap.Any("token_header", header),
 		)
 		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
@@ -10,31 +10,47 @@ import (
 )
 
 type LoggingMiddleware struct {
-	logger *zap.Logger
+	logger               *zap.Logger
+	slowRequestThreshold time.Duration
+	skipPaths            map[string]bool
 }
 
-func NewLoggingMiddleware() *LoggingMiddleware {
+// NewLoggingMiddleware creates the request logger. Requests taking longer than
+// slowRequestThreshold get an extra warning; zero disables the check.
+// Requests to skipPaths, such as health checks and metrics scrapes, are only
+// logged when they fail with a server error.
+func NewLoggingMiddleware(slowRequestThreshold time.Duration, skipPaths []string) *LoggingMiddleware {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = true
+	}
+
 	return &LoggingMiddleware{
-		logger: zap.L().Named("http_logger"),
+		logger:               zap.L().Named("http_logger"),
+		slowRequestThreshold: slowRequestThreshold,
+		skipPaths:            skip,
 	}
 }
 
 func (m *LoggingMiddleware) Handler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		startTime := time.Now()
+		skip := m.skipPaths[c.Request.URL.Path]
 		
 		// Extract trace ID
 		span := trace.SpanFromContext(c.Request.Context())
 		traceID := span.SpanContext().TraceID().String()
 		
 		// Log request start
-		m.logger.Debug("HTTP request started",
-			zap.String("method", c.Request.Method),
-			zap.String("path", c.Request.URL.Path),
-			zap.String("trace_id", traceID),
-			zap.String("client_ip", c.ClientIP()),
-			zap.String("user_agent", c.Request.UserAgent()),
-		)
+		if !skip {
+			m.logger.Debug("HTTP request started",
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("trace_id", traceID),
+				zap.String("client_ip", c.ClientIP()),
+				zap.String("user_agent", c.Request.UserAgent()),
+			)
+		}
 
 		// Process request
 		c.Next()
@@ -44,12 +60,17 @@ func (m *LoggingMiddleware) Handler() gin.HandlerFunc {
 		
 		// Get status code
 		statusCode := c.Writer.Status()
+		if skip && statusCode < 500 {
+			return
+		}
 		
 		// Prepare log fields
 		fields := []zapcore.Field{
 			zap.String("method", c.Request.Method),
 			zap.String("path", c.Request.URL.Path),
 			zap.Int("status", statusCode),
+			// Size is -1 until something is written
+			zap.Int("response_bytes", max(c.Writer.Size(), 0)),
 			zap.Duration("duration", duration),
 			zap.String("trace_id", traceID),
 			zap.String("client_ip", c.ClientIP()),
@@ -75,5 +96,15 @@ func (m *LoggingMiddleware) Handler() gin.HandlerFunc {
 		default:
 			m.logger.Info("HTTP request completed", fields...)
 		}
+
+		if m.slowRequestThreshold > 0 && duration > m.slowRequestThreshold {
+			m.logger.Warn("Slow HTTP request",
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path),
+				zap.Duration("duration", duration),
+				zap.Duration("threshold", m.slowRequestThreshold),
+				zap.String("trace_id", traceID),
+			)
+		}
 	}
 }
\ No newline at end of file
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Endpoint groups with their own rate limits.
+const (
+	RateLimitGroupAuth   = "auth"
+	RateLimitGroupReads  = "reads"
+	RateLimitGroupWrites = "writes"
+)
+
+// RateLimit is a token bucket: Burst requests at once, refilled at
+// RequestsPerMinute. A zero RequestsPerMinute leaves the group unlimited.
+type RateLimit struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// idleBucketSweep is how often buckets that have refilled completely are
+// dropped, so clients that went away do not pile up.
+const idleBucketSweep = time.Minute
+
+// RateLimiter keeps an independent set of buckets per endpoint group. Each
+// client gets one bucket per group, keyed by user id when authenticated and
+// by IP otherwise.
+type RateLimiter struct {
+	groups map[string]*limiterGroup
+	logger *zap.Logger
+}
+
+func NewRateLimiter(limits map[string]RateLimit) *RateLimiter {
+	groups := make(map[string]*limiterGroup, len(limits))
+	for name, limit := range limits {
+		if limit.RequestsPerMinute <= 0 {
+			continue
+		}
+		groups[name] = &limiterGroup{
+			rate:    float64(limit.RequestsPerMinute) / 60,
+			burst:   float64(max(limit.Burst, 1)),
+			buckets: make(map[string]*bucket),
+		}
+	}
+
+	return &RateLimiter{
+		groups: groups,
+		logger: zap.L().Named("rate_limiter"),
+	}
+}
+
+// Handler limits requests against group's buckets. Put it after
+// AuthMiddleware to key authenticated requests by user.
+func (l *RateLimiter) Handler(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		l.limit(c, group)
+	}
+}
+
+// MethodHandler counts GET, HEAD and OPTIONS requests as reads and
+// everything else as writes.
+func (l *RateLimiter) MethodHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			l.limit(c, RateLimitGroupReads)
+		default:
+			l.limit(c, RateLimitGroupWrites)
+		}
+	}
+}
+
+func (l *RateLimiter) limit(c *gin.Context, group string) {
+	g, ok := l.groups[group]
+	if !ok {
+		c.Next()
+		return
+	}
+
+	key := "ip:" + c.ClientIP()
+	if userID := c.GetString("user_id"); userID != "" {
+		key = "user:" + userID
+	}
+
+	allowed, retryAfter := g.allow(key, time.Now())
+	if !allowed {
+		l.logger.Debug("Rate limit exceeded",
+			zap.String("group", group),
+			zap.String("key", key),
+			zap.String("path", c.Request.URL.Path),
+		)
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests"})
+		return
+	}
+
+	c.Next()
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type limiterGroup struct {
+	rate  float64 // tokens per second
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// allow takes a token from key's bucket, or reports how long until one is
+// available.
+func (g *limiterGroup) allow(key string, now time.Time) (bool, time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if now.Sub(g.lastSweep) >= idleBucketSweep {
+		g.sweep(now)
+	}
+
+	b, ok := g.buckets[key]
+	if !ok {
+		b = &bucket{tokens: g.burst, last: now}
+		g.buckets[key] = b
+	}
+
+	b.tokens = math.Min(g.burst, b.tokens+now.Sub(b.last).Seconds()*g.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / g.rate * float64(time.Second))
+}
+
+func (g *limiterGroup) sweep(now time.Time) {
+	for key, b := range g.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*g.rate >= g.burst {
+			delete(g.buckets, key)
+		}
+	}
+	g.lastSweep = now
+}
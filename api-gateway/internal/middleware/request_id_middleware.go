@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the request ID in both directions. The ID is also
+// stored in the gin context under this key for the logging middleware.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds IDs accepted from clients so they can't bloat
+// every log line.
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware tags each request with an ID, reusing the client's
+// X-Request-ID when it looks sane and generating one otherwise. The ID is
+// echoed in the response and forwarded to the backends on every gRPC call.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		c.Set(RequestIDHeader, requestID)
+		c.Header(RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(client.WithRequestID(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
+
+// validRequestID accepts short IDs made of letters, digits and the
+// punctuation common in trace and UUID formats.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Roles issued by the user service.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// RequireRole aborts with 403 unless the authenticated user holds role. It
+// must run after AuthMiddleware, which sets user_role.
+func RequireRole(role string) gin.HandlerFunc {
+	logger := zap.L().Named("role_middleware")
+
+	return func(c *gin.Context) {
+		if c.GetString("user_role") != role {
+			logger.Debug("Role check failed",
+				zap.String("required_role", role),
+				zap.String("user_role", c.GetString("user_role")),
+				zap.String("path", c.Request.URL.Path),
+			)
+			c.AbortWithStatusJSON(403, gin.H{"error": "Insufficient permissions"})
+			return
+		}
+
+		c.Next()
+	}
+}
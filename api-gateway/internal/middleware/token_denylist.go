@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RevokedTokenKeyPrefix must match the prefix the user service writes
+// revoked token IDs under.
+const RevokedTokenKeyPrefix = "revoked_token:"
+
+// TokenDenylist reports whether a token ID has been revoked by Logout.
+type TokenDenylist interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+type redisTokenDenylist struct {
+	client *redis.Client
+}
+
+// NewRedisTokenDenylist reads the denylist the user service keeps in Redis.
+func NewRedisTokenDenylist(client *redis.Client) TokenDenylist {
+	return &redisTokenDenylist{client: client}
+}
+
+func (d *redisTokenDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	n, err := d.client.Exists(ctx, RevokedTokenKeyPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
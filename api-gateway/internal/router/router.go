@@ -16,6 +16,11 @@ type Config struct {
 	UserHandler      *handler.UserHandler
 	AuthHandler      *handler.AuthHandler
 	TaskHandler      *handler.TaskHandler
+	WebhookHandler   *handler.WebhookHandler
+	AdminHandler     *handler.AdminHandler
+	ExportHandler    *handler.ExportHandler
+	CalendarHandler  *handler.CalendarHandler
+	FeedTokenHandler *handler.FeedTokenHandler
 	HealthHandler    *handler.HealthHandler
 	LoggingMiddleware *middleware.LoggingMiddleware
 	MetricsMiddleware *middleware.MetricsMiddleware
@@ -23,6 +28,13 @@ type Config struct {
 	CORSConfig       middleware.CORSConfig
 	SwaggerEnabled   bool
 	SwaggerPath      string
+	AdminConfigEnabled bool
+	ExportEnabled      bool
+	CalendarEnabled    bool
+	DetailedHealthEnabled bool
+	// RateLimiter limits auth, read and write requests separately; nil
+	// disables rate limiting.
+	RateLimiter *middleware.RateLimiter
 }
 
 func NewRouter(cfg Config) *gin.Engine {
@@ -37,6 +49,9 @@ func NewRouter(cfg Config) *gin.Engine {
 	// CORS middleware
 	router.Use(middleware.CORSMiddleware(cfg.CORSConfig))
 	
+	// Request ID middleware, ahead of logging so every log line carries it
+	router.Use(middleware.RequestIDMiddleware())
+	
 	// Logging middleware
 	router.Use(cfg.LoggingMiddleware.Handler())
 	
@@ -48,43 +63,120 @@ func NewRouter(cfg Config) *gin.Engine {
 	{
 		// Health check
 		public.GET("/health", cfg.HealthHandler.Health)
+		public.GET("/ready", cfg.HealthHandler.Readiness)
+		if cfg.DetailedHealthEnabled {
+			public.GET("/health/detailed", cfg.HealthHandler.HealthDetailed)
+		}
 		
 		// Auth routes
 		auth := public.Group("/auth")
+		if cfg.RateLimiter != nil {
+			auth.Use(cfg.RateLimiter.Handler(middleware.RateLimitGroupAuth))
+		}
 		{
 			auth.POST("/register", cfg.AuthHandler.Register)
 			auth.POST("/login", cfg.AuthHandler.Login)
+			auth.POST("/refresh", cfg.AuthHandler.RefreshToken)
 			auth.POST("/validate", cfg.AuthHandler.ValidateToken)
+			auth.POST("/forgot-password", cfg.AuthHandler.ForgotPassword)
+			auth.POST("/reset-password", cfg.AuthHandler.ResetPassword)
+		}
+
+		// Feeds authenticate with a feed token, not a bearer token
+		if cfg.CalendarEnabled {
+			public.GET("/tasks/calendar.ics", cfg.CalendarHandler.Feed)
 		}
 	}
 	
 	// Protected routes (require authentication)
 	protected := router.Group("/api/v1")
 	protected.Use(cfg.AuthMiddleware.Handler())
+	if cfg.RateLimiter != nil {
+		protected.Use(cfg.RateLimiter.MethodHandler())
+	}
 	{
+		requireAdmin := middleware.RequireRole(middleware.RoleAdmin)
+
+		protected.POST("/auth/logout", cfg.AuthHandler.Logout)
+
 		// User routes
 		users := protected.Group("/users")
 		{
-			users.GET("", cfg.UserHandler.ListUsers)
-			users.POST("", cfg.UserHandler.CreateUser)
+			users.GET("", requireAdmin, cfg.UserHandler.ListUsers)
+			users.POST("", requireAdmin, cfg.UserHandler.CreateUser)
 			users.GET("/:id", cfg.UserHandler.GetUser)
-			users.PUT("/:id", cfg.UserHandler.UpdateUser)
-			users.DELETE("/:id", cfg.UserHandler.DeleteUser)
+			users.GET("/by-username/:username", cfg.UserHandler.GetUserByUsername)
+			users.POST("/profiles", cfg.UserHandler.BatchGetPublicProfiles)
+			users.GET("/:id/metrics", cfg.UserHandler.GetUserMetrics)
+			users.PUT("/:id", requireAdmin, cfg.UserHandler.UpdateUser)
+			users.DELETE("/:id", requireAdmin, cfg.UserHandler.DeleteUser)
 			users.GET("/me", cfg.UserHandler.GetCurrentUser)
 			users.PUT("/me", cfg.UserHandler.UpdateCurrentUser)
+			if cfg.ExportEnabled {
+				users.GET("/me/export", cfg.ExportHandler.ExportMyData)
+			}
+			users.GET("/me/feed-tokens", cfg.FeedTokenHandler.ListFeedTokens)
+			users.POST("/me/feed-tokens", cfg.FeedTokenHandler.CreateFeedToken)
+			users.DELETE("/me/feed-tokens/:id", cfg.FeedTokenHandler.RevokeFeedToken)
 		}
 		
 		// Task routes
 		tasks := protected.Group("/tasks")
 		{
-			tasks.GET("", cfg.TaskHandler.ListTasks)
+			tasks.GET("", requireAdmin, cfg.TaskHandler.ListTasks)
+			tasks.GET("/modified-by/:user_id", requireAdmin, cfg.TaskHandler.ListTasksModifiedBy)
 			tasks.POST("", cfg.TaskHandler.CreateTask)
 			tasks.GET("/:id", cfg.TaskHandler.GetTask)
+			tasks.POST("/:id/restore", cfg.TaskHandler.RestoreTask)
+			tasks.POST("/:id/complete", cfg.TaskHandler.CompleteTask)
+			tasks.POST("/:id/archive", cfg.TaskHandler.ArchiveTask)
+			tasks.POST("/:id/unarchive", cfg.TaskHandler.UnarchiveTask)
+			tasks.PATCH("/:id/board-move", cfg.TaskHandler.MoveTaskOnBoard)
+			tasks.PATCH("/:id/position", cfg.TaskHandler.ReorderTask)
 			tasks.PUT("/:id", cfg.TaskHandler.UpdateTask)
 			tasks.DELETE("/:id", cfg.TaskHandler.DeleteTask)
+			tasks.DELETE("/:id/purge", cfg.TaskHandler.PurgeTask)
 			
 			// User-specific task routes
 			tasks.GET("/me", cfg.TaskHandler.ListMyTasks)
+			tasks.GET("/search", cfg.TaskHandler.SearchTasks)
+			tasks.GET("/board", cfg.TaskHandler.GetBoard)
+			tasks.GET("/needs-attention", cfg.TaskHandler.ListTasksNeedingAttention)
+			tasks.GET("/overdue", cfg.TaskHandler.ListOverdueTasks)
+			tasks.GET("/trash", cfg.TaskHandler.ListDeletedTasks)
+			tasks.GET("/count", cfg.TaskHandler.CountTasks)
+			tasks.GET("/matrix", cfg.TaskHandler.GetTaskMatrix)
+			tasks.GET("/stats", cfg.TaskHandler.GetTaskStats)
+			tasks.GET("/trends", cfg.TaskHandler.GetCompletionTrends)
+			tasks.POST("/mark-all-seen", cfg.TaskHandler.MarkAllSeen)
+			tasks.GET("/unread-count", cfg.TaskHandler.GetUnreadCount)
+			if cfg.ExportEnabled {
+				tasks.POST("/export-selected", cfg.ExportHandler.ExportSelectedTasks)
+			}
+			tasks.PATCH("/bulk-due-date", cfg.TaskHandler.BulkSetDueDate)
+			tasks.PATCH("/bulk-tags", cfg.TaskHandler.BulkTags)
+			tasks.POST("/snooze-overdue", cfg.TaskHandler.SnoozeOverdue)
+			tasks.DELETE("/completed", cfg.TaskHandler.DeleteCompletedTasks)
+			tasks.POST("/restore-all", cfg.TaskHandler.RestoreAllTasks)
+			tasks.POST("/preview-recurrence", cfg.TaskHandler.PreviewRecurrence)
+		}
+		
+		// Webhook routes
+		webhooks := protected.Group("/webhooks")
+		{
+			webhooks.GET("", cfg.WebhookHandler.ListWebhooks)
+			webhooks.POST("", cfg.WebhookHandler.CreateWebhook)
+			webhooks.GET("/:id", cfg.WebhookHandler.GetWebhook)
+			webhooks.PUT("/:id", cfg.WebhookHandler.UpdateWebhook)
+			webhooks.DELETE("/:id", cfg.WebhookHandler.DeleteWebhook)
+		}
+		
+		// Admin routes
+		if cfg.AdminConfigEnabled {
+			admin := protected.Group("/admin", requireAdmin)
+			{
+				admin.GET("/config", cfg.AdminHandler.GetConfig)
+			}
 		}
 	}
 	
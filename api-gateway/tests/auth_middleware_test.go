@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJWTSecret = "test-secret"
+
+func signTestToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	claims["user_id"] = "user-1"
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthMiddleware_OnlyAcceptsAccessTokens(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.NewAuthMiddleware(&recordingUserClient{}, testJWTSecret).Handler())
+	router.GET("/api/v1/me", func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString("user_id"))
+	})
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   int
+	}{
+		{"access token", jwt.MapClaims{}, http.StatusOK},
+		{"refresh token", jwt.MapClaims{"token_type": "refresh"}, http.StatusUnauthorized},
+		{"password reset token", jwt.MapClaims{"token_type": "password_reset"}, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+			req.Header.Set("Authorization", "Bearer "+signTestToken(t, tt.claims))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+// setDenylist is an in-memory middleware.TokenDenylist.
+type setDenylist map[string]bool
+
+func (d setDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return d[jti], nil
+}
+
+func TestAuthMiddleware_RejectsRevokedTokens(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	authMiddleware := middleware.NewAuthMiddleware(&recordingUserClient{}, testJWTSecret)
+	authMiddleware.SetDenylist(setDenylist{"revoked-jti": true})
+	router := gin.New()
+	router.Use(authMiddleware.Handler())
+	router.GET("/api/v1/me", func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString("user_id"))
+	})
+
+	tests := []struct {
+		name string
+		jti  string
+		want int
+	}{
+		{"revoked token", "revoked-jti", http.StatusUnauthorized},
+		{"other token", "live-jti", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+			req.Header.Set("Authorization", "Bearer "+signTestToken(t, jwt.MapClaims{"jti": tt.jti}))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newCalendarRouter(todoClient *fakeTodoClient) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	userClient := newFeedTokenUserClient()
+	userClient.tokens["feed-token"] = &pb.FeedToken{Id: "feed-token-1", UserId: "user-1"}
+	calendarHandler := handler.NewCalendarHandler(userClient, todoClient, 2)
+
+	router := gin.New()
+	router.GET("/api/v1/tasks/calendar.ics", calendarHandler.Feed)
+	return router
+}
+
+func TestCalendarFeed(t *testing.T) {
+	due := time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+	todoClient := &fakeTodoClient{tasks: []*pb.Task{
+		{Id: "task-1", UserId: "user-1", Title: "Pay rent, utilities; etc", DueDate: timestamppb.New(due)},
+		{Id: "task-2", UserId: "user-1", Title: "Write report", Description: "line one\nline two", DueDate: timestamppb.New(due.Add(24 * time.Hour))},
+		{Id: "task-3", UserId: "user-1", Title: strings.Repeat("long title ", 10), DueDate: timestamppb.New(due.Add(48 * time.Hour))},
+	}}
+	router := newCalendarRouter(todoClient)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/tasks/calendar.ics?token=feed-token", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+	// Fetched in pages of two, restricted to tasks with a due date
+	require.Len(t, todoClient.requests, 2)
+	assert.Equal(t, "user-1", todoClient.requests[0].UserId)
+	assert.NotNil(t, todoClient.requests[0].DueAfter)
+
+	body := recorder.Body.String()
+	require.True(t, strings.HasSuffix(body, "\r\n"))
+	lines := strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n")
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+	assert.Contains(t, lines, "VERSION:2.0")
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 75, "line %q is not folded", line)
+		assert.NotContains(t, line, "\n")
+	}
+
+	assert.Equal(t, 3, strings.Count(body, "BEGIN:VEVENT\r\n"))
+	assert.Equal(t, 3, strings.Count(body, "END:VEVENT\r\n"))
+	assert.Contains(t, lines, "UID:task-1@task-manager")
+	assert.Contains(t, lines, "DTSTART:20260314T093000Z")
+	assert.Contains(t, lines, `SUMMARY:Pay rent\, utilities\; etc`)
+	assert.Contains(t, lines, `DESCRIPTION:line one\nline two`)
+
+	// Unfolding restores the long summary
+	unfolded := strings.ReplaceAll(body, "\r\n ", "")
+	assert.Contains(t, unfolded, "SUMMARY:"+strings.Repeat("long title ", 10)+"\r\n")
+}
+
+func TestCalendarFeed_RejectsBadToken(t *testing.T) {
+	router := newCalendarRouter(&fakeTodoClient{})
+
+	for _, target := range []string{"/api/v1/tasks/calendar.ics", "/api/v1/tasks/calendar.ics?token=wrong"} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code, target)
+	}
+}
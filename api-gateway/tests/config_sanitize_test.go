@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeMasksSensitiveFields(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{Port: 8080, GracefulShutdownTimeout: 10 * time.Second},
+		Services: config.ServicesConfig{
+			User: config.ServiceConfig{Host: "user-service", AuthToken: "user-token"},
+			Todo: config.ServiceConfig{Host: "todo-service"},
+		},
+		JWT: config.JWTConfig{Secret: "jwt-secret", TokenLifetime: 24 * time.Hour},
+	}
+
+	sanitized := config.Sanitize(cfg)
+
+	// Inspect the JSON the endpoint would serve
+	raw, err := json.Marshal(sanitized)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "jwt-secret")
+	assert.NotContains(t, string(raw), "user-token")
+
+	var out map[string]map[string]any
+	require.NoError(t, json.Unmarshal(raw, &out))
+
+	assert.Equal(t, config.RedactedValue, out["jwt"]["secret"])
+	assert.Equal(t, "24h0m0s", out["jwt"]["tokenlifetime"])
+	assert.Equal(t, float64(8080), out["server"]["port"])
+
+	services := out["services"]
+	assert.Equal(t, config.RedactedValue, services["user"].(map[string]any)["auth_token"])
+	assert.Equal(t, "user-service", services["user"].(map[string]any)["host"])
+	// An unset secret stays visibly unset
+	assert.Equal(t, "", services["todo"].(map[string]any)["auth_token"])
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_RejectsInsecureJWTSecretInProduction(t *testing.T) {
+	withSecret := func(secret string) *config.Config {
+		return &config.Config{JWT: config.JWTConfig{Secret: secret, MinSecretLength: 32}}
+	}
+
+	for _, secret := range []string{config.DefaultJWTSecret, "short-secret"} {
+		err := withSecret(secret).Validate("production")
+
+		var insecure *config.InsecureJWTSecretError
+		assert.True(t, errors.As(err, &insecure), "secret %q", secret)
+	}
+
+	assert.NoError(t, withSecret(strings.Repeat("k", 32)).Validate("production"))
+	assert.NoError(t, withSecret(config.DefaultJWTSecret).Validate("development"))
+	assert.NoError(t, withSecret(config.DefaultJWTSecret).Validate(""))
+}
+
+func TestValidate_RejectsWeakJWTSecretInAnyEnvironment(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWTConfig{MinSecretLength: 32, MinSecretDistinct: 10}}
+
+	for _, secret := range []string{"too-short", strings.Repeat("ab", 20)} {
+		cfg.JWT.Secret = secret
+		err := cfg.Validate("development")
+
+		var insecure *config.InsecureJWTSecretError
+		assert.True(t, errors.As(err, &insecure), "secret %q", secret)
+	}
+
+	cfg.JWT.Secret = "Zq7vN3kR9tYw2LmB5xHc8PfD4sGj6AeU"
+	assert.NoError(t, cfg.Validate("development"))
+}
@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUserClient and fakeTodoClient implement only what the export needs;
+// any other call panics through the nil embedded interface.
+type fakeUserClient struct {
+	client.UserClient
+	user *pb.User
+}
+
+func (f *fakeUserClient) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	return &pb.GetUserResponse{User: f.user}, nil
+}
+
+type fakeTodoClient struct {
+	client.TodoClient
+	tasks    []*pb.Task
+	requests []*pb.ListTasksByUserRequest
+}
+
+func (f *fakeTodoClient) ListTasksByUser(ctx context.Context, req *pb.ListTasksByUserRequest) (*pb.ListTasksByUserResponse, error) {
+	f.requests = append(f.requests, req)
+
+	start := int((req.Page - 1) * req.PageSize)
+	end := min(start+int(req.PageSize), len(f.tasks))
+	if start > end {
+		start = end
+	}
+	return &pb.ListTasksByUserResponse{
+		Tasks:    f.tasks[start:end],
+		Total:    int32(len(f.tasks)),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+func TestExportMyData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userClient := &fakeUserClient{user: &pb.User{Id: "user-1", Username: "alice", Email: "alice@example.com"}}
+	todoClient := &fakeTodoClient{tasks: []*pb.Task{
+		{Id: "task-1", UserId: "user-1", Title: "First"},
+		{Id: "task-2", UserId: "user-1", Title: "Second"},
+		{Id: "task-3", UserId: "user-1", Title: "Third"},
+	}}
+	exportHandler := handler.NewExportHandler(userClient, todoClient, 2, 10)
+
+	router := gin.New()
+	router.GET("/api/v1/users/me/export", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+		exportHandler.ExportMyData(c)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users/me/export", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment")
+	assert.NotContains(t, recorder.Body.String(), "password")
+
+	var bundle struct {
+		User  handler.UserResponse   `json:"user"`
+		Tasks []handler.TaskResponse `json:"tasks"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &bundle))
+
+	assert.Equal(t, "user-1", bundle.User.ID)
+	assert.Equal(t, "alice@example.com", bundle.User.Email)
+	if assert.Len(t, bundle.Tasks, 3) {
+		assert.Equal(t, "First", bundle.Tasks[0].Title)
+		assert.Equal(t, "Third", bundle.Tasks[2].Title)
+	}
+
+	// Tasks were fetched in batches rather than all at once
+	assert.Len(t, todoClient.requests, 2)
+	// Archived tasks are the user's data too
+	for _, req := range todoClient.requests {
+		assert.True(t, req.IncludeArchived)
+	}
+}
+
+// GetTasksByIDs answers like the todo service: every existing task in
+// request order, whoever owns it.
+func (f *fakeTodoClient) GetTasksByIDs(ctx context.Context, req *pb.GetTasksByIDsRequest) (*pb.GetTasksByIDsResponse, error) {
+	resp := &pb.GetTasksByIDsResponse{}
+	for _, id := range req.Ids {
+		for _, task := range f.tasks {
+			if task.Id == id {
+				resp.Tasks = append(resp.Tasks, task)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func serveExportSelected(todoClient client.TodoClient, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	exportHandler := handler.NewExportHandler(nil, todoClient, 2, 3)
+	router := gin.New()
+	router.POST("/api/v1/tasks/export-selected", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+		exportHandler.ExportSelectedTasks(c)
+	})
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/export-selected", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestExportSelectedTasks_OnlyOwnedRequestedTasks(t *testing.T) {
+	todoClient := &fakeTodoClient{tasks: []*pb.Task{
+		{Id: "task-1", UserId: "user-1", Title: "Mine, requested"},
+		{Id: "task-2", UserId: "user-1", Title: "Mine, not requested"},
+		{Id: "task-3", UserId: "user-2", Title: "Someone else's"},
+		{Id: "task-4", UserId: "user-1", Title: "Mine, also requested", Tags: []string{"home", "weekly"}},
+	}}
+
+	recorder := serveExportSelected(todoClient, `{"ids":["task-4","task-3","task-1"]}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Disposition"), "tasks.json")
+
+	var bundle struct {
+		Tasks []handler.TaskResponse `json:"tasks"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &bundle))
+	var ids []string
+	for _, task := range bundle.Tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.Equal(t, []string{"task-4", "task-1"}, ids)
+
+	recorder = serveExportSelected(todoClient, `{"ids":["task-4","task-3","missing"],"format":"csv"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/csv")
+	rows, err := csv.NewReader(recorder.Body).ReadAll()
+	require.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, "id", rows[0][0])
+		assert.Equal(t, "task-4", rows[1][0])
+		assert.Equal(t, "home;weekly", rows[1][6])
+	}
+}
+
+func TestExportSelectedTasks_RejectsInvalidSelections(t *testing.T) {
+	todoClient := &fakeTodoClient{}
+
+	assert.Equal(t, http.StatusBadRequest, serveExportSelected(todoClient, `{"ids":[]}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveExportSelected(todoClient, `{"ids":["a","b","c","d"]}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveExportSelected(todoClient, `{"ids":["a"],"format":"xml"}`).Code)
+}
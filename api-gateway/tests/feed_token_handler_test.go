@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// feedTokenUserClient keeps feed tokens in memory, keyed by token.
+type feedTokenUserClient struct {
+	client.UserClient
+	tokens map[string]*pb.FeedToken
+	issued int
+}
+
+func newFeedTokenUserClient() *feedTokenUserClient {
+	return &feedTokenUserClient{tokens: make(map[string]*pb.FeedToken)}
+}
+
+func (f *feedTokenUserClient) CreateFeedToken(ctx context.Context, req *pb.CreateFeedTokenRequest) (*pb.CreateFeedTokenResponse, error) {
+	f.issued++
+	token := fmt.Sprintf("token-%d", f.issued)
+	feedToken := &pb.FeedToken{
+		Id:        fmt.Sprintf("feed-token-%d", f.issued),
+		UserId:    req.UserId,
+		Name:      req.Name,
+		CreatedAt: timestamppb.Now(),
+	}
+	f.tokens[token] = feedToken
+	return &pb.CreateFeedTokenResponse{FeedToken: feedToken, Token: token}, nil
+}
+
+func (f *feedTokenUserClient) ListFeedTokens(ctx context.Context, req *pb.ListFeedTokensRequest) (*pb.ListFeedTokensResponse, error) {
+	resp := &pb.ListFeedTokensResponse{}
+	for _, feedToken := range f.tokens {
+		if feedToken.UserId == req.UserId {
+			resp.FeedTokens = append(resp.FeedTokens, feedToken)
+		}
+	}
+	return resp, nil
+}
+
+func (f *feedTokenUserClient) RevokeFeedToken(ctx context.Context, req *pb.RevokeFeedTokenRequest) (*pb.RevokeFeedTokenResponse, error) {
+	for token, feedToken := range f.tokens {
+		if feedToken.Id == req.Id && feedToken.UserId == req.UserId {
+			delete(f.tokens, token)
+			return &pb.RevokeFeedTokenResponse{Success: true}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "feed token not found")
+}
+
+func (f *feedTokenUserClient) ValidateFeedToken(ctx context.Context, req *pb.ValidateFeedTokenRequest) (*pb.ValidateFeedTokenResponse, error) {
+	feedToken, ok := f.tokens[req.Token]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid feed token")
+	}
+	return &pb.ValidateFeedTokenResponse{User: &pb.User{Id: feedToken.UserId}}, nil
+}
+
+// ValidateToken only knows access tokens, as in the user service.
+func (f *feedTokenUserClient) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
+	return nil, status.Error(codes.Unauthenticated, "invalid token")
+}
+
+func TestFeedTokens_CreateUseRevoke(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userClient := newFeedTokenUserClient()
+	feedTokenHandler := handler.NewFeedTokenHandler(userClient)
+	calendarHandler := handler.NewCalendarHandler(userClient, &fakeTodoClient{}, 10)
+
+	router := gin.New()
+	router.GET("/api/v1/tasks/calendar.ics", calendarHandler.Feed)
+	me := router.Group("/api/v1/users/me", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+	})
+	me.GET("/feed-tokens", feedTokenHandler.ListFeedTokens)
+	me.POST("/feed-tokens", feedTokenHandler.CreateFeedToken)
+	me.DELETE("/feed-tokens/:id", feedTokenHandler.RevokeFeedToken)
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	// Create
+	recorder := serve(http.MethodPost, "/api/v1/users/me/feed-tokens", `{"name": "Phone calendar"}`)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+
+	var created handler.FeedTokenResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &created))
+	assert.Equal(t, "Phone calendar", created.Name)
+	require.NotEmpty(t, created.Token)
+
+	// The token is not shown again
+	recorder = serve(http.MethodGet, "/api/v1/users/me/feed-tokens", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var listed handler.ListFeedTokensResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listed))
+	require.Len(t, listed.FeedTokens, 1)
+	assert.Equal(t, created.ID, listed.FeedTokens[0].ID)
+	assert.Empty(t, listed.FeedTokens[0].Token)
+
+	// Use
+	feedURL := "/api/v1/tasks/calendar.ics?token=" + created.Token
+	recorder = serve(http.MethodGet, feedURL, "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "BEGIN:VCALENDAR")
+
+	// Revoke
+	recorder = serve(http.MethodDelete, "/api/v1/users/me/feed-tokens/"+created.ID, "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = serve(http.MethodGet, feedURL, "")
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder = serve(http.MethodDelete, "/api/v1/users/me/feed-tokens/"+created.ID, "")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestFeedToken_NotAcceptedByAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userClient := newFeedTokenUserClient()
+	created, err := userClient.CreateFeedToken(context.Background(), &pb.CreateFeedTokenRequest{UserId: "user-1"})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(middleware.NewAuthMiddleware(userClient, testJWTSecret).Handler())
+	router.POST("/api/v1/tasks", func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	// Neither as a bearer token nor the way feeds receive it
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", nil)
+	req.Header.Set("Authorization", "Bearer "+created.Token)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/tasks?token="+created.Token, nil))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestCreateFeedToken_RejectsLongName(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	feedTokenHandler := handler.NewFeedTokenHandler(newFeedTokenUserClient())
+
+	router := gin.New()
+	router.POST("/api/v1/users/me/feed-tokens", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+		feedTokenHandler.CreateFeedToken(c)
+	})
+
+	body := fmt.Sprintf(`{"name": %q}`, strings.Repeat("a", 101))
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/users/me/feed-tokens", strings.NewReader(body)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
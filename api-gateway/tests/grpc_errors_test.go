@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingUserClient struct {
+	client.UserClient
+	err error
+}
+
+func (f *failingUserClient) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	return nil, f.err
+}
+
+func TestGRPCErrorMapping(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    int
+		wantMessage string
+	}{
+		{"not found", status.Error(codes.NotFound, "user not found"), http.StatusNotFound, "user not found"},
+		{"already exists", status.Error(codes.AlreadyExists, "email already taken"), http.StatusConflict, "email already taken"},
+		{"aborted", status.Error(codes.Aborted, "task was modified"), http.StatusConflict, "task was modified"},
+		{"invalid argument", status.Error(codes.InvalidArgument, "invalid id"), http.StatusBadRequest, "invalid id"},
+		{"permission denied", status.Error(codes.PermissionDenied, "not allowed"), http.StatusForbidden, "not allowed"},
+		{"unauthenticated", status.Error(codes.Unauthenticated, "invalid gateway token"), http.StatusUnauthorized, "invalid gateway token"},
+		{"internal", status.Error(codes.Internal, "failed to get user"), http.StatusInternalServerError, "Internal server error"},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), http.StatusInternalServerError, "Internal server error"},
+		{"non-gRPC error", errors.New("boom"), http.StatusInternalServerError, "Internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userHandler := handler.NewUserHandler(&failingUserClient{err: tt.err}, nil, false)
+
+			router := gin.New()
+			router.GET("/api/v1/users/:id", userHandler.GetUser)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users/user-1", nil))
+
+			var body map[string]string
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantMessage, body["error"])
+		})
+	}
+}
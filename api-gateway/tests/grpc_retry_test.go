@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyTodoServer fails the first failures GetTask calls with UNAVAILABLE
+// and sleeps delay before answering the rest.
+type flakyTodoServer struct {
+	pb.UnimplementedTodoServiceServer
+	failures int32
+	delay    time.Duration
+	calls    atomic.Int32
+}
+
+func (s *flakyTodoServer) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
+	if s.calls.Add(1) <= s.failures {
+		return nil, status.Error(codes.Unavailable, "try again")
+	}
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &pb.GetTaskResponse{Task: &pb.Task{Id: req.Id}}, nil
+}
+
+func newFlakyTodoClient(t *testing.T, server *flakyTodoServer, timeout time.Duration, retry client.RetryConfig) client.TodoClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterTodoServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	todoClient, err := client.NewTodoClient(client.TodoConfig{
+		Host:    "127.0.0.1",
+		Port:    listener.Addr().(*net.TCPAddr).Port,
+		Timeout: timeout,
+		Retry:   retry,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { todoClient.Close() })
+	return todoClient
+}
+
+var testRetry = client.RetryConfig{
+	MaxAttempts:       3,
+	InitialBackoff:    10 * time.Millisecond,
+	MaxBackoff:        50 * time.Millisecond,
+	BackoffMultiplier: 2,
+}
+
+func TestTodoClient_RetriesUnavailable(t *testing.T) {
+	server := &flakyTodoServer{failures: 1}
+	todoClient := newFlakyTodoClient(t, server, 5*time.Second, testRetry)
+
+	resp, err := todoClient.GetTask(context.Background(), &pb.GetTaskRequest{Id: "task-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "task-1", resp.Task.Id)
+	assert.Equal(t, int32(2), server.calls.Load())
+}
+
+func TestTodoClient_RetriesDisabled(t *testing.T) {
+	server := &flakyTodoServer{failures: 1}
+	todoClient := newFlakyTodoClient(t, server, 5*time.Second, client.RetryConfig{MaxAttempts: 1})
+
+	_, err := todoClient.GetTask(context.Background(), &pb.GetTaskRequest{Id: "task-1"})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+func TestTodoClient_CallsTimeOut(t *testing.T) {
+	server := &flakyTodoServer{delay: time.Second}
+	todoClient := newFlakyTodoClient(t, server, 50*time.Millisecond, testRetry)
+
+	start := time.Now()
+	_, err := todoClient.GetTask(context.Background(), &pb.GetTaskRequest{Id: "task-1"})
+
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestNewTodoClient_UnreachableFailsWithinTimeout(t *testing.T) {
+	// Reserve a port and free it so nothing is listening there
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	start := time.Now()
+	_, err = client.NewTodoClient(client.TodoConfig{Host: "127.0.0.1", Port: port, Timeout: 200 * time.Millisecond})
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestNewUserClient_UnreachableFailsWithinTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	start := time.Now()
+	_, err = client.NewUserClient(client.UserConfig{Host: "127.0.0.1", Port: port, Timeout: 200 * time.Millisecond})
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type healthUserClient struct {
+	client.UserClient
+	checkErr   error
+	components map[string]string
+}
+
+func (f *healthUserClient) CheckHealth(ctx context.Context) error {
+	return f.checkErr
+}
+
+func (f *healthUserClient) GetHealthDetails(ctx context.Context, req *pb.GetUserHealthDetailsRequest) (*pb.GetUserHealthDetailsResponse, error) {
+	return &pb.GetUserHealthDetailsResponse{Components: f.components}, nil
+}
+
+type healthTodoClient struct {
+	client.TodoClient
+	checkErr   error
+	components map[string]string
+}
+
+func (f *healthTodoClient) CheckHealth(ctx context.Context) error {
+	return f.checkErr
+}
+
+func (f *healthTodoClient) GetHealthDetails(ctx context.Context, req *pb.GetTodoHealthDetailsRequest) (*pb.GetTodoHealthDetailsResponse, error) {
+	return &pb.GetTodoHealthDetailsResponse{Components: f.components}, nil
+}
+
+type detailedHealthBody struct {
+	Status     string            `json:"status"`
+	Components map[string]string `json:"components"`
+}
+
+func serveDetailedHealth(t *testing.T, userClient client.UserClient, todoClient client.TodoClient) (int, detailedHealthBody) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/api/v1/health/detailed", handler.NewHealthHandler(userClient, todoClient, time.Second).HealthDetailed)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/health/detailed", nil))
+
+	var body detailedHealthBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return w.Code, body
+}
+
+func TestHealthDetailed(t *testing.T) {
+	t.Run("all healthy", func(t *testing.T) {
+		code, body := serveDetailedHealth(t,
+			&healthUserClient{components: map[string]string{"database": "ok", "redis": "disabled"}},
+			&healthTodoClient{components: map[string]string{"database": "ok", "redis": "ok"}},
+		)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", body.Status)
+		assert.Equal(t, map[string]string{
+			"user-service":          "ok",
+			"user-service.database": "ok",
+			"user-service.redis":    "disabled",
+			"todo-service":          "ok",
+			"todo-service.database": "ok",
+			"todo-service.redis":    "ok",
+		}, body.Components)
+	})
+
+	t.Run("unhealthy dependency", func(t *testing.T) {
+		code, body := serveDetailedHealth(t,
+			&healthUserClient{components: map[string]string{"database": "ok"}},
+			&healthTodoClient{components: map[string]string{"database": "ok", "redis": "unavailable"}},
+		)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "degraded", body.Status)
+		assert.Equal(t, "ok", body.Components["todo-service"])
+		assert.Equal(t, "unavailable", body.Components["todo-service.redis"])
+	})
+
+	t.Run("unreachable service", func(t *testing.T) {
+		code, body := serveDetailedHealth(t,
+			&healthUserClient{checkErr: errors.New("connection refused")},
+			&healthTodoClient{components: map[string]string{"database": "ok"}},
+		)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "degraded", body.Status)
+		assert.Equal(t, "unavailable", body.Components["user-service"])
+		assert.NotContains(t, body.Components, "user-service.database")
+	})
+}
+
+type readinessBody struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services"`
+}
+
+func serveReadiness(t *testing.T, userClient client.UserClient, todoClient client.TodoClient) (int, readinessBody) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/api/v1/ready", handler.NewHealthHandler(userClient, todoClient, time.Second).Readiness)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/ready", nil))
+
+	var body readinessBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return w.Code, body
+}
+
+func TestReadiness(t *testing.T) {
+	t.Run("all serving", func(t *testing.T) {
+		code, body := serveReadiness(t, &healthUserClient{}, &healthTodoClient{})
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ready", body.Status)
+		assert.Equal(t, map[string]string{"user-service": "ok", "todo-service": "ok"}, body.Services)
+	})
+
+	t.Run("backend not serving", func(t *testing.T) {
+		code, body := serveReadiness(t,
+			&healthUserClient{},
+			&healthTodoClient{checkErr: errors.New("todo-service is NOT_SERVING")},
+		)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "not_ready", body.Status)
+		assert.Equal(t, map[string]string{"user-service": "ok", "todo-service": "unavailable"}, body.Services)
+	})
+}
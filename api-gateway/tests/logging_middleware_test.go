@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedRouter(t *testing.T, threshold time.Duration, delay time.Duration, skipPaths ...string) (*gin.Engine, *observer.ObservedLogs) {
+	t.Helper()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	restore := zap.ReplaceGlobals(zap.New(core))
+	t.Cleanup(restore)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.NewLoggingMiddleware(threshold, skipPaths).Handler())
+	router.GET("/work", func(c *gin.Context) {
+		time.Sleep(delay)
+		c.Status(http.StatusOK)
+	})
+	router.GET("/health", func(c *gin.Context) {
+		if c.Query("fail") != "" {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unhealthy"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"status": "healthy"})
+	})
+
+	return router, logs
+}
+
+func TestLoggingMiddleware_WarnsOnSlowRequest(t *testing.T) {
+	router, logs := newObservedRouter(t, 10*time.Millisecond, 30*time.Millisecond)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/work", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	slow := logs.FilterMessage("Slow HTTP request").All()
+	if assert.Len(t, slow, 1) {
+		entry := slow[0]
+		assert.Equal(t, zapcore.WarnLevel, entry.Level)
+		fields := entry.ContextMap()
+		assert.Equal(t, http.MethodGet, fields["method"])
+		assert.Equal(t, "/work", fields["path"])
+		assert.GreaterOrEqual(t, fields["duration"], 30*time.Millisecond)
+	}
+}
+
+func TestLoggingMiddleware_NoWarningForFastRequest(t *testing.T) {
+	router, logs := newObservedRouter(t, time.Second, 0)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/work", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Zero(t, logs.FilterMessage("Slow HTTP request").Len())
+}
+
+func TestLoggingMiddleware_ZeroThresholdDisablesWarning(t *testing.T) {
+	router, logs := newObservedRouter(t, 0, 5*time.Millisecond)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/work", nil))
+
+	assert.Zero(t, logs.FilterMessage("Slow HTTP request").Len())
+}
+
+func TestLoggingMiddleware_AccessLogFields(t *testing.T) {
+	router, logs := newObservedRouter(t, 0, 0)
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set("User-Agent", "probe/1.0")
+	req.RemoteAddr = "203.0.113.7:40000"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	completed := logs.FilterMessage("HTTP request completed").All()
+	if assert.Len(t, completed, 1) {
+		fields := completed[0].ContextMap()
+		assert.Equal(t, http.MethodGet, fields["method"])
+		assert.Equal(t, "/health", fields["path"])
+		assert.Equal(t, int64(http.StatusOK), fields["status"])
+		assert.Equal(t, int64(w.Body.Len()), fields["response_bytes"])
+		assert.Equal(t, "203.0.113.7", fields["client_ip"])
+		assert.Equal(t, "probe/1.0", fields["user_agent"])
+		assert.Contains(t, fields, "duration")
+	}
+}
+
+func TestLoggingMiddleware_SkipPathsOnlyLogServerErrors(t *testing.T) {
+	router, logs := newObservedRouter(t, 0, 0, "/health")
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Zero(t, logs.Len())
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health?fail=1", nil))
+	failed := logs.FilterMessage("HTTP request failed with server error").All()
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, int64(http.StatusServiceUnavailable), failed[0].ContextMap()["status"])
+	}
+
+	// Other paths are logged as usual
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/work", nil))
+	assert.Equal(t, 1, logs.FilterMessage("HTTP request completed").Len())
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRateLimitedRouter mirrors the gateway layout: a public auth group and
+// a protected group whose user comes from the X-User header.
+func newRateLimitedRouter(limits map[string]middleware.RateLimit) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	limiter := middleware.NewRateLimiter(limits)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	router := gin.New()
+	auth := router.Group("/api/v1/auth", limiter.Handler(middleware.RateLimitGroupAuth))
+	auth.POST("/login", ok)
+
+	protected := router.Group("/api/v1", func(c *gin.Context) {
+		c.Set("user_id", c.GetHeader("X-User"))
+	}, limiter.MethodHandler())
+	protected.GET("/tasks/me", ok)
+	protected.POST("/tasks", ok)
+
+	return router
+}
+
+func sendRateLimited(router *gin.Engine, method, path, userID, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = remoteAddr
+	if userID != "" {
+		req.Header.Set("X-User", userID)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimiter_GroupsAreIndependent(t *testing.T) {
+	// Refills are far too slow to matter during the test
+	router := newRateLimitedRouter(map[string]middleware.RateLimit{
+		middleware.RateLimitGroupAuth:   {RequestsPerMinute: 1, Burst: 1},
+		middleware.RateLimitGroupReads:  {RequestsPerMinute: 1, Burst: 3},
+		middleware.RateLimitGroupWrites: {RequestsPerMinute: 1, Burst: 2},
+	})
+	const ip = "203.0.113.7:1234"
+
+	// Exhaust writes
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodPost, "/api/v1/tasks", "alice", ip).Code)
+	}
+	limited := sendRateLimited(router, http.MethodPost, "/api/v1/tasks", "alice", ip)
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "60", limited.Header().Get("Retry-After"))
+
+	// Exhaust auth from the same address
+	assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodPost, "/api/v1/auth/login", "", ip).Code)
+	assert.Equal(t, http.StatusTooManyRequests, sendRateLimited(router, http.MethodPost, "/api/v1/auth/login", "", ip).Code)
+
+	// Reads still have their own budget
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodGet, "/api/v1/tasks/me", "alice", ip).Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, sendRateLimited(router, http.MethodGet, "/api/v1/tasks/me", "alice", ip).Code)
+}
+
+func TestRateLimiter_KeysByUserThenIP(t *testing.T) {
+	router := newRateLimitedRouter(map[string]middleware.RateLimit{
+		middleware.RateLimitGroupAuth:   {RequestsPerMinute: 1, Burst: 1},
+		middleware.RateLimitGroupWrites: {RequestsPerMinute: 1, Burst: 1},
+	})
+
+	// Users behind one address get separate buckets
+	assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodPost, "/api/v1/tasks", "alice", "203.0.113.7:1234").Code)
+	assert.Equal(t, http.StatusTooManyRequests, sendRateLimited(router, http.MethodPost, "/api/v1/tasks", "alice", "203.0.113.8:1234").Code)
+	assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodPost, "/api/v1/tasks", "bob", "203.0.113.7:1234").Code)
+
+	// Anonymous requests fall back to the client address
+	assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodPost, "/api/v1/auth/login", "", "203.0.113.7:1234").Code)
+	assert.Equal(t, http.StatusTooManyRequests, sendRateLimited(router, http.MethodPost, "/api/v1/auth/login", "", "203.0.113.7:5678").Code)
+	assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodPost, "/api/v1/auth/login", "", "198.51.100.1:1234").Code)
+
+	// Groups without a limit are not limited
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, sendRateLimited(router, http.MethodGet, "/api/v1/tasks/me", "alice", "203.0.113.7:1234").Code)
+	}
+}
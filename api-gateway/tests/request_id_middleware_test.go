@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDTodoServer records the request ID each GetTask call arrives with.
+type requestIDTodoServer struct {
+	pb.UnimplementedTodoServiceServer
+	received chan string
+}
+
+func (s *requestIDTodoServer) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.received <- strings.Join(md.Get("x-request-id"), ",")
+	return &pb.GetTaskResponse{Task: &pb.Task{Id: req.Id}}, nil
+}
+
+func newRequestIDRouter(t *testing.T) (*gin.Engine, *requestIDTodoServer, *observer.ObservedLogs) {
+	t.Helper()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	restore := zap.ReplaceGlobals(zap.New(core))
+	t.Cleanup(restore)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &requestIDTodoServer{received: make(chan string, 1)}
+	grpcServer := grpc.NewServer()
+	pb.RegisterTodoServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	todoClient, err := client.NewTodoClient(client.TodoConfig{
+		Host:    "127.0.0.1",
+		Port:    listener.Addr().(*net.TCPAddr).Port,
+		Timeout: 5 * time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { todoClient.Close() })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+	router.Use(middleware.NewLoggingMiddleware(0, nil).Handler())
+	router.GET("/tasks/:id", func(c *gin.Context) {
+		if _, err := todoClient.GetTask(c.Request.Context(), &pb.GetTaskRequest{Id: c.Param("id")}); err != nil {
+			c.Status(http.StatusBadGateway)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	return router, server, logs
+}
+
+func loggedRequestID(t *testing.T, logs *observer.ObservedLogs) any {
+	t.Helper()
+	completed := logs.FilterMessage("HTTP request completed").All()
+	require.Len(t, completed, 1)
+	return completed[0].ContextMap()["request_id"]
+}
+
+func TestRequestIDMiddleware_ReusesClientID(t *testing.T) {
+	router, server, logs := newRequestIDRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/tasks/task-1", nil)
+	req.Header.Set(middleware.RequestIDHeader, "client-req-42")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "client-req-42", w.Header().Get(middleware.RequestIDHeader))
+	assert.Equal(t, "client-req-42", <-server.received)
+	assert.Equal(t, "client-req-42", loggedRequestID(t, logs))
+}
+
+func TestRequestIDMiddleware_GeneratesIDWhenMissing(t *testing.T) {
+	router, server, logs := newRequestIDRouter(t)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/tasks/task-1", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	requestID := w.Header().Get(middleware.RequestIDHeader)
+	assert.NotEmpty(t, requestID)
+	assert.Equal(t, requestID, <-server.received)
+	assert.Equal(t, requestID, loggedRequestID(t, logs))
+}
+
+func TestRequestIDMiddleware_ReplacesMalformedID(t *testing.T) {
+	router, server, _ := newRequestIDRouter(t)
+
+	for _, requestID := range []string{"has spaces", "line\nbreak", strings.Repeat("a", 129)} {
+		req := httptest.NewRequest(http.MethodGet, "/tasks/task-1", nil)
+		req.Header.Set(middleware.RequestIDHeader, requestID)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		got := w.Header().Get(middleware.RequestIDHeader)
+		assert.NotEmpty(t, got)
+		assert.NotEqual(t, requestID, got)
+		assert.Equal(t, got, <-server.received)
+	}
+}
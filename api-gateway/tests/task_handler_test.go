@@ -0,0 +1,513 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ownedTodoClient holds tasks keyed by id and enforces ownership in the
+// user-scoped calls the way the todo service does.
+type ownedTodoClient struct {
+	client.TodoClient
+	owners  map[string]string
+	deleted []string
+	// completedCleared lists the users whose completed tasks were deleted.
+	completedCleared []string
+	// recurring lists the tasks whose completion creates a next occurrence.
+	recurring []string
+	// archived lists the tasks currently archived.
+	archived []string
+	// purged lists the tasks permanently deleted.
+	purged []string
+	// trashCleared lists the users whose deleted tasks were all restored.
+	trashCleared []string
+	// tagged and untagged list the tags added and removed in bulk, per task.
+	tagged   map[string][]string
+	untagged map[string][]string
+}
+
+func (f *ownedTodoClient) owns(id, userID string) error {
+	owner, ok := f.owners[id]
+	if !ok || owner != userID {
+		return status.Error(codes.NotFound, "task not found")
+	}
+	return nil
+}
+
+func (f *ownedTodoClient) GetTaskByUser(ctx context.Context, req *pb.GetTaskByUserRequest) (*pb.GetTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	task := &pb.Task{Id: req.Id, UserId: req.UserId}
+	if slices.Contains(f.deleted, req.Id) {
+		if !req.IncludeDeleted {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		task.DeletedAt = timestamppb.New(time.Now())
+	}
+	return &pb.GetTaskResponse{Task: task}, nil
+}
+
+func (f *ownedTodoClient) DeleteTaskByUser(ctx context.Context, req *pb.DeleteTaskByUserRequest) (*pb.DeleteTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	f.deleted = append(f.deleted, req.Id)
+	return &pb.DeleteTaskResponse{Success: true}, nil
+}
+
+func (f *ownedTodoClient) PurgeTask(ctx context.Context, req *pb.PurgeTaskRequest) (*pb.DeleteTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(f.deleted, req.Id) {
+		return nil, status.Error(codes.NotFound, "deleted task not found")
+	}
+	f.purged = append(f.purged, req.Id)
+	return &pb.DeleteTaskResponse{Success: true}, nil
+}
+
+func (f *ownedTodoClient) DeleteCompletedTasks(ctx context.Context, req *pb.DeleteCompletedTasksRequest) (*pb.DeleteCompletedTasksResponse, error) {
+	f.completedCleared = append(f.completedCleared, req.UserId)
+	return &pb.DeleteCompletedTasksResponse{Deleted: 2}, nil
+}
+
+func (f *ownedTodoClient) RestoreAllTasks(ctx context.Context, req *pb.RestoreAllTasksRequest) (*pb.RestoreAllTasksResponse, error) {
+	f.trashCleared = append(f.trashCleared, req.UserId)
+	return &pb.RestoreAllTasksResponse{Restored: 3}, nil
+}
+
+func (f *ownedTodoClient) ListDeletedTasks(ctx context.Context, req *pb.ListDeletedTasksRequest) (*pb.ListDeletedTasksResponse, error) {
+	resp := &pb.ListDeletedTasksResponse{Page: req.Page, PageSize: req.PageSize}
+	for _, id := range f.deleted {
+		if f.owners[id] == req.UserId {
+			deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+			resp.Tasks = append(resp.Tasks, &pb.Task{Id: id, UserId: req.UserId, DeletedAt: timestamppb.New(deletedAt)})
+		}
+	}
+	resp.Total = int32(len(resp.Tasks))
+	return resp, nil
+}
+
+func (f *ownedTodoClient) ListTasksByUser(ctx context.Context, req *pb.ListTasksByUserRequest) (*pb.ListTasksByUserResponse, error) {
+	resp := &pb.ListTasksByUserResponse{Page: req.Page, PageSize: req.PageSize}
+	for _, id := range []string{"task-1", "task-2"} {
+		if f.owners[id] == req.UserId {
+			resp.Tasks = append(resp.Tasks, &pb.Task{Id: id, UserId: req.UserId, IsNew: id == "task-1"})
+		}
+	}
+	resp.Total = int32(len(resp.Tasks))
+	return resp, nil
+}
+
+func (f *ownedTodoClient) BulkAddTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error) {
+	return f.bulkTags(req, f.tagged)
+}
+
+func (f *ownedTodoClient) BulkRemoveTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error) {
+	return f.bulkTags(req, f.untagged)
+}
+
+func (f *ownedTodoClient) bulkTags(req *pb.BulkTagsRequest, into map[string][]string) (*pb.BulkTagsResponse, error) {
+	var updated int32
+	for _, id := range req.Ids {
+		if f.owns(id, req.UserId) == nil {
+			into[id] = append(into[id], req.Tags...)
+			updated++
+		}
+	}
+	return &pb.BulkTagsResponse{Updated: updated}, nil
+}
+
+func (f *ownedTodoClient) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	resp := &pb.CompleteTaskResponse{Completed: &pb.Task{Id: req.Id, UserId: req.UserId, Status: pb.TaskStatus_DONE}}
+	if slices.Contains(f.recurring, req.Id) {
+		resp.Next = &pb.Task{Id: req.Id + "-next", UserId: req.UserId, Status: pb.TaskStatus_TODO, ParentTaskId: req.Id}
+	}
+	return resp, nil
+}
+
+func (f *ownedTodoClient) ArchiveTask(ctx context.Context, req *pb.ArchiveTaskRequest) (*pb.ArchiveTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	f.archived = append(f.archived, req.Id)
+	return &pb.ArchiveTaskResponse{Task: &pb.Task{Id: req.Id, UserId: req.UserId, Status: pb.TaskStatus_ARCHIVED}}, nil
+}
+
+func (f *ownedTodoClient) UnarchiveTask(ctx context.Context, req *pb.UnarchiveTaskRequest) (*pb.UnarchiveTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	f.archived = slices.DeleteFunc(f.archived, func(id string) bool { return id == req.Id })
+	return &pb.UnarchiveTaskResponse{Task: &pb.Task{Id: req.Id, UserId: req.UserId, Status: pb.TaskStatus_TODO}}, nil
+}
+
+func (f *ownedTodoClient) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
+	return &pb.GetTaskResponse{Task: &pb.Task{Id: req.Id, UserId: f.owners[req.Id]}}, nil
+}
+
+func (f *ownedTodoClient) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+	f.deleted = append(f.deleted, req.Id)
+	return &pb.DeleteTaskResponse{Success: true}, nil
+}
+
+func serveTask(todoClient client.TodoClient, method, userID, role string) *httptest.ResponseRecorder {
+	return serveTaskAt(todoClient, method, "/api/v1/tasks/task-1", userID, role)
+}
+
+func serveTaskAt(todoClient client.TodoClient, method, target, userID, role string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	taskHandler := handler.NewTaskHandler(todoClient)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Set("user_role", role)
+	})
+	router.GET("/api/v1/tasks/me", taskHandler.ListMyTasks)
+	router.GET("/api/v1/tasks/:id", taskHandler.GetTask)
+	router.DELETE("/api/v1/tasks/completed", taskHandler.DeleteCompletedTasks)
+	router.DELETE("/api/v1/tasks/:id", taskHandler.DeleteTask)
+	router.DELETE("/api/v1/tasks/:id/purge", taskHandler.PurgeTask)
+	router.GET("/api/v1/tasks/trash", taskHandler.ListDeletedTasks)
+	router.POST("/api/v1/tasks/restore-all", taskHandler.RestoreAllTasks)
+	router.POST("/api/v1/tasks/:id/complete", taskHandler.CompleteTask)
+	router.POST("/api/v1/tasks/:id/archive", taskHandler.ArchiveTask)
+	router.POST("/api/v1/tasks/:id/unarchive", taskHandler.UnarchiveTask)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+	return recorder
+}
+
+func TestGetTask_OtherUsersTaskIsHidden(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	assert.Equal(t, http.StatusOK, serveTask(todoClient, http.MethodGet, "owner", middleware.RoleUser).Code)
+	assert.Equal(t, http.StatusNotFound, serveTask(todoClient, http.MethodGet, "intruder", middleware.RoleUser).Code)
+}
+
+func TestDeleteTask_OtherUsersTaskIsKept(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	recorder := serveTask(todoClient, http.MethodDelete, "intruder", middleware.RoleUser)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Empty(t, todoClient.deleted)
+
+	recorder = serveTask(todoClient, http.MethodDelete, "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []string{"task-1"}, todoClient.deleted)
+}
+
+func TestPurgeTask_OnlyTrashedTasks(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	// Live tasks have to be deleted first
+	recorder := serveTaskAt(todoClient, http.MethodDelete, "/api/v1/tasks/task-1/purge", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Empty(t, todoClient.purged)
+
+	assert.Equal(t, http.StatusOK, serveTask(todoClient, http.MethodDelete, "owner", middleware.RoleUser).Code)
+
+	recorder = serveTaskAt(todoClient, http.MethodDelete, "/api/v1/tasks/task-1/purge", "intruder", middleware.RoleUser)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = serveTaskAt(todoClient, http.MethodDelete, "/api/v1/tasks/task-1/purge", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []string{"task-1"}, todoClient.purged)
+}
+
+func TestDeleteCompletedTasks(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	recorder := serveTaskAt(todoClient, http.MethodDelete, "/api/v1/tasks/completed", "owner", "user")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"deleted":2}`, recorder.Body.String())
+	assert.Equal(t, []string{"owner"}, todoClient.completedCleared)
+	// The static route wins over /:id, so no single task was deleted
+	assert.Empty(t, todoClient.deleted)
+}
+
+func TestRestoreAllTasks(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	recorder := serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/restore-all", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"restored":3}`, recorder.Body.String())
+	assert.Equal(t, []string{"owner"}, todoClient.trashCleared)
+}
+
+func TestListDeletedTasks_IncludesDeletedAt(t *testing.T) {
+	todoClient := &ownedTodoClient{
+		owners:  map[string]string{"task-1": "owner", "task-2": "owner", "task-3": "other"},
+		deleted: []string{"task-1", "task-3"},
+	}
+
+	recorder := serveTaskAt(todoClient, http.MethodGet, "/api/v1/tasks/trash", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var body handler.ListTasksResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, int64(1), body.Total)
+	if assert.Len(t, body.Tasks, 1) {
+		assert.Equal(t, "task-1", body.Tasks[0].ID)
+		if assert.NotNil(t, body.Tasks[0].DeletedAt) {
+			assert.True(t, body.Tasks[0].DeletedAt.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)))
+		}
+	}
+}
+
+func TestIsNew_OnlyOnMyTaskList(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner", "task-2": "owner"}}
+
+	recorder := serveTaskAt(todoClient, http.MethodGet, "/api/v1/tasks/me", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var body handler.ListTasksResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	if assert.Len(t, body.Tasks, 2) {
+		if assert.NotNil(t, body.Tasks[0].IsNew) && assert.NotNil(t, body.Tasks[1].IsNew) {
+			assert.True(t, *body.Tasks[0].IsNew)
+			assert.False(t, *body.Tasks[1].IsNew)
+		}
+	}
+
+	// A single task never computes it, so it is left out rather than false
+	recorder = serveTask(todoClient, http.MethodGet, "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "is_new")
+}
+
+func TestBulkTags(t *testing.T) {
+	const mine, alsoMine, notListed, theirs = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601", "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f602",
+		"5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f603", "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f604"
+	todoClient := &ownedTodoClient{
+		owners:   map[string]string{mine: "owner", alsoMine: "owner", notListed: "owner", theirs: "other"},
+		tagged:   map[string][]string{},
+		untagged: map[string][]string{},
+	}
+	serve := func(body string) *httptest.ResponseRecorder {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(func(c *gin.Context) { c.Set("user_id", "owner") })
+		router.PATCH("/api/v1/tasks/bulk-tags", handler.NewTaskHandler(todoClient).BulkTags)
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPatch, "/api/v1/tasks/bulk-tags", strings.NewReader(body)))
+		return recorder
+	}
+
+	// Only the listed tasks the caller owns are tagged
+	recorder := serve(`{"ids":["` + mine + `","` + alsoMine + `","` + theirs + `"],"tags":["urgent"],"action":"add"}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"updated":2}`, recorder.Body.String())
+	assert.Equal(t, map[string][]string{mine: {"urgent"}, alsoMine: {"urgent"}}, todoClient.tagged)
+
+	recorder = serve(`{"ids":["` + alsoMine + `"],"tags":["urgent"],"action":"remove"}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, map[string][]string{alsoMine: {"urgent"}}, todoClient.untagged)
+	assert.NotContains(t, todoClient.tagged, notListed)
+
+	for _, body := range []string{
+		`{"ids":["` + mine + `"],"tags":["urgent"],"action":"toggle"}`,
+		`{"ids":["` + mine + `"],"tags":[],"action":"add"}`,
+		`{"ids":["not-a-uuid"],"tags":["urgent"],"action":"add"}`,
+	} {
+		assert.Equal(t, http.StatusBadRequest, serve(body).Code, body)
+	}
+}
+
+func TestCompleteTask_RecurringReturnsNext(t *testing.T) {
+	todoClient := &ownedTodoClient{
+		owners:    map[string]string{"task-1": "owner", "task-2": "owner"},
+		recurring: []string{"task-1"},
+	}
+
+	recorder := serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/task-1/complete", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var resp handler.CompleteTaskResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "task-1", resp.Completed.ID)
+	assert.Equal(t, "DONE", resp.Completed.Status)
+	if assert.NotNil(t, resp.Next) {
+		assert.Equal(t, "task-1-next", resp.Next.ID)
+		assert.Equal(t, "TODO", resp.Next.Status)
+	}
+
+	// A non-recurring task has no next occurrence
+	recorder = serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/task-2/complete", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), `"next"`)
+
+	recorder = serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/task-1/complete", "intruder", middleware.RoleUser)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestArchiveTask(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	recorder := serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/task-1/archive", "intruder", middleware.RoleUser)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Empty(t, todoClient.archived)
+
+	recorder = serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/task-1/archive", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"status":"ARCHIVED"`)
+	assert.Equal(t, []string{"task-1"}, todoClient.archived)
+
+	recorder = serveTaskAt(todoClient, http.MethodPost, "/api/v1/tasks/task-1/unarchive", "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"status":"TODO"`)
+	assert.Empty(t, todoClient.archived)
+}
+
+func TestTaskAccess_AdminMayAccessAnyTask(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	assert.Equal(t, http.StatusOK, serveTask(todoClient, http.MethodGet, "admin-1", middleware.RoleAdmin).Code)
+	assert.Equal(t, http.StatusOK, serveTask(todoClient, http.MethodDelete, "admin-1", middleware.RoleAdmin).Code)
+	assert.Equal(t, []string{"task-1"}, todoClient.deleted)
+}
+
+func TestGetTask_OwnerMaySeeDeletedTask(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}, deleted: []string{"task-1"}}
+	const target = "/api/v1/tasks/task-1?include_deleted=true"
+
+	// Deleted tasks stay hidden unless asked for
+	assert.Equal(t, http.StatusNotFound, serveTask(todoClient, http.MethodGet, "owner", middleware.RoleUser).Code)
+
+	recorder := serveTaskAt(todoClient, http.MethodGet, target, "owner", middleware.RoleUser)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"deleted":true`)
+
+	assert.Equal(t, http.StatusNotFound, serveTaskAt(todoClient, http.MethodGet, target, "intruder", middleware.RoleUser).Code)
+}
+
+func (f *ownedTodoClient) MoveTaskOnBoard(ctx context.Context, req *pb.MoveTaskOnBoardRequest) (*pb.MoveTaskOnBoardResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	return &pb.MoveTaskOnBoardResponse{Task: &pb.Task{Id: req.Id, UserId: req.UserId, Status: req.Status, Position: req.Position}}, nil
+}
+
+func serveBoardMove(todoClient client.TodoClient, userID, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("user_id", userID) })
+	router.PATCH("/api/v1/tasks/:id/board-move", handler.NewTaskHandler(todoClient).MoveTaskOnBoard)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/tasks/task-1/board-move", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestMoveTaskOnBoard(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	recorder := serveBoardMove(todoClient, "owner", `{"status":"IN_PROGRESS","position":0}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"status":"IN_PROGRESS"`)
+	assert.Contains(t, recorder.Body.String(), `"position":0`)
+
+	assert.Equal(t, http.StatusBadRequest, serveBoardMove(todoClient, "owner", `{"status":"IN_PROGRESS"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveBoardMove(todoClient, "owner", `{"status":"BLOCKED","position":1}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveBoardMove(todoClient, "owner", `{"status":"DONE","position":-1}`).Code)
+	assert.Equal(t, http.StatusNotFound, serveBoardMove(todoClient, "intruder", `{"status":"DONE","position":1}`).Code)
+}
+
+func (f *ownedTodoClient) ReorderTask(ctx context.Context, req *pb.ReorderTaskRequest) (*pb.ReorderTaskResponse, error) {
+	if err := f.owns(req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	return &pb.ReorderTaskResponse{Task: &pb.Task{Id: req.Id, UserId: req.UserId, Position: req.Position}}, nil
+}
+
+func serveReorder(todoClient client.TodoClient, userID, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("user_id", userID) })
+	router.PATCH("/api/v1/tasks/:id/position", handler.NewTaskHandler(todoClient).ReorderTask)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/tasks/task-1/position", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestReorderTask(t *testing.T) {
+	todoClient := &ownedTodoClient{owners: map[string]string{"task-1": "owner"}}
+
+	recorder := serveReorder(todoClient, "owner", `{"position":2}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"position":2`)
+
+	assert.Equal(t, http.StatusBadRequest, serveReorder(todoClient, "owner", `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveReorder(todoClient, "owner", `{"position":-1}`).Code)
+	assert.Equal(t, http.StatusNotFound, serveReorder(todoClient, "intruder", `{"position":0}`).Code)
+}
+
+// trendsTodoClient records the trends request and answers with one point.
+type trendsTodoClient struct {
+	client.TodoClient
+	got *pb.GetCompletionTrendsRequest
+}
+
+func (f *trendsTodoClient) GetCompletionTrends(ctx context.Context, req *pb.GetCompletionTrendsRequest) (*pb.GetCompletionTrendsResponse, error) {
+	f.got = req
+	return &pb.GetCompletionTrendsResponse{Points: []*pb.CompletionTrendPoint{
+		{Start: req.From, Completed: 3},
+	}}, nil
+}
+
+func serveTrends(todoClient client.TodoClient, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("user_id", "owner") })
+	router.GET("/api/v1/tasks/trends", handler.NewTaskHandler(todoClient).GetCompletionTrends)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	return recorder
+}
+
+func TestGetCompletionTrends(t *testing.T) {
+	todoClient := &trendsTodoClient{}
+
+	recorder := serveTrends(todoClient, "/api/v1/tasks/trends?from=2025-03-01T00:00:00Z&to=2025-03-08T00:00:00Z")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"granularity":"day","points":[{"start":"2025-03-01T00:00:00Z","completed":3}]}`, recorder.Body.String())
+	if assert.NotNil(t, todoClient.got) {
+		assert.Equal(t, "owner", todoClient.got.UserId)
+		assert.Equal(t, "day", todoClient.got.Granularity)
+		assert.Equal(t, time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), todoClient.got.To.AsTime())
+	}
+
+	assert.Equal(t, http.StatusBadRequest, serveTrends(todoClient, "/api/v1/tasks/trends?from=2025-03-01T00:00:00Z").Code)
+	assert.Equal(t, http.StatusBadRequest, serveTrends(todoClient, "/api/v1/tasks/trends?from=2025-03-01T00:00:00Z&to=2025-03-08T00:00:00Z&granularity=hour").Code)
+}
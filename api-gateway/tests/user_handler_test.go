@@ -0,0 +1,285 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/client"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/handler"
+	"github.com/amirhasanpour/task-manager/api-gateway/internal/middleware"
+	pb "github.com/amirhasanpour/task-manager/api-gateway/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingUserClient struct {
+	client.UserClient
+	calls *[]string
+}
+
+func (f *recordingUserClient) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	*f.calls = append(*f.calls, "DeleteUser:"+req.Id)
+	return &pb.DeleteUserResponse{Success: true}, nil
+}
+
+// updatingUserClient records the last UpdateUser request.
+type updatingUserClient struct {
+	client.UserClient
+	req *pb.UpdateUserRequest
+}
+
+func (f *updatingUserClient) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	f.req = req
+	return &pb.UpdateUserResponse{User: &pb.User{Id: req.Id, FullName: req.FullName}}, nil
+}
+
+// listingUserClient records the last ListUsers request.
+type listingUserClient struct {
+	client.UserClient
+	req *pb.ListUsersRequest
+}
+
+func (f *listingUserClient) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	f.req = req
+	return &pb.ListUsersResponse{Page: req.Page, PageSize: req.PageSize}, nil
+}
+
+// lookupUserClient knows a single user, by username.
+type lookupUserClient struct {
+	client.UserClient
+}
+
+func (f *lookupUserClient) GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.GetUserResponse, error) {
+	if req.Username != "alice" {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &pb.GetUserResponse{User: &pb.User{Id: "user-1", Username: "alice", Email: "alice@example.com"}}, nil
+}
+
+// profileUserClient returns one public profile per requested id.
+type profileUserClient struct {
+	client.UserClient
+}
+
+func (f *profileUserClient) BatchGetPublicProfiles(ctx context.Context, req *pb.BatchGetPublicProfilesRequest) (*pb.BatchGetPublicProfilesResponse, error) {
+	resp := &pb.BatchGetPublicProfilesResponse{}
+	for _, id := range req.Ids {
+		resp.Profiles = append(resp.Profiles, &pb.PublicProfile{Id: id, Username: "user-" + id, FullName: "User " + id})
+	}
+	return resp, nil
+}
+
+type recordingTodoClient struct {
+	client.TodoClient
+	calls *[]string
+}
+
+func (f *recordingTodoClient) PurgeUserData(ctx context.Context, req *pb.PurgeUserDataRequest) (*pb.PurgeUserDataResponse, error) {
+	*f.calls = append(*f.calls, "PurgeUserData:"+req.UserId)
+	return &pb.PurgeUserDataResponse{TasksDeleted: 3, WebhooksDeleted: 1}, nil
+}
+
+func deleteUser(hardDeleteEnabled bool, target string) (*httptest.ResponseRecorder, []string) {
+	gin.SetMode(gin.TestMode)
+
+	var calls []string
+	userHandler := handler.NewUserHandler(
+		&recordingUserClient{calls: &calls},
+		&recordingTodoClient{calls: &calls},
+		hardDeleteEnabled,
+	)
+
+	router := gin.New()
+	router.DELETE("/api/v1/users/:id", userHandler.DeleteUser)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, target, nil))
+	return recorder, calls
+}
+
+func TestUpdateCurrentUser_MasksProvidedFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userClient := &updatingUserClient{}
+	userHandler := handler.NewUserHandler(userClient, &recordingTodoClient{}, false)
+
+	router := gin.New()
+	router.PUT("/api/v1/users/me", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+		userHandler.UpdateCurrentUser(c)
+	})
+
+	// An explicit empty full name is sent as a masked update, so it clears
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/users/me", strings.NewReader(`{"full_name": ""}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	if assert.NotNil(t, userClient.req) {
+		assert.Equal(t, "user-1", userClient.req.Id)
+		assert.Equal(t, "", userClient.req.FullName)
+		assert.Equal(t, []string{"full_name"}, userClient.req.UpdateMask.GetPaths())
+	}
+}
+
+func TestListUsers_PassesSearchAndSort(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userClient := &listingUserClient{}
+	userHandler := handler.NewUserHandler(userClient, &recordingTodoClient{}, false)
+
+	router := gin.New()
+	router.GET("/api/v1/users", userHandler.ListUsers)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users?search=alice&sort_by=email&sort_desc=true", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	if assert.NotNil(t, userClient.req) {
+		assert.Equal(t, "alice", userClient.req.Search)
+		assert.Equal(t, "email", userClient.req.SortBy)
+		assert.True(t, userClient.req.SortDesc)
+	}
+
+	userClient.req = nil
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users?sort_by=password", nil))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Nil(t, userClient.req)
+}
+
+func TestGetUserByUsername(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userHandler := handler.NewUserHandler(&lookupUserClient{}, &recordingTodoClient{}, false)
+	router := gin.New()
+	router.GET("/api/v1/users/by-username/:username", userHandler.GetUserByUsername)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users/by-username/alice", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"id":"user-1"`)
+	assert.Contains(t, recorder.Body.String(), `"username":"alice"`)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users/by-username/bob", nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `{"error":"user not found"}`, recorder.Body.String())
+}
+
+func TestBatchGetPublicProfiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userHandler := handler.NewUserHandler(&profileUserClient{}, &recordingTodoClient{}, false)
+	router := gin.New()
+	router.POST("/api/v1/users/profiles", userHandler.BatchGetPublicProfiles)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/profiles", strings.NewReader(`{"ids": ["1", "2"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"profiles": [
+		{"id": "1", "username": "user-1", "full_name": "User 1"},
+		{"id": "2", "username": "user-2", "full_name": "User 2"}
+	]}`, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/users/profiles", strings.NewReader(`{"ids": []}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestDeleteUser_HardDeletePurgesTodoData(t *testing.T) {
+	recorder, calls := deleteUser(true, "/api/v1/users/user-1?hard=true")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	// Todo data goes first so a failed account delete can be retried
+	assert.Equal(t, []string{"PurgeUserData:user-1", "DeleteUser:user-1"}, calls)
+}
+
+func TestDeleteUser_WithoutHardLeavesTodoData(t *testing.T) {
+	recorder, calls := deleteUser(true, "/api/v1/users/user-1")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []string{"DeleteUser:user-1"}, calls)
+}
+
+func TestDeleteUser_HardDeleteDisabled(t *testing.T) {
+	recorder, calls := deleteUser(false, "/api/v1/users/user-1?hard=true")
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Empty(t, calls)
+}
+
+type metricsUserClient struct {
+	client.UserClient
+}
+
+func (f *metricsUserClient) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if req.Id != "user-1" && req.Id != "admin-1" {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &pb.GetUserResponse{User: &pb.User{Id: req.Id}}, nil
+}
+
+type metricsTodoClient struct {
+	client.TodoClient
+}
+
+func (f *metricsTodoClient) GetUserTaskMetrics(ctx context.Context, req *pb.GetUserTaskMetricsRequest) (*pb.GetUserTaskMetricsResponse, error) {
+	return &pb.GetUserTaskMetricsResponse{Total: 12, Completed: 7, Overdue: 2}, nil
+}
+
+func getUserMetrics(callerID, callerRole, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	userHandler := handler.NewUserHandler(&metricsUserClient{}, &metricsTodoClient{}, false)
+
+	router := gin.New()
+	router.GET("/api/v1/users/:id/metrics", func(c *gin.Context) {
+		c.Set("user_id", callerID)
+		c.Set("user_role", callerRole)
+	}, userHandler.GetUserMetrics)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/users/"+target+"/metrics", nil))
+	return recorder
+}
+
+func TestGetUserMetrics_Access(t *testing.T) {
+	tests := []struct {
+		name       string
+		callerID   string
+		callerRole string
+		target     string
+		wantStatus int
+	}{
+		{"self", "user-1", middleware.RoleUser, "user-1", http.StatusOK},
+		{"other user", "user-2", middleware.RoleUser, "user-1", http.StatusForbidden},
+		{"admin", "admin-1", middleware.RoleAdmin, "user-1", http.StatusOK},
+		{"admin, unknown user", "admin-1", middleware.RoleAdmin, "missing", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := getUserMetrics(tt.callerID, tt.callerRole, tt.target)
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.JSONEq(t, `{"user_id":"user-1","total":12,"completed":7,"overdue":2}`, recorder.Body.String())
+			}
+		})
+	}
+}
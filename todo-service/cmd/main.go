@@ -11,12 +11,14 @@ import (
 
 	"github.com/amirhasanpour/task-manager/todo-service/config"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/events"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/handler"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/interceptor"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/tracing"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/webhook"
 	"github.com/amirhasanpour/task-manager/todo-service/pkg/db"
 	"github.com/amirhasanpour/task-manager/todo-service/pkg/logger"
 	"github.com/amirhasanpour/task-manager/todo-service/pkg/metrics"
@@ -56,6 +58,7 @@ func main() {
 		zap.String("version", "1.0.0"),
 		zap.String("environment", os.Getenv("APP_ENV")),
 	)
+	log.Debug("Configuration loaded", zap.Any("config", cfg.Redacted()))
 
 	// Initialize tracing
 	ctx := context.Background()
@@ -90,14 +93,18 @@ func main() {
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
 	}
 
-	database, err := db.NewPostgresConnection(dbConfig)
+	database, err := db.NewPostgresConnectionWithRetry(ctx, dbConfig, db.RetryConfig{
+		Attempts: cfg.Database.ConnectAttempts,
+		Interval: cfg.Database.ConnectInterval,
+		MaxWait:  cfg.Database.ConnectMaxWait,
+	})
 	if err != nil {
 		log.Error("Failed to connect to database", zap.Error(err))
 		os.Exit(1)
 	}
 
 	// Run database migrations
-	if err := db.Migrate(database, &model.Task{}); err != nil {
+	if err := db.Migrate(database, &model.Task{}, &model.Webhook{}, &model.WebhookDeadLetter{}); err != nil {
 		log.Error("Failed to migrate database", zap.Error(err))
 		os.Exit(1)
 	}
@@ -115,20 +122,35 @@ func main() {
 		ReadTimeout:  cfg.Redis.ReadTimeout,
 		WriteTimeout: cfg.Redis.WriteTimeout,
 		CacheTTL:     cfg.Redis.CacheTTL,
+		TaskCacheTTL: cfg.Redis.TaskCacheTTL,
+		ListCacheTTL: cfg.Redis.ListCacheTTL,
 	}
 
+	// Redis is optional: without it the service runs uncached
 	redisClient, err := redis.NewRedisClient(redisConfig)
 	if err != nil {
-		log.Error("Failed to connect to Redis", zap.Error(err))
-		os.Exit(1)
+		log.Warn("Failed to connect to Redis, continuing without the shared cache", zap.Error(err))
+	} else {
+		defer redisClient.Close()
 	}
-	defer redisClient.Close()
 
-	// Initialize repository
+	// Initialize repositories
 	taskRepo := repository.NewTaskRepository(database)
+	webhookRepo := repository.NewWebhookRepository(database)
 
 	// Initialize cache
-	taskCache := cache.NewTaskCache(redisClient)
+	var taskCache cache.TaskCache
+	if redisClient != nil {
+		taskCache = cache.NewTaskCache(redisClient)
+	} else {
+		taskCache = cache.NewNoopTaskCache()
+	}
+	if cfg.Redis.MemoryCacheSize > 0 {
+		taskCache = cache.NewTieredTaskCache(
+			cache.NewMemoryTaskCache(cfg.Redis.MemoryCacheSize, cfg.Redis.MemoryCacheTTL),
+			taskCache,
+		)
+	}
 
 	// Initialize service metrics
 	serviceMetrics := service.NewMetricsCollector(
@@ -143,33 +165,156 @@ func main() {
 	)
 
 	// Initialize service
-	taskService := service.NewTaskService(taskRepo, taskCache, serviceMetrics)
+	// Initialize event publishers
+	var publishers []events.EventPublisher
+	if cfg.Events.Enabled {
+		if redisClient != nil {
+			publishers = append(publishers, events.NewRedisPublisher(redisClient, cfg.Events.Channel))
+		} else {
+			log.Warn("Task events need Redis and will not be published")
+		}
+	}
+	if cfg.Webhooks.Enabled {
+		publishers = append(publishers, webhook.NewDispatcher(webhookRepo, webhook.Config{
+			MaxRetries:            cfg.Webhooks.MaxRetries,
+			InitialBackoff:        cfg.Webhooks.InitialBackoff,
+			Timeout:               cfg.Webhooks.Timeout,
+			AllowPrivateAddresses: cfg.Webhooks.AllowPrivateAddresses,
+		}))
+	}
+	eventPublisher := events.NewMultiPublisher(publishers...)
+
+	boardOrder, err := service.ParseBoardOrder(cfg.Tasks.BoardOrder)
+	if err != nil {
+		log.Error("Invalid board order configuration", zap.Error(err))
+		os.Exit(1)
+	}
+
+	taskService := service.NewTaskServiceWithConfig(taskRepo, taskCache, serviceMetrics, eventPublisher, service.Config{
+		MaxBoardPerColumn:    cfg.Tasks.MaxBoardPerColumn,
+		MaxBatchCreate:       cfg.Tasks.MaxBatchCreate,
+		MaxBatchGet:          cfg.Tasks.MaxBatchGet,
+		MaxBulkUpdate:        cfg.Tasks.MaxBulkUpdate,
+		MaxRecurrencePreview: cfg.Tasks.MaxRecurrencePreview,
+		MaxSearchPageSize:    cfg.Tasks.MaxSearchPageSize,
+		MaxSnooze:            cfg.Tasks.MaxSnooze,
+		MaxTrendPoints:       cfg.Tasks.MaxTrendPoints,
+		RestoreAllWindow:     cfg.Tasks.RestoreAllWindow,
+		GenerateRecurring:    cfg.Tasks.GenerateRecurring,
+		FoldTagCase:          cfg.Tasks.FoldTagCase,
+		TagAllowlist:         cfg.Tasks.TagAllowlist,
+		BoardOrder:           boardOrder,
+		Attention: service.AttentionConfig{
+			DueSoonWindow:               cfg.Tasks.Attention.DueSoonWindow,
+			StaleAfter:                  cfg.Tasks.Attention.StaleAfter,
+			OverdueWeight:               cfg.Tasks.Attention.Weights.Overdue,
+			DueSoonWeight:               cfg.Tasks.Attention.Weights.DueSoon,
+			HighPriorityUntouchedWeight: cfg.Tasks.Attention.Weights.HighPriorityUntouched,
+			MaxResults:                  cfg.Tasks.Attention.MaxResults,
+		},
+		Urgency: repository.UrgencyWeights{
+			Priority:     cfg.Tasks.Urgency.Weights.Priority,
+			DueDate:      cfg.Tasks.Urgency.Weights.DueDate,
+			Staleness:    cfg.Tasks.Urgency.Weights.Staleness,
+			DueHorizon:   cfg.Tasks.Urgency.DueHorizon,
+			StaleHorizon: cfg.Tasks.Urgency.StaleHorizon,
+		},
+	})
+
+	webhookService := service.NewWebhookService(webhookRepo)
+
+	// Warm the task count gauges, then keep them in step if configured
+	if err := taskService.RefreshMetrics(ctx); err != nil {
+		log.Warn("Failed to refresh task metrics", zap.Error(err))
+	}
+	refreshCtx, stopRefresh := context.WithCancel(ctx)
+	defer stopRefresh()
+	if cfg.Metrics.RefreshInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(cfg.Metrics.RefreshInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-refreshCtx.Done():
+					return
+				case <-ticker.C:
+					if err := taskService.RefreshMetrics(refreshCtx); err != nil {
+						log.Warn("Failed to refresh task metrics", zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
 
-	// Initialize handler
+	// Start the orphaned cache sweeper
+	sweepCtx, stopSweeper := context.WithCancel(ctx)
+	defer stopSweeper()
+	if redisClient != nil && cfg.Redis.SweepInterval > 0 {
+		go service.NewCacheSweeper(taskRepo, taskCache, cfg.Redis.SweepInterval).Run(sweepCtx)
+	}
+
+	// Initialize handlers
 	taskHandler := handler.NewTaskHandler(taskService)
+	taskHandler.SetListTagsLimit(cfg.Tasks.ListTagsLimit)
+	var redisCheck handler.HealthCheck
+	if redisClient != nil {
+		redisCheck = redisClient.Ping
+	}
+	taskHandler.SetHealthChecks(map[string]handler.HealthCheck{
+		"database": func(ctx context.Context) error { return db.Ping(ctx, database) },
+		"redis":    redisCheck,
+	})
+	webhookHandler := handler.NewWebhookHandler(webhookService)
 
 	// Initialize interceptors
 	metricsInterceptor := interceptor.NewMetricsInterceptor(metricsCollector)
 	loggingInterceptor := interceptor.NewLoggingInterceptor()
+	requestIDInterceptor := interceptor.NewRequestIDInterceptor()
 	recoveryInterceptor := interceptor.NewRecoveryInterceptor()
 
+	var authUnary grpc.UnaryServerInterceptor
+	if cfg.Auth.Enabled {
+		if cfg.Auth.GatewayToken == "" {
+			log.Error("Auth interceptor enabled without a gateway token")
+			os.Exit(1)
+		}
+		authUnary = interceptor.NewAuthInterceptor(cfg.Auth.GatewayToken, cfg.Auth.ExemptMethods).Unary()
+	}
+
+	unaryInterceptors, err := interceptor.BuildChain(cfg.Interceptors.Order, map[string]grpc.UnaryServerInterceptor{
+		"recovery":   recoveryInterceptor.Unary(),
+		"request_id": requestIDInterceptor.Unary(),
+		"logging":    loggingInterceptor.Unary(),
+		"metrics":    metricsInterceptor.Unary(),
+		"auth":       authUnary,
+	})
+	if err != nil {
+		log.Error("Invalid interceptor configuration", zap.Error(err))
+		os.Exit(1)
+	}
+
 	// Create gRPC server with interceptors
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			recoveryInterceptor.Unary(),
-			loggingInterceptor.Unary(),
-			metricsInterceptor.Unary(),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	)
 
 	// Register services
 	pb.RegisterTodoServiceServer(grpcServer, taskHandler)
+	pb.RegisterWebhookServiceServer(grpcServer, webhookHandler)
 	
 	// Register health service
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	healthServer.SetServingStatus("todo-service", grpc_health_v1.HealthCheckResponse_SERVING)
 
+	// Report NOT_SERVING while a dependency is down
+	healthCtx, stopHealthChecks := context.WithCancel(ctx)
+	healthChecksDone := make(chan struct{})
+	go func() {
+		defer close(healthChecksDone)
+		taskHandler.WatchServingStatus(healthCtx, healthServer, "todo-service", cfg.Health.CheckInterval)
+	}()
+
 	// Register reflection service (for debugging)
 	reflection.Register(grpcServer)
 
@@ -198,6 +343,10 @@ func main() {
 
 	log.Info("Shutting down server...")
 
+	// Stop the dependency checks so they cannot flip the status back
+	stopHealthChecks()
+	<-healthChecksDone
+
 	// Set health status to NOT_SERVING
 	healthServer.SetServingStatus("todo-service", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 
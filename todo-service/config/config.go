@@ -8,12 +8,18 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	Logging  LoggingConfig
-	Metrics  MetricsConfig
-	OTel     OTelConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	Redis        RedisConfig
+	Logging      LoggingConfig
+	Metrics      MetricsConfig
+	OTel         OTelConfig
+	Auth         AuthConfig
+	Interceptors InterceptorsConfig
+	Health       HealthConfig
+	Tasks        TasksConfig
+	Events       EventsConfig
+	Webhooks     WebhooksConfig
 }
 
 type ServerConfig struct {
@@ -25,18 +31,24 @@ type DatabaseConfig struct {
 	Host            string
 	Port            int
 	User            string
-	Password        string
+	Password        string `sensitive:"true"`
 	Name            string
 	SSLMode         string
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+
+	// ConnectAttempts, ConnectInterval and ConnectMaxWait make startup wait
+	// for the database instead of exiting on the first failed connection.
+	ConnectAttempts int           `mapstructure:"connect_attempts"`
+	ConnectInterval time.Duration `mapstructure:"connect_interval"`
+	ConnectMaxWait  time.Duration `mapstructure:"connect_max_wait"`
 }
 
 type RedisConfig struct {
 	Host         string
 	Port         int
-	Password     string
+	Password     string `sensitive:"true"`
 	DB           int
 	PoolSize     int
 	MinIdleConns int
@@ -45,6 +57,21 @@ type RedisConfig struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	CacheTTL     time.Duration
+
+	// TaskCacheTTL and ListCacheTTL override CacheTTL for single tasks and
+	// task lists; lists go stale faster so they get the shorter TTL.
+	TaskCacheTTL time.Duration `mapstructure:"task_cache_ttl"`
+	ListCacheTTL time.Duration `mapstructure:"list_cache_ttl"`
+
+	// MemoryCacheSize bounds the in-process task cache tried before Redis;
+	// zero disables it. MemoryCacheTTL is kept short because other
+	// instances cannot invalidate it.
+	MemoryCacheSize int           `mapstructure:"memory_cache_size"`
+	MemoryCacheTTL  time.Duration `mapstructure:"memory_cache_ttl"`
+
+	// SweepInterval is how often cached tasks are checked against the
+	// database and dropped if their row is gone. Zero disables the sweep.
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
 }
 
 type LoggingConfig struct {
@@ -56,6 +83,20 @@ type LoggingConfig struct {
 
 type MetricsConfig struct {
 	Port int
+
+	// RefreshInterval is how often the task count gauges are recounted from
+	// the database. They are always counted once at startup; zero disables
+	// the periodic refresh.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// HealthConfig controls the background dependency checks behind the gRPC
+// health status.
+type HealthConfig struct {
+	// CheckInterval is how often the database and Redis are pinged. While
+	// either fails the service reports NOT_SERVING. Zero disables the
+	// checks and the service always reports SERVING.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
 }
 
 type OTelConfig struct {
@@ -63,6 +104,90 @@ type OTelConfig struct {
 	ServiceName string
 }
 
+// AuthConfig controls the gateway-token check on incoming gRPC calls.
+type AuthConfig struct {
+	Enabled       bool
+	GatewayToken  string   `mapstructure:"gateway_token" sensitive:"true"`
+	ExemptMethods []string `mapstructure:"exempt_methods"`
+}
+
+// InterceptorsConfig lists the unary interceptors by name, outermost first.
+type InterceptorsConfig struct {
+	Order []string
+}
+
+// TasksConfig bounds the work a single task request can trigger.
+type TasksConfig struct {
+	MaxBoardPerColumn    int `mapstructure:"max_board_per_column"`
+	MaxBatchCreate       int `mapstructure:"max_batch_create"`
+	MaxBatchGet          int `mapstructure:"max_batch_get"`
+	MaxBulkUpdate        int `mapstructure:"max_bulk_update"`
+	MaxRecurrencePreview int `mapstructure:"max_recurrence_preview"`
+	MaxSearchPageSize    int `mapstructure:"max_search_page_size"`
+	// MaxSnooze bounds how far SnoozeOverdue may push due dates.
+	MaxSnooze time.Duration `mapstructure:"max_snooze"`
+	// MaxTrendPoints bounds how many periods a completion trend may cover.
+	MaxTrendPoints int `mapstructure:"max_trend_points"`
+	// RestoreAllWindow limits restore-all to recently deleted tasks; zero
+	// restores the whole trash.
+	RestoreAllWindow time.Duration `mapstructure:"restore_all_window"`
+	// GenerateRecurring creates the next instance when a recurring task is done.
+	GenerateRecurring bool `mapstructure:"generate_recurring"`
+	// FoldTagCase lowercases tags, so "Work" and "work" are the same tag.
+	FoldTagCase bool `mapstructure:"fold_tag_case"`
+	// ListTagsLimit caps the tags returned per task in list responses; zero
+	// returns them all.
+	ListTagsLimit int `mapstructure:"list_tags_limit"`
+	// TagAllowlist, when set, is the only tags tasks may carry.
+	TagAllowlist []string `mapstructure:"tag_allowlist"`
+	// BoardOrder maps a status to "field [asc|desc]" for its board column.
+	BoardOrder map[string]string `mapstructure:"board_order"`
+	Attention  AttentionConfig
+	Urgency    UrgencyConfig
+}
+
+// AttentionConfig weighs the reasons a task needs attention; a task's score
+// is the sum of the weights that apply.
+type AttentionConfig struct {
+	MaxResults    int           `mapstructure:"max_results"`
+	DueSoonWindow time.Duration `mapstructure:"due_soon_window"`
+	StaleAfter    time.Duration `mapstructure:"stale_after"`
+	Weights       struct {
+		Overdue               float64
+		DueSoon               float64 `mapstructure:"due_soon"`
+		HighPriorityUntouched float64 `mapstructure:"high_priority_untouched"`
+	}
+}
+
+// UrgencyConfig weighs priority, due date proximity and staleness into the
+// score tasks are sorted by with sort_by=urgency.
+type UrgencyConfig struct {
+	DueHorizon   time.Duration `mapstructure:"due_horizon"`
+	StaleHorizon time.Duration `mapstructure:"stale_horizon"`
+	Weights      struct {
+		Priority  float64
+		DueDate   float64 `mapstructure:"due_date"`
+		Staleness float64
+	}
+}
+
+// EventsConfig controls publishing of task events to Redis pub/sub.
+type EventsConfig struct {
+	Enabled bool
+	Channel string
+}
+
+// WebhooksConfig controls delivery of task events to user webhooks.
+type WebhooksConfig struct {
+	Enabled        bool
+	MaxRetries     int           `mapstructure:"max_retries"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	Timeout        time.Duration
+	// AllowPrivateAddresses lets deliveries reach loopback and private
+	// networks; only for local development.
+	AllowPrivateAddresses bool `mapstructure:"allow_private_addresses"`
+}
+
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -105,6 +230,9 @@ func setDefaults() {
 	viper.SetDefault("database.max_open_conns", 25)
 	viper.SetDefault("database.max_idle_conns", 5)
 	viper.SetDefault("database.conn_max_lifetime", "5m")
+	viper.SetDefault("database.connect_attempts", 10)
+	viper.SetDefault("database.connect_interval", "1s")
+	viper.SetDefault("database.connect_max_wait", "1m")
 
 	viper.SetDefault("redis.host", "localhost")
 	viper.SetDefault("redis.port", 6379)
@@ -117,6 +245,11 @@ func setDefaults() {
 	viper.SetDefault("redis.read_timeout", "3s")
 	viper.SetDefault("redis.write_timeout", "3s")
 	viper.SetDefault("redis.cache_ttl", "5m")
+	viper.SetDefault("redis.task_cache_ttl", "5m")
+	viper.SetDefault("redis.list_cache_ttl", "1m")
+	viper.SetDefault("redis.sweep_interval", "0s")
+	viper.SetDefault("redis.memory_cache_size", 1000)
+	viper.SetDefault("redis.memory_cache_ttl", "30s")
 
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.encoding", "json")
@@ -124,7 +257,50 @@ func setDefaults() {
 	viper.SetDefault("logging.error_output_paths", []string{"stderr"})
 
 	viper.SetDefault("metrics.port", 9093)
+	viper.SetDefault("metrics.refresh_interval", "0s")
 
 	viper.SetDefault("otel.endpoint", "http://localhost:4317")
 	viper.SetDefault("otel.service_name", "todo-service")
+
+	viper.SetDefault("auth.enabled", false)
+	viper.SetDefault("auth.gateway_token", "")
+	viper.SetDefault("auth.exempt_methods", []string{"/grpc.health.v1.Health/Check"})
+
+	viper.SetDefault("interceptors.order", []string{"recovery", "request_id", "logging", "metrics", "auth"})
+
+	viper.SetDefault("health.check_interval", "10s")
+
+	viper.SetDefault("tasks.max_board_per_column", 50)
+	viper.SetDefault("tasks.max_batch_create", 100)
+	viper.SetDefault("tasks.max_batch_get", 100)
+	viper.SetDefault("tasks.max_bulk_update", 100)
+	viper.SetDefault("tasks.max_recurrence_preview", 52)
+	viper.SetDefault("tasks.max_search_page_size", 50)
+	viper.SetDefault("tasks.max_snooze", "720h")
+	viper.SetDefault("tasks.max_trend_points", 366)
+	viper.SetDefault("tasks.restore_all_window", "0s")
+	viper.SetDefault("tasks.generate_recurring", true)
+	viper.SetDefault("tasks.fold_tag_case", true)
+	viper.SetDefault("tasks.tag_allowlist", []string{})
+	viper.SetDefault("tasks.list_tags_limit", 0)
+	viper.SetDefault("tasks.attention.max_results", 50)
+	viper.SetDefault("tasks.attention.due_soon_window", "48h")
+	viper.SetDefault("tasks.attention.stale_after", "72h")
+	viper.SetDefault("tasks.attention.weights.overdue", 3)
+	viper.SetDefault("tasks.attention.weights.due_soon", 2)
+	viper.SetDefault("tasks.attention.weights.high_priority_untouched", 1)
+	viper.SetDefault("tasks.urgency.due_horizon", "168h")
+	viper.SetDefault("tasks.urgency.stale_horizon", "336h")
+	viper.SetDefault("tasks.urgency.weights.priority", 1)
+	viper.SetDefault("tasks.urgency.weights.due_date", 2)
+	viper.SetDefault("tasks.urgency.weights.staleness", 0.5)
+
+	viper.SetDefault("events.enabled", false)
+	viper.SetDefault("events.channel", "task-events")
+
+	viper.SetDefault("webhooks.enabled", false)
+	viper.SetDefault("webhooks.max_retries", 3)
+	viper.SetDefault("webhooks.initial_backoff", "1s")
+	viper.SetDefault("webhooks.timeout", "5s")
+	viper.SetDefault("webhooks.allow_private_addresses", false)
 }
\ No newline at end of file
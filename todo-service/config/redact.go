@@ -0,0 +1,40 @@
+package config
+
+import "reflect"
+
+// RedactedValue replaces non-empty string fields tagged `sensitive:"true"`.
+const RedactedValue = "[REDACTED]"
+
+// Redacted returns a copy of the config with every sensitive field masked,
+// safe to log or expose. Empty secrets stay empty so an unset value is
+// still recognisable.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	redact(reflect.ValueOf(&redacted).Elem())
+	return &redacted
+}
+
+func redact(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		switch {
+		case field.Tag.Get("sensitive") == "true":
+			if value.IsZero() {
+				continue
+			}
+			if value.Kind() == reflect.String {
+				value.SetString(RedactedValue)
+			} else {
+				value.Set(reflect.Zero(value.Type()))
+			}
+		case value.Kind() == reflect.Struct:
+			redact(value)
+		}
+	}
+}
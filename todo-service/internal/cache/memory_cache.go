@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+)
+
+// MemoryTaskCache is a bounded, in-process LRU of single tasks. Entries are
+// stored encoded, like in Redis, so callers never share a task value.
+type MemoryTaskCache struct {
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	order   *list.List // most recently used first
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	id        string
+	data      []byte
+	expiresAt time.Time
+}
+
+// NewMemoryTaskCache keeps at most size tasks, each for at most ttl. Other
+// instances do not invalidate it, so ttl bounds how stale a task can be.
+func NewMemoryTaskCache(size int, ttl time.Duration) *MemoryTaskCache {
+	return &MemoryTaskCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+// Get returns the cached task, or nil if it is missing or expired.
+func (m *MemoryTaskCache) Get(id string) *model.Task {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[id]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*memoryEntry)
+	if time.Now().After(entry.expiresAt) {
+		m.remove(elem)
+		return nil
+	}
+
+	var task model.Task
+	if err := json.Unmarshal(entry.data, &task); err != nil {
+		m.remove(elem)
+		return nil
+	}
+	m.order.MoveToFront(elem)
+	return &task
+}
+
+// Set caches task, evicting the least recently used task when full.
+func (m *MemoryTaskCache) Set(task *model.Task) {
+	if m.size <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(task)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := &memoryEntry{id: task.ID, data: data, expiresAt: time.Now().Add(m.ttl)}
+	if elem, ok := m.entries[task.ID]; ok {
+		elem.Value = entry
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.entries[task.ID] = m.order.PushFront(entry)
+	if m.order.Len() > m.size {
+		m.remove(m.order.Back())
+	}
+}
+
+func (m *MemoryTaskCache) Delete(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[id]; ok {
+		m.remove(elem)
+	}
+}
+
+// Len reports how many tasks are held, expired ones included.
+func (m *MemoryTaskCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+func (m *MemoryTaskCache) remove(elem *list.Element) {
+	m.order.Remove(elem)
+	delete(m.entries, elem.Value.(*memoryEntry).id)
+}
+
+// tieredTaskCache serves single tasks from memory before asking next, and
+// keeps both tiers in step on writes. Everything else goes straight to next.
+type tieredTaskCache struct {
+	TaskCache
+	memory *MemoryTaskCache
+}
+
+// NewTieredTaskCache puts memory in front of next, so task reads try memory,
+// then next, then the database.
+func NewTieredTaskCache(memory *MemoryTaskCache, next TaskCache) TaskCache {
+	return &tieredTaskCache{TaskCache: next, memory: memory}
+}
+
+func (c *tieredTaskCache) GetTask(ctx context.Context, id string) (*model.Task, error) {
+	if task := c.memory.Get(id); task != nil {
+		return task, nil
+	}
+
+	task, err := c.TaskCache.GetTask(ctx, id)
+	if err != nil || task == nil {
+		return task, err
+	}
+	c.memory.Set(task)
+	return task, nil
+}
+
+func (c *tieredTaskCache) GetTasks(ctx context.Context, ids []string) (map[string]*model.Task, error) {
+	tasks := make(map[string]*model.Task, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if task := c.memory.Get(id); task != nil {
+			tasks[id] = task
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return tasks, nil
+	}
+
+	found, err := c.TaskCache.GetTasks(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for id, task := range found {
+		c.memory.Set(task)
+		tasks[id] = task
+	}
+	return tasks, nil
+}
+
+func (c *tieredTaskCache) SetTask(ctx context.Context, task *model.Task) error {
+	c.memory.Set(task)
+	return c.TaskCache.SetTask(ctx, task)
+}
+
+func (c *tieredTaskCache) DeleteTask(ctx context.Context, id string) error {
+	c.memory.Delete(id)
+	return c.TaskCache.DeleteTask(ctx, id)
+}
@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+)
+
+// ErrLastSeenUnavailable is returned when a last-seen timestamp cannot be
+// stored. Unlike cached entries it is user state, so dropping it would lose
+// the user's mark-all-seen.
+var ErrLastSeenUnavailable = errors.New("last seen timestamps are unavailable without redis")
+
+// noopTaskCache caches nothing. It stands in for the Redis cache when Redis
+// is unreachable at startup, so every lookup misses and reads go to the
+// database.
+type noopTaskCache struct{}
+
+func NewNoopTaskCache() TaskCache {
+	return noopTaskCache{}
+}
+
+func (noopTaskCache) GetTask(ctx context.Context, id string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) GetTasks(ctx context.Context, ids []string) (map[string]*model.Task, error) {
+	return map[string]*model.Task{}, nil
+}
+
+func (noopTaskCache) SetTask(ctx context.Context, task *model.Task) error {
+	return nil
+}
+
+func (noopTaskCache) DeleteTask(ctx context.Context, id string) error {
+	return nil
+}
+
+func (noopTaskCache) CachedTaskIDs(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) GetTasksList(ctx context.Context, key string) ([]*model.Task, int64, error) {
+	return nil, 0, nil
+}
+
+func (noopTaskCache) SetTasksList(ctx context.Context, key string, tasks []*model.Task, total int64) error {
+	return nil
+}
+
+func (noopTaskCache) DeleteTasksList(ctx context.Context, pattern string) error {
+	return nil
+}
+
+func (noopTaskCache) InvalidateUserTasks(ctx context.Context, userID string) error {
+	return nil
+}
+
+func (noopTaskCache) UserCacheVersion(ctx context.Context, userID string) (int64, error) {
+	return 0, nil
+}
+
+func (noopTaskCache) GetBoard(ctx context.Context, key string) (map[string][]*model.Task, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) SetBoard(ctx context.Context, key string, board map[string][]*model.Task) error {
+	return nil
+}
+
+func (noopTaskCache) GetLastSeen(ctx context.Context, userID string) (*time.Time, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) SetLastSeen(ctx context.Context, userID string, seenAt time.Time) error {
+	return ErrLastSeenUnavailable
+}
+
+func (noopTaskCache) DeleteLastSeen(ctx context.Context, userID string) error {
+	return nil
+}
+
+func (noopTaskCache) GetCount(ctx context.Context, key string) (*int64, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) SetCount(ctx context.Context, key string, count int64) error {
+	return nil
+}
+
+func (noopTaskCache) GetMatrix(ctx context.Context, key string) (map[string]map[string]int64, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) SetMatrix(ctx context.Context, key string, matrix map[string]map[string]int64) error {
+	return nil
+}
+
+func (noopTaskCache) GetStats(ctx context.Context, key string) (map[string]int64, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) SetStats(ctx context.Context, key string, stats map[string]int64) error {
+	return nil
+}
+
+func (noopTaskCache) GetMetrics(ctx context.Context, key string) (*model.TaskMetrics, error) {
+	return nil, nil
+}
+
+func (noopTaskCache) SetMetrics(ctx context.Context, key string, metrics *model.TaskMetrics) error {
+	return nil
+}
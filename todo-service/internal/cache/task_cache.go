@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
 	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
@@ -15,14 +18,34 @@ import (
 
 type TaskCache interface {
 	GetTask(ctx context.Context, id string) (*model.Task, error)
+	GetTasks(ctx context.Context, ids []string) (map[string]*model.Task, error)
 	SetTask(ctx context.Context, task *model.Task) error
 	DeleteTask(ctx context.Context, id string) error
+	CachedTaskIDs(ctx context.Context) ([]string, error)
 	GetTasksList(ctx context.Context, key string) ([]*model.Task, int64, error)
 	SetTasksList(ctx context.Context, key string, tasks []*model.Task, total int64) error
 	DeleteTasksList(ctx context.Context, pattern string) error
 	InvalidateUserTasks(ctx context.Context, userID string) error
+	UserCacheVersion(ctx context.Context, userID string) (int64, error)
+	GetBoard(ctx context.Context, key string) (map[string][]*model.Task, error)
+	SetBoard(ctx context.Context, key string, board map[string][]*model.Task) error
+	GetLastSeen(ctx context.Context, userID string) (*time.Time, error)
+	SetLastSeen(ctx context.Context, userID string, seenAt time.Time) error
+	DeleteLastSeen(ctx context.Context, userID string) error
+	GetCount(ctx context.Context, key string) (*int64, error)
+	SetCount(ctx context.Context, key string, count int64) error
+	GetMatrix(ctx context.Context, key string) (map[string]map[string]int64, error)
+	SetMatrix(ctx context.Context, key string, matrix map[string]map[string]int64) error
+	GetStats(ctx context.Context, key string) (map[string]int64, error)
+	SetStats(ctx context.Context, key string, stats map[string]int64) error
+	GetMetrics(ctx context.Context, key string) (*model.TaskMetrics, error)
+	SetMetrics(ctx context.Context, key string, metrics *model.TaskMetrics) error
 }
 
+// countCacheTTL keeps task counts short-lived; they are cheap to recompute
+// and dashboards poll them.
+const countCacheTTL = 30 * time.Second
+
 type taskCache struct {
 	redisClient *redis.RedisClient
 	logger      *zap.Logger
@@ -71,6 +94,52 @@ func (c *taskCache) GetTask(ctx context.Context, id string) (*model.Task, error)
 	return &task, nil
 }
 
+// GetTasks looks up several tasks in one round trip and returns the ones
+// found, keyed by id. Entries that cannot be decoded count as misses.
+func (c *taskCache) GetTasks(ctx context.Context, ids []string) (map[string]*model.Task, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("task.count", len(ids)))
+
+	tasks := make(map[string]*model.Task, len(ids))
+	if len(ids) == 0 {
+		return tasks, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = c.taskKey(id)
+	}
+
+	values, err := c.redisClient.MGet(ctx, keys...)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	for i, data := range values {
+		if data == "" {
+			continue
+		}
+		var task model.Task
+		if err := json.Unmarshal([]byte(data), &task); err != nil {
+			c.logger.Error("Failed to unmarshal cached task", 
+				zap.Error(err),
+				zap.String("key", keys[i]),
+			)
+			continue
+		}
+		tasks[ids[i]] = &task
+	}
+
+	c.logger.Debug("Tasks fetched from cache", 
+		zap.Int("requested", len(ids)),
+		zap.Int("hits", len(tasks)),
+	)
+	return tasks, nil
+}
+
 func (c *taskCache) SetTask(ctx context.Context, task *model.Task) error {
 	ctx, span := c.tracer.Start(ctx, "TaskCache.SetTask")
 	defer span.End()
@@ -90,7 +159,7 @@ func (c *taskCache) SetTask(ctx context.Context, task *model.Task) error {
 		return err
 	}
 
-	if err := c.redisClient.Set(ctx, cacheKey, data); err != nil {
+	if err := c.redisClient.SetWithTTL(ctx, cacheKey, data, c.redisClient.TaskCacheTTL()); err != nil {
 		span.RecordError(err)
 		return err
 	}
@@ -117,6 +186,24 @@ func (c *taskCache) DeleteTask(ctx context.Context, id string) error {
 	return nil
 }
 
+// CachedTaskIDs lists the ids of all individually cached tasks.
+func (c *taskCache) CachedTaskIDs(ctx context.Context) ([]string, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.CachedTaskIDs")
+	defer span.End()
+
+	keys, err := c.redisClient.ScanKeys(ctx, c.taskKey("*"))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, strings.TrimPrefix(key, c.taskKey("")))
+	}
+	return ids, nil
+}
+
 func (c *taskCache) GetTasksList(ctx context.Context, key string) ([]*model.Task, int64, error) {
 	ctx, span := c.tracer.Start(ctx, "TaskCache.GetTasksList")
 	defer span.End()
@@ -190,7 +277,7 @@ func (c *taskCache) SetTasksList(ctx context.Context, key string, tasks []*model
 		return err
 	}
 
-	if err := c.redisClient.Set(ctx, key, data); err != nil {
+	if err := c.redisClient.SetWithTTL(ctx, key, data, c.redisClient.ListCacheTTL()); err != nil {
 		span.RecordError(err)
 		return err
 	}
@@ -219,26 +306,371 @@ func (c *taskCache) DeleteTasksList(ctx context.Context, pattern string) error {
 	return nil
 }
 
+// InvalidateUserTasks bumps the user's cache version. Listings are cached
+// under UserNamespace for the current version, so the old ones stop being
+// read at once and expire on their own, without scanning for them.
 func (c *taskCache) InvalidateUserTasks(ctx context.Context, userID string) error {
 	ctx, span := c.tracer.Start(ctx, "TaskCache.InvalidateUserTasks")
 	defer span.End()
 
 	span.SetAttributes(attribute.String("user.id", userID))
 
-	// Pattern for all user-related cache keys
-	pattern := fmt.Sprintf("tasks:user:%s:*", userID)
-	
-	c.logger.Debug("Invalidating user tasks cache", 
+	version, err := c.redisClient.Incr(ctx, c.userVersionKey(userID))
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.logger.Debug("User tasks cache invalidated", 
 		zap.String("user_id", userID),
-		zap.String("pattern", pattern),
+		zap.Int64("version", version),
 	)
+	return nil
+}
+
+// UserCacheVersion returns the user's current cache version, zero until
+// their cache is first invalidated.
+func (c *taskCache) UserCacheVersion(ctx context.Context, userID string) (int64, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.UserCacheVersion")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	data, err := c.redisClient.Get(ctx, c.userVersionKey(userID))
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	if data == "" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	return version, nil
+}
+
+func (c *taskCache) GetBoard(ctx context.Context, key string) (map[string][]*model.Task, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetBoard")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	c.logger.Debug("Getting board from cache", zap.String("key", key))
+
+	data, err := c.redisClient.Get(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		c.logger.Debug("Board cache miss", zap.String("key", key))
+		return nil, nil
+	}
+
+	var board map[string][]*model.Task
+	if err := json.Unmarshal([]byte(data), &board); err != nil {
+		c.logger.Error("Failed to unmarshal cached board", 
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c.logger.Debug("Board cache hit", zap.String("key", key))
+	return board, nil
+}
+
+func (c *taskCache) SetBoard(ctx context.Context, key string, board map[string][]*model.Task) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.SetBoard")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	c.logger.Debug("Setting board in cache", zap.String("key", key))
+
+	data, err := json.Marshal(board)
+	if err != nil {
+		c.logger.Error("Failed to marshal board for cache", 
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.redisClient.SetWithTTL(ctx, key, data, c.redisClient.ListCacheTTL()); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.logger.Debug("Board cached successfully", zap.String("key", key))
+	return nil
+}
+
+// GetLastSeen returns when the user last marked their tasks as seen, or nil
+// if they never have.
+func (c *taskCache) GetLastSeen(ctx context.Context, userID string) (*time.Time, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetLastSeen")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	cacheKey := c.lastSeenKey(userID)
+	data, err := c.redisClient.Get(ctx, cacheKey)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		return nil, nil
+	}
+
+	seenAt, err := time.Parse(time.RFC3339Nano, data)
+	if err != nil {
+		c.logger.Error("Failed to parse last seen timestamp", 
+			zap.Error(err),
+			zap.String("key", cacheKey),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &seenAt, nil
+}
+
+// SetLastSeen records the user's last-seen timestamp. Unlike cached task
+// data it never expires, otherwise every task would flip back to new.
+func (c *taskCache) SetLastSeen(ctx context.Context, userID string, seenAt time.Time) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.SetLastSeen")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	cacheKey := c.lastSeenKey(userID)
+	c.logger.Debug("Setting last seen timestamp", zap.String("key", cacheKey))
+
+	if err := c.redisClient.SetPersistent(ctx, cacheKey, seenAt.UTC().Format(time.RFC3339Nano)); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLastSeen forgets when the user last marked their tasks as seen.
+func (c *taskCache) DeleteLastSeen(ctx context.Context, userID string) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.DeleteLastSeen")
+	defer span.End()
+
+	if err := c.redisClient.Delete(ctx, c.lastSeenKey(userID)); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+// GetCount returns a cached task count, or nil on a miss.
+func (c *taskCache) GetCount(ctx context.Context, key string) (*int64, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetCount")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := c.redisClient.Get(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		return nil, nil
+	}
 
-	if err := c.DeleteTasksList(ctx, pattern); err != nil {
+	count, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		c.logger.Error("Failed to parse cached count", 
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &count, nil
+}
+
+func (c *taskCache) SetCount(ctx context.Context, key string, count int64) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.SetCount")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	if err := c.redisClient.SetWithTTL(ctx, key, strconv.FormatInt(count, 10), countCacheTTL); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// GetMatrix returns a cached status by priority matrix, or nil on a miss.
+func (c *taskCache) GetMatrix(ctx context.Context, key string) (map[string]map[string]int64, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetMatrix")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := c.redisClient.Get(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		return nil, nil
+	}
+
+	var matrix map[string]map[string]int64
+	if err := json.Unmarshal([]byte(data), &matrix); err != nil {
+		c.logger.Error("Failed to unmarshal cached matrix", 
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return matrix, nil
+}
+
+// SetMatrix caches a matrix for as long as a count.
+func (c *taskCache) SetMatrix(ctx context.Context, key string, matrix map[string]map[string]int64) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.SetMatrix")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := json.Marshal(matrix)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.redisClient.SetWithTTL(ctx, key, data, countCacheTTL); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// GetStats returns cached per-status counts, or nil on a miss.
+func (c *taskCache) GetStats(ctx context.Context, key string) (map[string]int64, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := c.redisClient.Get(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		return nil, nil
+	}
+
+	var stats map[string]int64
+	if err := json.Unmarshal([]byte(data), &stats); err != nil {
+		c.logger.Error("Failed to unmarshal cached stats", 
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// SetStats caches per-status counts for as long as a count.
+func (c *taskCache) SetStats(ctx context.Context, key string, stats map[string]int64) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.SetStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.redisClient.SetWithTTL(ctx, key, data, countCacheTTL); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// GetMetrics returns cached task metrics, or nil on a miss.
+func (c *taskCache) GetMetrics(ctx context.Context, key string) (*model.TaskMetrics, error) {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.GetMetrics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := c.redisClient.Get(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		return nil, nil
+	}
+
+	var metrics model.TaskMetrics
+	if err := json.Unmarshal([]byte(data), &metrics); err != nil {
+		c.logger.Error("Failed to unmarshal cached metrics", 
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &metrics, nil
+}
+
+// SetMetrics caches task metrics for as long as a count.
+func (c *taskCache) SetMetrics(ctx context.Context, key string, metrics *model.TaskMetrics) error {
+	ctx, span := c.tracer.Start(ctx, "TaskCache.SetMetrics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("cache.key", key))
+
+	data, err := json.Marshal(metrics)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.redisClient.SetWithTTL(ctx, key, data, countCacheTTL); err != nil {
 		span.RecordError(err)
 		return err
 	}
 
-	c.logger.Debug("User tasks cache invalidated", zap.String("user_id", userID))
 	return nil
 }
 
@@ -252,4 +684,21 @@ func (c *taskCache) tasksListKey(filterKey string) string {
 
 func (c *taskCache) userTasksKey(userID, filterKey string) string {
 	return fmt.Sprintf("tasks:user:%s:%s", userID, filterKey)
+}
+
+// userVersionKey lives outside the tasks:user:* namespace so that the
+// counter outlives the entries it versions.
+func (c *taskCache) userVersionKey(userID string) string {
+	return fmt.Sprintf("cacheversion:user:%s", userID)
+}
+
+// UserNamespace is the key prefix for a user's cached listings at version.
+func UserNamespace(userID string, version int64) string {
+	return fmt.Sprintf("tasks:user:%s:v%d", userID, version)
+}
+
+// lastSeenKey lives outside the user's versioned namespace so that
+// InvalidateUserTasks does not orphan it.
+func (c *taskCache) lastSeenKey(userID string) string {
+	return fmt.Sprintf("seen:user:%s", userID)
 }
\ No newline at end of file
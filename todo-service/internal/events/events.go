@@ -0,0 +1,124 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+)
+
+type EventType string
+
+const (
+	TaskCreated   EventType = "task.created"
+	TaskUpdated   EventType = "task.updated"
+	TaskDeleted   EventType = "task.deleted"
+	TaskCompleted EventType = "task.completed"
+	TaskRestored  EventType = "task.restored"
+)
+
+// Event describes a change to a task after it has been committed. Status
+// is the task's status once the change applied; when the change moved it,
+// Changes["status"] holds the old one too.
+type Event struct {
+	Type      EventType         `json:"type"`
+	TaskID    string            `json:"task_id"`
+	UserID    string            `json:"user_id"`
+	Status    model.TaskStatus  `json:"status"`
+	Timestamp time.Time         `json:"timestamp"`
+	Changes   map[string]Change `json:"changes,omitempty"`
+}
+
+// Change holds the before and after value of a single task field.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// EventPublisher delivers task events to whatever is listening. Callers treat
+// publishing as best-effort.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+type noopPublisher struct{}
+
+// NewNoopPublisher returns a publisher that drops every event.
+func NewNoopPublisher() EventPublisher {
+	return noopPublisher{}
+}
+
+func (noopPublisher) Publish(ctx context.Context, event Event) error {
+	return nil
+}
+
+// NewTaskEvent builds an event for task stamped with the current time.
+func NewTaskEvent(eventType EventType, task *model.Task, changes map[string]Change) Event {
+	return Event{
+		Type:      eventType,
+		TaskID:    task.ID,
+		UserID:    task.UserID,
+		Status:    task.Status,
+		Timestamp: time.Now().UTC(),
+		Changes:   changes,
+	}
+}
+
+// TaskChanges lists the user-editable fields that differ between before and
+// after.
+func TaskChanges(before, after *model.Task) map[string]Change {
+	changes := make(map[string]Change)
+
+	if before.Title != after.Title {
+		changes["title"] = Change{Old: before.Title, New: after.Title}
+	}
+	if before.Description != after.Description {
+		changes["description"] = Change{Old: before.Description, New: after.Description}
+	}
+	if before.Status != after.Status {
+		changes["status"] = Change{Old: before.Status, New: after.Status}
+	}
+	if before.Priority != after.Priority {
+		changes["priority"] = Change{Old: before.Priority, New: after.Priority}
+	}
+	if !sameTime(before.DueDate, after.DueDate) {
+		changes["due_date"] = Change{Old: before.DueDate, New: after.DueDate}
+	}
+	if !slices.Equal(before.Tags, after.Tags) {
+		changes["tags"] = Change{Old: before.Tags, New: after.Tags}
+	}
+	if before.Position != after.Position {
+		changes["position"] = Change{Old: before.Position, New: after.Position}
+	}
+
+	return changes
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+type multiPublisher struct {
+	publishers []EventPublisher
+}
+
+// NewMultiPublisher fans each event out to every publisher, returning the
+// joined errors of those that failed.
+func NewMultiPublisher(publishers ...EventPublisher) EventPublisher {
+	return &multiPublisher{publishers: publishers}
+}
+
+func (m *multiPublisher) Publish(ctx context.Context, event Event) error {
+	var errs []error
+	for _, publisher := range m.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
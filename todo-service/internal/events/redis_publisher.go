@@ -0,0 +1,43 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
+	"go.uber.org/zap"
+)
+
+type redisPublisher struct {
+	redisClient *redis.RedisClient
+	channel     string
+	logger      *zap.Logger
+}
+
+// NewRedisPublisher publishes events as JSON on a Redis pub/sub channel.
+func NewRedisPublisher(redisClient *redis.RedisClient, channel string) EventPublisher {
+	return &redisPublisher{
+		redisClient: redisClient,
+		channel:     channel,
+		logger:      zap.L().Named("event_publisher"),
+	}
+}
+
+func (p *redisPublisher) Publish(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error("Failed to marshal event", zap.Error(err), zap.String("type", string(event.Type)))
+		return err
+	}
+
+	if err := p.redisClient.Publish(ctx, p.channel, payload); err != nil {
+		return err
+	}
+
+	p.logger.Debug("Event published",
+		zap.String("type", string(event.Type)),
+		zap.String("task_id", event.TaskID),
+		zap.String("channel", p.channel),
+	)
+	return nil
+}
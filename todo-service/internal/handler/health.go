@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/amirhasanpour/task-manager/todo-service/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Component statuses reported by GetHealthDetails.
+const (
+	ComponentOK          = "ok"
+	ComponentUnavailable = "unavailable"
+	ComponentDisabled    = "disabled"
+)
+
+// HealthCheck reports whether one dependency is reachable. A nil check
+// marks the dependency as disabled.
+type HealthCheck func(ctx context.Context) error
+
+// SetHealthChecks sets the dependencies GetHealthDetails reports on, keyed
+// by component name.
+func (h *TaskHandler) SetHealthChecks(checks map[string]HealthCheck) {
+	h.healthChecks = checks
+}
+
+func (h *TaskHandler) GetHealthDetails(ctx context.Context, req *pb.GetTodoHealthDetailsRequest) (*pb.GetTodoHealthDetailsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetHealthDetails")
+	defer span.End()
+
+	components := make(map[string]string, len(h.healthChecks))
+	for name, check := range h.healthChecks {
+		if check == nil {
+			components[name] = ComponentDisabled
+			continue
+		}
+		if err := check(ctx); err != nil {
+			h.logger.Warn("Health check failed", zap.String("component", name), zap.Error(err))
+			components[name] = ComponentUnavailable
+			continue
+		}
+		components[name] = ComponentOK
+	}
+
+	return &pb.GetTodoHealthDetailsResponse{Components: components}, nil
+}
+
+// WatchServingStatus runs the health checks every interval until ctx is
+// done, reporting service to server as NOT_SERVING while any enabled check
+// fails and SERVING otherwise. Each round is bounded by interval. It
+// returns at once when interval is not positive.
+func (h *TaskHandler) WatchServingStatus(ctx context.Context, server *health.Server, service string, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		h.updateServingStatus(ctx, server, service, interval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *TaskHandler) updateServingStatus(ctx context.Context, server *health.Server, service string, timeout time.Duration) {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+	for name, check := range h.healthChecks {
+		if check == nil {
+			continue
+		}
+		if err := check(checkCtx); err != nil {
+			h.logger.Warn("Dependency unhealthy, not serving", zap.String("component", name), zap.Error(err))
+			servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			break
+		}
+	}
+
+	// Leave the status alone once shutdown has begun
+	if ctx.Err() != nil {
+		return
+	}
+	server.SetServingStatus(service, servingStatus)
+}
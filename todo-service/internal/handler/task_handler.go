@@ -2,6 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
@@ -11,6 +14,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -19,6 +24,11 @@ type TaskHandler struct {
 	service service.TaskService
 	logger  *zap.Logger
 	tracer  trace.Tracer
+
+	healthChecks map[string]HealthCheck
+	// listTagsLimit caps the tags returned per task in list responses;
+	// zero returns them all.
+	listTagsLimit int
 }
 
 func NewTaskHandler(service service.TaskService) *TaskHandler {
@@ -33,6 +43,12 @@ func (h *TaskHandler) SetTracer(tracer trace.Tracer) {
 	h.tracer = tracer
 }
 
+// SetListTagsLimit caps the tags returned per task in list responses. Tasks
+// with more are marked tags_truncated; single-task responses are not capped.
+func (h *TaskHandler) SetListTagsLimit(limit int) {
+	h.listTagsLimit = limit
+}
+
 func (h *TaskHandler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "TaskHandler.CreateTask")
 	defer span.End()
@@ -47,22 +63,7 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		zap.String("title", req.Title),
 	)
 
-	var dueDate *time.Time
-	if req.DueDate != nil {
-		dueDateValue := req.DueDate.AsTime()
-		dueDate = &dueDateValue
-	}
-
-	serviceReq := &service.CreateTaskRequest{
-		UserID:      req.UserId,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      req.Status.String(),
-		Priority:    req.Priority.String(),
-		DueDate:     dueDate,
-	}
-
-	task, err := h.service.CreateTask(ctx, serviceReq)
+	task, err := h.service.CreateTask(ctx, createRequestFromProto(req))
 	if err != nil {
 		h.logger.Error("Failed to create task", zap.Error(err))
 		return nil, err
@@ -79,6 +80,49 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 	return resp, nil
 }
 
+func (h *TaskHandler) CreateTasksBatch(ctx context.Context, req *pb.CreateTasksBatchRequest) (*pb.CreateTasksBatchResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.CreateTasksBatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("batch.size", len(req.Tasks)))
+
+	h.logger.Debug("CreateTasksBatch request received", zap.Int("count", len(req.Tasks)))
+
+	serviceReqs := make([]*service.CreateTaskRequest, 0, len(req.Tasks))
+	for _, item := range req.Tasks {
+		serviceReqs = append(serviceReqs, createRequestFromProto(item))
+	}
+
+	tasks, err := h.service.CreateTasksBatch(ctx, serviceReqs)
+	if err != nil {
+		// Rejected items are reported in the response, not as an RPC error
+		var batchErr *service.BatchCreateError
+		if errors.As(err, &batchErr) {
+			h.logger.Warn("CreateTasksBatch rejected", zap.Int("rejected", len(batchErr.Items)))
+			resp := &pb.CreateTasksBatchResponse{}
+			for _, item := range batchErr.Items {
+				resp.Errors = append(resp.Errors, &pb.BatchItemError{
+					Index:   int32(item.Index),
+					Message: item.Message,
+				})
+			}
+			return resp, nil
+		}
+		h.logger.Error("Failed to create task batch", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.CreateTasksBatchResponse{
+		Tasks: make([]*pb.Task, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		resp.Tasks = append(resp.Tasks, modelToProto(task))
+	}
+
+	h.logger.Info("CreateTasksBatch completed successfully", zap.Int("count", len(tasks)))
+	return resp, nil
+}
+
 func (h *TaskHandler) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetTask")
 	defer span.End()
@@ -101,6 +145,52 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.
 	return resp, nil
 }
 
+func (h *TaskHandler) GetTaskByUser(ctx context.Context, req *pb.GetTaskByUserRequest) (*pb.GetTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetTaskByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("GetTaskByUser request received",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId),
+	)
+
+	task, err := h.service.GetTaskByUser(ctx, req.Id, req.UserId, req.IncludeDeleted)
+	if err != nil {
+		h.logger.Error("Failed to get task by user", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
+	}
+
+	return &pb.GetTaskResponse{Task: modelToProto(task)}, nil
+}
+
+func (h *TaskHandler) GetTasksByIDs(ctx context.Context, req *pb.GetTasksByIDsRequest) (*pb.GetTasksByIDsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetTasksByIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("task.count", len(req.Ids)))
+
+	h.logger.Debug("GetTasksByIDs request received", zap.Int("count", len(req.Ids)))
+
+	tasks, err := h.service.GetTasksByIDs(ctx, req.Ids)
+	if err != nil {
+		h.logger.Error("Failed to get tasks by IDs", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = modelToProto(task)
+	}
+
+	h.logger.Debug("GetTasksByIDs completed successfully", zap.Int("task_count", len(tasks)))
+	return &pb.GetTasksByIDsResponse{Tasks: protoTasks}, nil
+}
+
 func (h *TaskHandler) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "TaskHandler.UpdateTask")
 	defer span.End()
@@ -120,24 +210,42 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest)
 		UserID: req.UserId,
 	}
 
-	// Only set fields that are provided
-	if req.Title != "" {
-		serviceReq.Title = &req.Title
-	}
-	if req.Description != "" {
-		serviceReq.Description = &req.Description
-	}
-	if req.Status != pb.TaskStatus(0) {
-		statusStr := req.Status.String()
-		serviceReq.Status = &statusStr
-	}
-	if req.Priority != pb.TaskPriority(0) {
-		priorityStr := req.Priority.String()
-		serviceReq.Priority = &priorityStr
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		if err := applyTaskUpdateMask(serviceReq, req, paths); err != nil {
+			return nil, err
+		}
+	} else {
+		// Only set fields that are provided
+		if req.Title != "" {
+			serviceReq.Title = &req.Title
+		}
+		if req.Description != nil {
+			serviceReq.Description = req.Description
+		}
+		if req.Status != nil {
+			statusStr := req.Status.String()
+			serviceReq.Status = &statusStr
+		}
+		if req.Priority != nil {
+			priorityStr := req.Priority.String()
+			serviceReq.Priority = &priorityStr
+		}
+		if req.DueDate != nil {
+			dueDate := req.DueDate.AsTime()
+			serviceReq.DueDate = &dueDate
+		}
+		if req.ClearTags {
+			serviceReq.Tags = &[]string{}
+		} else if len(req.Tags) > 0 {
+			serviceReq.Tags = &req.Tags
+		}
+		if req.RecurrenceRule != nil {
+			serviceReq.RecurrenceRule = req.RecurrenceRule
+		}
 	}
-	if req.DueDate != nil {
-		dueDate := req.DueDate.AsTime()
-		serviceReq.DueDate = &dueDate
+	if req.ExpectedVersion != nil {
+		expected := int(*req.ExpectedVersion)
+		serviceReq.ExpectedVersion = &expected
 	}
 
 	task, err := h.service.UpdateTask(ctx, serviceReq)
@@ -157,6 +265,47 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest)
 	return resp, nil
 }
 
+
+// applyTaskUpdateMask sets exactly the fields named in paths on serviceReq,
+// taking their values from req even when empty. A masked due_date that is
+// unset clears the due date.
+func applyTaskUpdateMask(serviceReq *service.UpdateTaskRequest, req *pb.UpdateTaskRequest, paths []string) error {
+	for _, path := range paths {
+		switch path {
+		case "title":
+			serviceReq.Title = &req.Title
+		case "description":
+			description := req.GetDescription()
+			serviceReq.Description = &description
+		case "status":
+			statusStr := req.GetStatus().String()
+			serviceReq.Status = &statusStr
+		case "priority":
+			priorityStr := req.GetPriority().String()
+			serviceReq.Priority = &priorityStr
+		case "due_date":
+			if req.DueDate != nil {
+				dueDate := req.DueDate.AsTime()
+				serviceReq.DueDate = &dueDate
+			} else {
+				serviceReq.ClearDueDate = true
+			}
+		case "tags":
+			tags := req.Tags
+			if tags == nil {
+				tags = []string{}
+			}
+			serviceReq.Tags = &tags
+		case "recurrence_rule":
+			rule := req.GetRecurrenceRule()
+			serviceReq.RecurrenceRule = &rule
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+	return nil
+}
+
 func (h *TaskHandler) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "TaskHandler.DeleteTask")
 	defer span.End()
@@ -179,150 +328,1057 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 	return resp, nil
 }
 
-func (h *TaskHandler) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
-	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListTasks")
+func (h *TaskHandler) DeleteTaskByUser(ctx context.Context, req *pb.DeleteTaskByUserRequest) (*pb.DeleteTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.DeleteTaskByUser")
 	defer span.End()
 
 	span.SetAttributes(
-		attribute.Int("page", int(req.Page)),
-		attribute.Int("page_size", int(req.PageSize)),
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
 	)
 
-	h.logger.Debug("ListTasks request received", 
-		zap.Int32("page", req.Page),
-		zap.Int32("page_size", req.PageSize),
-		zap.String("filter_status", req.FilterByStatus),
-		zap.String("filter_priority", req.FilterByPriority),
-		zap.String("filter_user_id", req.FilterByUserId),
-		zap.String("sort_by", req.SortBy),
-		zap.Bool("sort_desc", req.SortDesc),
+	h.logger.Debug("DeleteTaskByUser request received",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId),
 	)
 
-	page := int(req.Page)
-	pageSize := int(req.PageSize)
-
-	filter := &repository.TaskFilter{
-		SortBy:   req.SortBy,
-		SortDesc: req.SortDesc,
+	if err := h.service.DeleteTaskByUser(ctx, req.Id, req.UserId); err != nil {
+		h.logger.Error("Failed to delete task by user", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
 	}
 
-	// Apply filters if provided
-	if req.FilterByStatus != "" {
-		filter.Status = &req.FilterByStatus
-	}
-	if req.FilterByPriority != "" {
-		filter.Priority = &req.FilterByPriority
-	}
-	if req.FilterByUserId != "" {
-		filter.UserID = &req.FilterByUserId
-	}
+	h.logger.Info("DeleteTaskByUser completed successfully", zap.String("id", req.Id))
+	return &pb.DeleteTaskResponse{Success: true}, nil
+}
 
-	tasks, total, err := h.service.ListTasks(ctx, filter, page, pageSize)
+func (h *TaskHandler) RestoreTask(ctx context.Context, req *pb.RestoreTaskRequest) (*pb.RestoreTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.RestoreTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("RestoreTask request received",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId),
+	)
+
+	task, err := h.service.RestoreTask(ctx, req.Id, req.UserId)
 	if err != nil {
-		h.logger.Error("Failed to list tasks", zap.Error(err))
+		h.logger.Error("Failed to restore task", zap.Error(err), zap.String("id", req.Id))
 		return nil, err
 	}
 
-	protoTasks := make([]*pb.Task, len(tasks))
-	for i, task := range tasks {
-		protoTasks[i] = modelToProto(task)
+	h.logger.Info("RestoreTask completed successfully", zap.String("task_id", task.ID))
+	return &pb.RestoreTaskResponse{Task: modelToProto(task)}, nil
+}
+
+func (h *TaskHandler) PurgeTask(ctx context.Context, req *pb.PurgeTaskRequest) (*pb.DeleteTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.PurgeTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("PurgeTask request received",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId),
+	)
+
+	if err := h.service.PurgeTask(ctx, req.Id, req.UserId); err != nil {
+		h.logger.Error("Failed to purge task", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
 	}
 
-	resp := &pb.ListTasksResponse{
-		Tasks:    protoTasks,
-		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
+	h.logger.Info("PurgeTask completed successfully", zap.String("id", req.Id))
+	return &pb.DeleteTaskResponse{Success: true}, nil
+}
+
+func (h *TaskHandler) RestoreAllTasks(ctx context.Context, req *pb.RestoreAllTasksRequest) (*pb.RestoreAllTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.RestoreAllTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("RestoreAllTasks request received", zap.String("user_id", req.UserId))
+
+	restored, err := h.service.RestoreAllTasks(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to restore all tasks", zap.Error(err))
+		return nil, err
 	}
 
-	h.logger.Debug("ListTasks completed successfully", 
-		zap.Int("task_count", len(tasks)),
-		zap.Int64("total", total),
-	)
-	return resp, nil
+	h.logger.Info("RestoreAllTasks completed successfully", zap.Int64("restored", restored))
+	return &pb.RestoreAllTasksResponse{Restored: restored}, nil
 }
 
-func (h *TaskHandler) ListTasksByUser(ctx context.Context, req *pb.ListTasksByUserRequest) (*pb.ListTasksByUserResponse, error) {
-	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListTasksByUser")
+func (h *TaskHandler) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.CompleteTask")
 	defer span.End()
 
 	span.SetAttributes(
+		attribute.String("task.id", req.Id),
 		attribute.String("user.id", req.UserId),
-		attribute.Int("page", int(req.Page)),
-		attribute.Int("page_size", int(req.PageSize)),
 	)
 
-	h.logger.Debug("ListTasksByUser request received", 
+	h.logger.Debug("CompleteTask request received",
+		zap.String("id", req.Id),
 		zap.String("user_id", req.UserId),
-		zap.Int32("page", req.Page),
-		zap.Int32("page_size", req.PageSize),
-		zap.String("filter_status", req.FilterByStatus),
-		zap.String("filter_priority", req.FilterByPriority),
-		zap.String("sort_by", req.SortBy),
-		zap.Bool("sort_desc", req.SortDesc),
 	)
 
-	page := int(req.Page)
-	pageSize := int(req.PageSize)
-
-	filter := &repository.TaskFilter{
-		SortBy:   req.SortBy,
-		SortDesc: req.SortDesc,
+	completed, next, err := h.service.CompleteTask(ctx, req.Id, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to complete task", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
 	}
 
-	// Apply filters if provided
-	if req.FilterByStatus != "" {
-		filter.Status = &req.FilterByStatus
-	}
-	if req.FilterByPriority != "" {
-		filter.Priority = &req.FilterByPriority
+	resp := &pb.CompleteTaskResponse{Completed: modelToProto(completed)}
+	if next != nil {
+		resp.Next = modelToProto(next)
 	}
 
-	tasks, total, err := h.service.ListTasksByUser(ctx, req.UserId, filter, page, pageSize)
+	h.logger.Info("CompleteTask completed successfully", zap.String("task_id", completed.ID))
+	return resp, nil
+}
+
+func (h *TaskHandler) ArchiveTask(ctx context.Context, req *pb.ArchiveTaskRequest) (*pb.ArchiveTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ArchiveTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("ArchiveTask request received",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId),
+	)
+
+	task, err := h.service.ArchiveTask(ctx, req.Id, req.UserId)
 	if err != nil {
-		h.logger.Error("Failed to list tasks by user", zap.Error(err))
+		h.logger.Error("Failed to archive task", zap.Error(err), zap.String("id", req.Id))
 		return nil, err
 	}
 
-	protoTasks := make([]*pb.Task, len(tasks))
-	for i, task := range tasks {
-		protoTasks[i] = modelToProto(task)
-	}
+	h.logger.Info("ArchiveTask completed successfully", zap.String("task_id", task.ID))
+	return &pb.ArchiveTaskResponse{Task: modelToProto(task)}, nil
+}
 
-	resp := &pb.ListTasksByUserResponse{
-		Tasks:    protoTasks,
-		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
-	}
+func (h *TaskHandler) UnarchiveTask(ctx context.Context, req *pb.UnarchiveTaskRequest) (*pb.UnarchiveTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.UnarchiveTask")
+	defer span.End()
 
-	h.logger.Debug("ListTasksByUser completed successfully", 
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("UnarchiveTask request received",
+		zap.String("id", req.Id),
 		zap.String("user_id", req.UserId),
-		zap.Int("task_count", len(tasks)),
-		zap.Int64("total", total),
 	)
-	return resp, nil
-}
 
-func modelToProto(task *model.Task) *pb.Task {
-	if task == nil {
-		return nil
+	task, err := h.service.UnarchiveTask(ctx, req.Id, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to unarchive task", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
 	}
 
-	protoTask := &pb.Task{
-		Id:          task.ID,
-		UserId:      task.UserID,
-		Title:       task.Title,
-		Description: task.Description,
-		Status:      protoStatus(task.ToProtoStatus()),
-		Priority:    protoPriority(task.ToProtoPriority()),
-		CreatedAt:   timestamppb.New(task.CreatedAt),
-		UpdatedAt:   timestamppb.New(task.UpdatedAt),
+	h.logger.Info("UnarchiveTask completed successfully", zap.String("task_id", task.ID))
+	return &pb.UnarchiveTaskResponse{Task: modelToProto(task)}, nil
+}
+
+func (h *TaskHandler) MoveTaskOnBoard(ctx context.Context, req *pb.MoveTaskOnBoardRequest) (*pb.MoveTaskOnBoardResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.MoveTaskOnBoard")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("MoveTaskOnBoard request received",
+		zap.String("id", req.Id),
+		zap.String("status", req.Status.String()),
+		zap.Int32("position", req.Position),
+	)
+
+	task, err := h.service.MoveTaskOnBoard(ctx, req.Id, req.UserId, req.Status.String(), int(req.Position))
+	if err != nil {
+		h.logger.Error("Failed to move task on board", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
 	}
 
-	if task.DueDate != nil {
-		protoTask.DueDate = timestamppb.New(*task.DueDate)
+	h.logger.Info("MoveTaskOnBoard completed successfully", zap.String("task_id", task.ID))
+	return &pb.MoveTaskOnBoardResponse{Task: modelToProto(task)}, nil
+}
+
+func (h *TaskHandler) ReorderTask(ctx context.Context, req *pb.ReorderTaskRequest) (*pb.ReorderTaskResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ReorderTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", req.Id),
+		attribute.String("user.id", req.UserId),
+	)
+
+	h.logger.Debug("ReorderTask request received",
+		zap.String("id", req.Id),
+		zap.Int32("position", req.Position),
+	)
+
+	task, err := h.service.ReorderTask(ctx, req.Id, req.UserId, int(req.Position))
+	if err != nil {
+		h.logger.Error("Failed to reorder task", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
+	}
+
+	h.logger.Info("ReorderTask completed successfully", zap.String("task_id", task.ID))
+	return &pb.ReorderTaskResponse{Task: modelToProto(task)}, nil
+}
+
+func (h *TaskHandler) PurgeUserData(ctx context.Context, req *pb.PurgeUserDataRequest) (*pb.PurgeUserDataResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.PurgeUserData")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("PurgeUserData request received", zap.String("user_id", req.UserId))
+
+	result, err := h.service.PurgeUserData(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to purge user data", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	return &pb.PurgeUserDataResponse{
+		TasksDeleted:    int64(len(result.Tasks)),
+		WebhooksDeleted: result.WebhooksDeleted,
+	}, nil
+}
+
+func (h *TaskHandler) BulkSetDueDate(ctx context.Context, req *pb.BulkSetDueDateRequest) (*pb.BulkSetDueDateResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.BulkSetDueDate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("task.count", len(req.Ids)),
+	)
+
+	h.logger.Debug("BulkSetDueDate request received",
+		zap.String("user_id", req.UserId),
+		zap.Int("count", len(req.Ids)),
+	)
+
+	var dueDate *time.Time
+	if req.DueDate != nil {
+		due := req.DueDate.AsTime()
+		dueDate = &due
+	}
+
+	updated, err := h.service.BulkSetDueDate(ctx, req.UserId, req.Ids, dueDate)
+	if err != nil {
+		h.logger.Error("Failed to set due dates", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("BulkSetDueDate completed successfully", zap.Int("updated", updated))
+	return &pb.BulkSetDueDateResponse{Updated: int32(updated)}, nil
+}
+
+func (h *TaskHandler) BulkAddTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.BulkAddTags")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("task.count", len(req.Ids)),
+	)
+
+	h.logger.Debug("BulkAddTags request received",
+		zap.String("user_id", req.UserId),
+		zap.Int("count", len(req.Ids)),
+	)
+
+	updated, err := h.service.BulkAddTags(ctx, req.UserId, req.Ids, req.Tags)
+	if err != nil {
+		h.logger.Error("Failed to add tags", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("BulkAddTags completed successfully", zap.Int("updated", updated))
+	return &pb.BulkTagsResponse{Updated: int32(updated)}, nil
+}
+
+func (h *TaskHandler) BulkRemoveTags(ctx context.Context, req *pb.BulkTagsRequest) (*pb.BulkTagsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.BulkRemoveTags")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("task.count", len(req.Ids)),
+	)
+
+	h.logger.Debug("BulkRemoveTags request received",
+		zap.String("user_id", req.UserId),
+		zap.Int("count", len(req.Ids)),
+	)
+
+	updated, err := h.service.BulkRemoveTags(ctx, req.UserId, req.Ids, req.Tags)
+	if err != nil {
+		h.logger.Error("Failed to remove tags", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("BulkRemoveTags completed successfully", zap.Int("updated", updated))
+	return &pb.BulkTagsResponse{Updated: int32(updated)}, nil
+}
+
+func (h *TaskHandler) SnoozeOverdue(ctx context.Context, req *pb.SnoozeOverdueRequest) (*pb.SnoozeOverdueResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.SnoozeOverdue")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("SnoozeOverdue request received",
+		zap.String("user_id", req.UserId),
+		zap.Int64("duration_seconds", req.DurationSeconds),
+	)
+
+	snoozed, err := h.service.SnoozeOverdue(ctx, req.UserId, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		h.logger.Error("Failed to snooze overdue tasks", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("SnoozeOverdue completed successfully", zap.Int("snoozed", snoozed))
+	return &pb.SnoozeOverdueResponse{Snoozed: int32(snoozed)}, nil
+}
+
+func (h *TaskHandler) DeleteCompletedTasks(ctx context.Context, req *pb.DeleteCompletedTasksRequest) (*pb.DeleteCompletedTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.DeleteCompletedTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("DeleteCompletedTasks request received", zap.String("user_id", req.UserId))
+
+	deleted, err := h.service.DeleteCompletedTasks(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to delete completed tasks", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("DeleteCompletedTasks completed successfully", zap.Int64("deleted", deleted))
+	return &pb.DeleteCompletedTasksResponse{Deleted: deleted}, nil
+}
+
+func (h *TaskHandler) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListTasks")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("page_size", int(req.PageSize)),
+	)
+
+	h.logger.Debug("ListTasks request received", 
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize),
+		zap.String("filter_status", req.FilterByStatus),
+		zap.String("filter_priority", req.FilterByPriority),
+		zap.String("filter_user_id", req.FilterByUserId),
+		zap.String("sort_by", req.SortBy),
+		zap.Bool("sort_desc", req.SortDesc),
+	)
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+
+	filter := &repository.TaskFilter{
+		SortBy:   req.SortBy,
+		SortDesc: req.SortDesc,
+	}
+
+	// Apply filters if provided
+	if req.FilterByStatus != "" {
+		filter.Status = &req.FilterByStatus
+	}
+	if req.FilterByPriority != "" {
+		filter.Priority = &req.FilterByPriority
+	}
+	if req.FilterByUserId != "" {
+		filter.UserID = &req.FilterByUserId
+	}
+	if req.DueBefore != nil {
+		dueBefore := req.DueBefore.AsTime()
+		filter.DueBefore = &dueBefore
+	}
+	if req.DueAfter != nil {
+		dueAfter := req.DueAfter.AsTime()
+		filter.DueAfter = &dueAfter
+	}
+	if req.CompletedAfter != nil {
+		completedAfter := req.CompletedAfter.AsTime()
+		filter.CompletedAfter = &completedAfter
+	}
+	if req.CompletedBefore != nil {
+		completedBefore := req.CompletedBefore.AsTime()
+		filter.CompletedBefore = &completedBefore
+	}
+	if req.Search != "" {
+		filter.Search = &req.Search
+	}
+	filter.IncludeDeleted = req.IncludeDeleted
+	filter.IncludeArchived = req.IncludeArchived
+	filter.Tags = req.Tags
+
+	if req.Cursor != nil {
+		return h.listTasksCursor(ctx, filter, *req.Cursor, pageSize)
+	}
+
+	tasks, total, err := h.service.ListTasks(ctx, filter, page, pageSize)
+	if err != nil {
+		h.logger.Error("Failed to list tasks", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+	}
+
+	resp := &pb.ListTasksResponse{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
+
+	h.logger.Debug("ListTasks completed successfully", 
+		zap.Int("task_count", len(tasks)),
+		zap.Int64("total", total),
+	)
+	return resp, nil
+}
+
+func (h *TaskHandler) listTasksCursor(ctx context.Context, filter *repository.TaskFilter, cursor string, pageSize int) (*pb.ListTasksResponse, error) {
+	tasks, nextCursor, err := h.service.ListTasksCursor(ctx, filter, cursor, pageSize)
+	if err != nil {
+		h.logger.Error("Failed to list tasks by cursor", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+	}
+
+	h.logger.Debug("ListTasks completed successfully", 
+		zap.Int("task_count", len(tasks)),
+		zap.Bool("has_more", nextCursor != ""),
+	)
+	return &pb.ListTasksResponse{Tasks: protoTasks, NextCursor: nextCursor, HasNext: nextCursor != ""}, nil
+}
+
+func (h *TaskHandler) ListTasksByUser(ctx context.Context, req *pb.ListTasksByUserRequest) (*pb.ListTasksByUserResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListTasksByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("page_size", int(req.PageSize)),
+	)
+
+	h.logger.Debug("ListTasksByUser request received", 
+		zap.String("user_id", req.UserId),
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize),
+		zap.String("filter_status", req.FilterByStatus),
+		zap.String("filter_priority", req.FilterByPriority),
+		zap.String("sort_by", req.SortBy),
+		zap.Bool("sort_desc", req.SortDesc),
+	)
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+
+	filter := &repository.TaskFilter{
+		SortBy:   req.SortBy,
+		SortDesc: req.SortDesc,
+	}
+
+	// Apply filters if provided
+	if req.FilterByStatus != "" {
+		filter.Status = &req.FilterByStatus
+	}
+	if req.FilterByPriority != "" {
+		filter.Priority = &req.FilterByPriority
+	}
+	if req.DueBefore != nil {
+		dueBefore := req.DueBefore.AsTime()
+		filter.DueBefore = &dueBefore
+	}
+	if req.DueAfter != nil {
+		dueAfter := req.DueAfter.AsTime()
+		filter.DueAfter = &dueAfter
+	}
+	if req.CompletedAfter != nil {
+		completedAfter := req.CompletedAfter.AsTime()
+		filter.CompletedAfter = &completedAfter
+	}
+	if req.CompletedBefore != nil {
+		completedBefore := req.CompletedBefore.AsTime()
+		filter.CompletedBefore = &completedBefore
+	}
+	if req.Search != "" {
+		filter.Search = &req.Search
+	}
+	filter.IncludeDeleted = req.IncludeDeleted
+	filter.IncludeArchived = req.IncludeArchived
+	filter.Tags = req.Tags
+
+	tasks, total, err := h.service.ListTasksByUser(ctx, req.UserId, filter, page, pageSize)
+	if err != nil {
+		h.logger.Error("Failed to list tasks by user", zap.Error(err))
+		return nil, err
+	}
+
+	// A missing last-seen timestamp only costs the is_new flags, so don't
+	// fail the listing over it.
+	lastSeen, err := h.service.GetLastSeen(ctx, req.UserId)
+	if err != nil {
+		h.logger.Warn("Failed to get last seen timestamp", zap.Error(err))
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+		if err == nil {
+			protoTasks[i].IsNew = lastSeen == nil || task.UpdatedAt.After(*lastSeen)
+		}
+	}
+
+	resp := &pb.ListTasksByUserResponse{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
+
+	h.logger.Debug("ListTasksByUser completed successfully", 
+		zap.String("user_id", req.UserId),
+		zap.Int("task_count", len(tasks)),
+		zap.Int64("total", total),
+	)
+	return resp, nil
+}
+
+func (h *TaskHandler) ListTasksModifiedBy(ctx context.Context, req *pb.ListTasksModifiedByRequest) (*pb.ListTasksModifiedByResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListTasksModifiedBy")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("ListTasksModifiedBy request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize),
+	)
+
+	tasks, total, err := h.service.ListTasksModifiedBy(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list tasks by last modifier", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+	}
+
+	resp := &pb.ListTasksModifiedByResponse{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
+	return resp, nil
+}
+
+func (h *TaskHandler) ListOverdueTasks(ctx context.Context, req *pb.ListOverdueTasksRequest) (*pb.ListOverdueTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListOverdueTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("ListOverdueTasks request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize),
+	)
+
+	tasks, total, err := h.service.ListOverdueTasks(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list overdue tasks", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+	}
+
+	resp := &pb.ListOverdueTasksResponse{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
+	return resp, nil
+}
+
+func (h *TaskHandler) ListDeletedTasks(ctx context.Context, req *pb.ListDeletedTasksRequest) (*pb.ListDeletedTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListDeletedTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("ListDeletedTasks request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize),
+	)
+
+	tasks, total, err := h.service.ListDeletedTasks(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list deleted tasks", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+	}
+
+	resp := &pb.ListDeletedTasksResponse{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
+	return resp, nil
+}
+
+func (h *TaskHandler) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.SearchTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("SearchTasks request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize),
+	)
+
+	tasks, total, err := h.service.SearchTasks(ctx, req.UserId, req.Query, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to search tasks", zap.Error(err))
+		return nil, err
+	}
+
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = h.listTaskToProto(task)
+	}
+
+	resp := &pb.SearchTasksResponse{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
+	return resp, nil
+}
+
+func (h *TaskHandler) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.GetBoardResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetBoard")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", req.UserId),
+		attribute.Int("per_column", int(req.PerColumn)),
+	)
+
+	h.logger.Debug("GetBoard request received", 
+		zap.String("user_id", req.UserId),
+		zap.Int32("per_column", req.PerColumn),
+	)
+
+	board, err := h.service.GetBoard(ctx, req.UserId, int(req.PerColumn))
+	if err != nil {
+		h.logger.Error("Failed to get board", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	columns := make([]*pb.BoardColumn, 0, len(board))
+	for taskStatus, tasks := range board {
+		column := &pb.BoardColumn{
+			Status: protoStatus(strings.ToUpper(taskStatus)),
+			Tasks:  make([]*pb.Task, len(tasks)),
+		}
+		for i, task := range tasks {
+			column.Tasks[i] = h.listTaskToProto(task)
+		}
+		columns = append(columns, column)
+	}
+
+	// Keep columns in workflow order regardless of map iteration
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i].Status < columns[j].Status
+	})
+
+	h.logger.Debug("GetBoard completed successfully", 
+		zap.String("user_id", req.UserId),
+		zap.Int("column_count", len(columns)),
+	)
+	return &pb.GetBoardResponse{Columns: columns}, nil
+}
+
+func (h *TaskHandler) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetUnreadCount")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("GetUnreadCount request received", zap.String("user_id", req.UserId))
+
+	count, lastSeen, err := h.service.GetUnreadCount(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to count unread tasks", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	resp := &pb.GetUnreadCountResponse{Count: count}
+	if lastSeen != nil {
+		resp.LastSeen = timestamppb.New(*lastSeen)
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) MarkAllSeen(ctx context.Context, req *pb.MarkAllSeenRequest) (*pb.MarkAllSeenResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.MarkAllSeen")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("MarkAllSeen request received", zap.String("user_id", req.UserId))
+
+	seenAt, err := h.service.MarkAllSeen(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to mark tasks as seen", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	resp := &pb.MarkAllSeenResponse{
+		SeenAt: timestamppb.New(seenAt),
+	}
+
+	h.logger.Debug("MarkAllSeen completed successfully", zap.String("user_id", req.UserId))
+	return resp, nil
+}
+
+func (h *TaskHandler) CountTasks(ctx context.Context, req *pb.CountTasksRequest) (*pb.CountTasksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.CountTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("CountTasks request received", 
+		zap.String("user_id", req.UserId),
+		zap.String("filter_status", req.FilterByStatus),
+		zap.String("filter_priority", req.FilterByPriority),
+	)
+
+	filter := &repository.TaskFilter{}
+	if req.FilterByStatus != "" {
+		filter.Status = &req.FilterByStatus
+	}
+	if req.FilterByPriority != "" {
+		filter.Priority = &req.FilterByPriority
+	}
+	filter.IncludeArchived = req.IncludeArchived
+
+	count, err := h.service.CountTasks(ctx, req.UserId, filter)
+	if err != nil {
+		h.logger.Error("Failed to count tasks", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	h.logger.Debug("CountTasks completed successfully", 
+		zap.String("user_id", req.UserId),
+		zap.Int64("count", count),
+	)
+	return &pb.CountTasksResponse{Count: count}, nil
+}
+
+func (h *TaskHandler) GetTaskMatrix(ctx context.Context, req *pb.GetTaskMatrixRequest) (*pb.GetTaskMatrixResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetTaskMatrix")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("GetTaskMatrix request received", zap.String("user_id", req.UserId))
+
+	matrix, err := h.service.GetTaskMatrix(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to get task matrix", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	resp := &pb.GetTaskMatrixResponse{
+		Statuses: make(map[string]*pb.TaskMatrixRow, len(matrix)),
+	}
+	for taskStatus, priorities := range matrix {
+		resp.Statuses[taskStatus] = &pb.TaskMatrixRow{Priorities: priorities}
+	}
+
+	h.logger.Debug("GetTaskMatrix completed successfully", zap.String("user_id", req.UserId))
+	return resp, nil
+}
+
+func (h *TaskHandler) GetTaskStats(ctx context.Context, req *pb.GetTaskStatsRequest) (*pb.GetTaskStatsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetTaskStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("GetTaskStats request received", zap.String("user_id", req.UserId))
+
+	stats, err := h.service.GetTaskStats(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to get task stats", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	h.logger.Debug("GetTaskStats completed successfully", zap.String("user_id", req.UserId))
+	return &pb.GetTaskStatsResponse{Counts: stats}, nil
+}
+
+func (h *TaskHandler) GetUserTaskMetrics(ctx context.Context, req *pb.GetUserTaskMetricsRequest) (*pb.GetUserTaskMetricsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetUserTaskMetrics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("GetUserTaskMetrics request received", zap.String("user_id", req.UserId))
+
+	metrics, err := h.service.GetUserMetrics(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to get user task metrics", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	resp := &pb.GetUserTaskMetricsResponse{
+		Total:     metrics.Total,
+		Completed: metrics.Completed,
+		Overdue:   metrics.Overdue,
+	}
+	if metrics.LastActivityAt != nil {
+		resp.LastActivityAt = timestamppb.New(*metrics.LastActivityAt)
+	}
+
+	h.logger.Debug("GetUserTaskMetrics completed successfully", zap.String("user_id", req.UserId))
+	return resp, nil
+}
+
+func (h *TaskHandler) GetCompletionTrends(ctx context.Context, req *pb.GetCompletionTrendsRequest) (*pb.GetCompletionTrendsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.GetCompletionTrends")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("GetCompletionTrends request received",
+		zap.String("user_id", req.UserId),
+		zap.String("granularity", req.Granularity),
+	)
+
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	points, err := h.service.GetCompletionTrends(ctx, req.UserId, req.Granularity, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		h.logger.Error("Failed to get completion trends", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	resp := &pb.GetCompletionTrendsResponse{Points: make([]*pb.CompletionTrendPoint, 0, len(points))}
+	for _, point := range points {
+		resp.Points = append(resp.Points, &pb.CompletionTrendPoint{
+			Start:     timestamppb.New(point.Start),
+			Completed: point.Completed,
+		})
+	}
+
+	h.logger.Debug("GetCompletionTrends completed successfully", zap.Int("points", len(points)))
+	return resp, nil
+}
+
+func (h *TaskHandler) ListTasksNeedingAttention(ctx context.Context, req *pb.ListTasksNeedingAttentionRequest) (*pb.ListTasksNeedingAttentionResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.ListTasksNeedingAttention")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("ListTasksNeedingAttention request received", zap.String("user_id", req.UserId))
+
+	ranked, err := h.service.ListTasksNeedingAttention(ctx, req.UserId, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list tasks needing attention", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	resp := &pb.ListTasksNeedingAttentionResponse{
+		Tasks: make([]*pb.AttentionTask, len(ranked)),
+	}
+	for i, item := range ranked {
+		resp.Tasks[i] = &pb.AttentionTask{
+			Task:    h.listTaskToProto(item.Task),
+			Score:   item.Score,
+			Reasons: item.Reasons,
+		}
+	}
+
+	h.logger.Debug("ListTasksNeedingAttention completed successfully", zap.Int("count", len(ranked)))
+	return resp, nil
+}
+
+func (h *TaskHandler) PreviewRecurrence(ctx context.Context, req *pb.PreviewRecurrenceRequest) (*pb.PreviewRecurrenceResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "TaskHandler.PreviewRecurrence")
+	defer span.End()
+
+	h.logger.Debug("PreviewRecurrence request received",
+		zap.String("rule", req.Rule),
+		zap.Int32("count", req.Count),
+	)
+
+	// Preview from now unless told otherwise
+	from := time.Now()
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+
+	occurrences, err := h.service.PreviewRecurrence(ctx, req.Rule, from, int(req.Count))
+	if err != nil {
+		h.logger.Debug("Failed to preview recurrence", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.PreviewRecurrenceResponse{
+		Occurrences: make([]*timestamppb.Timestamp, len(occurrences)),
+	}
+	for i, occurrence := range occurrences {
+		resp.Occurrences[i] = timestamppb.New(occurrence)
+	}
+
+	return resp, nil
+}
+
+func createRequestFromProto(req *pb.CreateTaskRequest) *service.CreateTaskRequest {
+	var dueDate *time.Time
+	if req.DueDate != nil {
+		dueDateValue := req.DueDate.AsTime()
+		dueDate = &dueDateValue
+	}
+
+	return &service.CreateTaskRequest{
+		UserID:      req.UserId,
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status.String(),
+		Priority:    req.Priority.String(),
+		DueDate:     dueDate,
+		Tags:        req.Tags,
+		RecurrenceRule: req.RecurrenceRule,
+	}
+}
+
+// pageInfo reports how many pages of pageSize total items make, and whether
+// there is one after page. A pageSize below 1 yields no pages.
+func pageInfo(total int64, page, pageSize int32) (int32, bool) {
+	if pageSize < 1 {
+		return 0, false
+	}
+	totalPages := int32((total + int64(pageSize) - 1) / int64(pageSize))
+	return totalPages, max(page, 1) < totalPages
+}
+
+// listTaskToProto converts a task for a list response, capping its tags at
+// the configured limit.
+func (h *TaskHandler) listTaskToProto(task *model.Task) *pb.Task {
+	protoTask := modelToProto(task)
+	if protoTask != nil && h.listTagsLimit > 0 && len(protoTask.Tags) > h.listTagsLimit {
+		protoTask.Tags = protoTask.Tags[:h.listTagsLimit]
+		protoTask.TagsTruncated = true
+	}
+	return protoTask
+}
+
+func modelToProto(task *model.Task) *pb.Task {
+	if task == nil {
+		return nil
+	}
+
+	protoTask := &pb.Task{
+		Id:          task.ID,
+		UserId:      task.UserID,
+		Title:       task.Title,
+		Description: task.Description,
+		Status:      protoStatus(task.ToProtoStatus()),
+		Priority:    protoPriority(task.ToProtoPriority()),
+		CreatedAt:   timestamppb.New(task.CreatedAt),
+		UpdatedAt:   timestamppb.New(task.UpdatedAt),
+		Position:    int32(task.Position),
+		Version:     int32(task.Version),
+	}
+
+	if task.DueDate != nil {
+		protoTask.DueDate = timestamppb.New(*task.DueDate)
+	}
+	if len(task.Tags) > 0 {
+		protoTask.Tags = task.Tags
+	}
+	if task.DeletedAt.Valid {
+		protoTask.DeletedAt = timestamppb.New(task.DeletedAt.Time)
+	}
+	if task.UpdatedBy != nil {
+		protoTask.UpdatedBy = *task.UpdatedBy
+	}
+	if task.RecurrenceRule != nil {
+		protoTask.RecurrenceRule = *task.RecurrenceRule
+	}
+	if task.ParentTaskID != nil {
+		protoTask.ParentTaskId = *task.ParentTaskID
+	}
+	if task.CompletedAt != nil {
+		protoTask.CompletedAt = timestamppb.New(*task.CompletedAt)
 	}
+	protoTask.UrgencyScore = task.UrgencyScore
 
 	return protoTask
 }
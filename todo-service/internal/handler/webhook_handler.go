@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
+	pb "github.com/amirhasanpour/task-manager/todo-service/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookHandler struct {
+	pb.UnimplementedWebhookServiceServer
+	service service.WebhookService
+	logger  *zap.Logger
+	tracer  trace.Tracer
+}
+
+func NewWebhookHandler(service service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		service: service,
+		logger:  zap.L().Named("webhook_handler"),
+		tracer:  trace.NewNoopTracerProvider().Tracer("noop"),
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "WebhookHandler.CreateWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("CreateWebhook request received", zap.String("user_id", req.UserId))
+
+	webhook, err := h.service.CreateWebhook(ctx, req.UserId, req.Url)
+	if err != nil {
+		h.logger.Error("Failed to create webhook", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.CreateWebhookResponse{
+		Webhook: webhookModelToProto(webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+func (h *WebhookHandler) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "WebhookHandler.GetWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.id", req.Id))
+
+	webhook, err := h.service.GetWebhook(ctx, req.Id, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to get webhook", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
+	}
+
+	return &pb.GetWebhookResponse{Webhook: webhookModelToProto(webhook)}, nil
+}
+
+func (h *WebhookHandler) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "WebhookHandler.UpdateWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.id", req.Id))
+
+	updateReq := &service.UpdateWebhookRequest{
+		ID:     req.Id,
+		UserID: req.UserId,
+		Active: req.Active,
+	}
+	if req.Url != "" {
+		updateReq.URL = &req.Url
+	}
+
+	webhook, err := h.service.UpdateWebhook(ctx, updateReq)
+	if err != nil {
+		h.logger.Error("Failed to update webhook", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
+	}
+
+	return &pb.UpdateWebhookResponse{Webhook: webhookModelToProto(webhook)}, nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "WebhookHandler.DeleteWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.id", req.Id))
+
+	if err := h.service.DeleteWebhook(ctx, req.Id, req.UserId); err != nil {
+		h.logger.Error("Failed to delete webhook", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
+	}
+
+	return &pb.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "WebhookHandler.ListWebhooks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	webhooks, err := h.service.ListWebhooks(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to list webhooks", zap.Error(err))
+		return nil, err
+	}
+
+	protoWebhooks := make([]*pb.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		protoWebhooks[i] = webhookModelToProto(webhook)
+	}
+
+	return &pb.ListWebhooksResponse{Webhooks: protoWebhooks}, nil
+}
+
+func webhookModelToProto(webhook *model.Webhook) *pb.Webhook {
+	if webhook == nil {
+		return nil
+	}
+
+	return &pb.Webhook{
+		Id:        webhook.ID,
+		UserId:    webhook.UserID,
+		Url:       webhook.URL,
+		Active:    webhook.Active,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+		UpdatedAt: timestamppb.New(webhook.UpdatedAt),
+	}
+}
@@ -59,6 +59,9 @@ func (li *LoggingInterceptor) Unary() grpc.UnaryServerInterceptor {
 			zap.String("status", statusCode.String()),
 			zap.String("trace_id", traceID),
 		}
+		if requestID := RequestIDFromContext(ctx); requestID != "" {
+			fields = append(fields, zap.String("request_id", requestID))
+		}
 		
 		// Log based on status code
 		switch  statusCode{
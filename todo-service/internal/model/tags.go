@@ -0,0 +1,42 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Tags is a task's set of user-defined labels, stored as a JSONB array so
+// that containment queries (tags @> '["work"]') can use a GIN index.
+type Tags []string
+
+func (Tags) GormDataType() string {
+	return "jsonb"
+}
+
+func (t Tags) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]string(t))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (t *Tags) Scan(value any) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Tags", value)
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
@@ -25,15 +25,41 @@ const (
 )
 
 type Task struct {
-	ID          string       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID      string       `gorm:"type:uuid;not null;index:idx_user_id" json:"user_id"`
-	Title       string       `gorm:"type:varchar(255);not null" json:"title"`
-	Description string       `gorm:"type:text" json:"description"`
-	Status      TaskStatus   `gorm:"type:varchar(20);not null;default:'todo';index:idx_status" json:"status"`
-	Priority    TaskPriority `gorm:"type:varchar(20);not null;default:'medium';index:idx_priority" json:"priority"`
-	DueDate     *time.Time   `gorm:"index:idx_due_date" json:"due_date"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
+	ID          string         `gorm:"type:uuid;primary_key;default:uuid_generate_v4();index:idx_created_at_id,priority:2" json:"id"`
+	UserID      string         `gorm:"type:uuid;not null;index:idx_user_id" json:"user_id"`
+	Title       string         `gorm:"type:varchar(255);not null" json:"title"`
+	Description string         `gorm:"type:text" json:"description"`
+	Status      TaskStatus     `gorm:"type:varchar(20);not null;default:'todo';index:idx_status" json:"status"`
+	Priority    TaskPriority   `gorm:"type:varchar(20);not null;default:'medium';index:idx_priority" json:"priority"`
+	DueDate     *time.Time     `gorm:"index:idx_due_date" json:"due_date"`
+	Tags        Tags           `gorm:"not null;default:'[]';index:idx_tags,type:gin" json:"tags"`
+	CreatedAt   time.Time      `gorm:"index:idx_created_at_id,priority:1" json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	// UpdatedBy is the user who last created, edited or restored the task.
+	UpdatedBy   *string        `gorm:"type:uuid;index:idx_updated_by" json:"updated_by,omitempty"`
+	// RecurrenceRule makes the task recur, e.g. "WEEKLY" or "MONTHLY;INTERVAL=3".
+	RecurrenceRule *string     `gorm:"type:varchar(64)" json:"recurrence_rule,omitempty"`
+	// ParentTaskID is the recurring task whose completion created this one.
+	ParentTaskID *string       `gorm:"type:uuid;index:idx_parent_task_id" json:"parent_task_id,omitempty"`
+	// Position is the task's zero-based place within its board column.
+	Position    int            `gorm:"not null;default:0" json:"position"`
+	// Version counts the task's updates. An update based on an older
+	// version is rejected instead of overwriting the newer one.
+	Version     int            `gorm:"not null;default:1" json:"version"`
+	// CompletedAt is when the task last moved into done, nil while it is not done.
+	CompletedAt *time.Time     `gorm:"index:idx_completed_at" json:"completed_at,omitempty"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	// UrgencyScore is computed for lists sorted by urgency and never stored.
+	UrgencyScore *float64      `gorm:"-" json:"urgency_score,omitempty"`
+}
+
+// TaskMetrics summarises one user's tasks. Overdue counts tasks past due
+// that are neither done nor archived; LastActivityAt is nil without tasks.
+type TaskMetrics struct {
+	Total          int64      `json:"total"`
+	Completed      int64      `json:"completed"`
+	Overdue        int64      `json:"overdue"`
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
 }
 
 func (t *Task) BeforeCreate(tx *gorm.DB) error {
@@ -43,6 +69,23 @@ func (t *Task) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// SetStatus moves the task to status, stamping CompletedAt with now when it
+// becomes done and clearing it when it leaves done. It reports whether
+// CompletedAt changed.
+func (t *Task) SetStatus(status TaskStatus, now time.Time) bool {
+	wasDone := t.Status == StatusDone
+	t.Status = status
+	switch {
+	case status == StatusDone && !wasDone:
+		t.CompletedAt = &now
+		return true
+	case status != StatusDone && t.CompletedAt != nil:
+		t.CompletedAt = nil
+		return true
+	}
+	return false
+}
+
 func (t *Task) ToProtoStatus() string {
 	switch t.Status {
 	case StatusTodo:
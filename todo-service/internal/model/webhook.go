@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook is a user-registered URL that receives task events.
+type Webhook struct {
+	ID        string    `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID    string    `gorm:"type:uuid;not null;index:idx_webhook_user_id" json:"user_id"`
+	URL       string    `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret    string    `gorm:"type:varchar(128);not null" json:"-"`
+	Active    bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (w *Webhook) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// WebhookDeadLetter records an event that could not be delivered to a
+// webhook after all retries.
+type WebhookDeadLetter struct {
+	ID        string    `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	WebhookID string    `gorm:"type:uuid;not null;index:idx_dead_letter_webhook_id" json:"webhook_id"`
+	EventType string    `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload   string    `gorm:"type:text;not null" json:"payload"`
+	Attempts  int       `gorm:"not null" json:"attempts"`
+	LastError string    `gorm:"type:text" json:"last_error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (d *WebhookDeadLetter) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	return nil
+}
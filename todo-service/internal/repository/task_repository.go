@@ -2,34 +2,126 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TaskRepository interface {
 	Create(ctx context.Context, task *model.Task) (*model.Task, error)
+	CreateBatch(ctx context.Context, tasks []*model.Task) error
 	FindByID(ctx context.Context, id string) (*model.Task, error)
 	FindByIDAndUser(ctx context.Context, id, userID string) (*model.Task, error)
-	Update(ctx context.Context, task *model.Task) (*model.Task, error)
-	Delete(ctx context.Context, id string) error
-	DeleteByUser(ctx context.Context, id, userID string) error
+	FindByIDAndUserIncludingDeleted(ctx context.Context, id, userID string) (*model.Task, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*model.Task, error)
+	Update(ctx context.Context, task *model.Task, fields ...string) (*model.Task, error)
+	UpdateWithNext(ctx context.Context, task, next *model.Task, fields ...string) (*model.Task, error)
+	Delete(ctx context.Context, id string) (*model.Task, error)
+	DeleteByUser(ctx context.Context, id, userID string) (*model.Task, error)
+	Restore(ctx context.Context, id, userID string) (*model.Task, error)
+	Purge(ctx context.Context, id, userID string) (*model.Task, error)
+	RestoreAllByUser(ctx context.Context, userID string, deletedAfter *time.Time) ([]*model.Task, error)
+	BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) ([]*model.Task, error)
+	BulkAddTags(ctx context.Context, userID string, ids []string, tags model.Tags, maxTags int) ([]*model.Task, error)
+	BulkRemoveTags(ctx context.Context, userID string, ids []string, tags model.Tags) ([]*model.Task, error)
 	List(ctx context.Context, filter *TaskFilter, page, pageSize int) ([]*model.Task, int64, error)
+	ListAfter(ctx context.Context, filter *TaskFilter, after *Cursor, limit int) ([]*model.Task, error)
 	ListByUser(ctx context.Context, userID string, filter *TaskFilter, page, pageSize int) ([]*model.Task, int64, error)
+	ListByUpdatedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error)
+	ListOverdue(ctx context.Context, userID string, now time.Time, page, pageSize int) ([]*model.Task, int64, error)
+	ListDeleted(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error)
+	SnoozeOverdue(ctx context.Context, userID string, now time.Time, by time.Duration) ([]*model.Task, error)
+	DeleteCompletedByUser(ctx context.Context, userID string) ([]*model.Task, error)
+	Search(ctx context.Context, userID, term string, page, pageSize int) ([]*model.Task, int64, error)
+	ListTopByUserAndStatus(ctx context.Context, userID string, status model.TaskStatus, limit int, order ColumnOrder) ([]*model.Task, error)
+	MoveOnBoard(ctx context.Context, id, userID string, status model.TaskStatus, position int, next *model.Task) (*model.Task, error)
+	Reorder(ctx context.Context, id, userID string, position int) (*model.Task, error)
+	CountByUser(ctx context.Context, userID string, filter *TaskFilter) (int64, error)
+	ListAttentionCandidates(ctx context.Context, userID string, dueBefore, staleBefore time.Time) ([]*model.Task, error)
+	CountByStatusAndPriority(ctx context.Context, userID string) (map[model.TaskStatus]map[model.TaskPriority]int64, error)
+	CountByStatus(ctx context.Context, userID string) (map[model.TaskStatus]int64, error)
+	CountAllByStatusAndPriority(ctx context.Context) (map[model.TaskStatus]map[model.TaskPriority]int64, error)
+	MetricsByUser(ctx context.Context, userID string, now time.Time) (*model.TaskMetrics, error)
+	CountCompletedByPeriod(ctx context.Context, userID, granularity string, from, to time.Time) (map[time.Time]int64, error)
+	ExistingIDs(ctx context.Context, ids []string) ([]string, error)
+	PurgeByUser(ctx context.Context, userID string) (*PurgeResult, error)
 }
 
 type TaskFilter struct {
-	Status   *string
-	Priority *string
-	UserID   *string
+	Status    *string
+	Priority  *string
+	UserID    *string
+	DueBefore *time.Time
+	DueAfter  *time.Time
+	Search    *string
+	// Tags keeps only tasks that carry every one of the given tags.
+	Tags      []string
+	// CaseSensitiveTags matches Tags exactly as given instead of
+	// lowercased, for tags stored without case folding.
+	CaseSensitiveTags bool
+	SortBy    string
+	SortDesc  bool
+	// IncludeDeleted also returns soft-deleted tasks.
+	IncludeDeleted bool
+	// IncludeArchived also lists archived tasks, which listings leave out by
+	// default. Filtering by the archived status lists them regardless.
+	IncludeArchived bool
+	// UpdatedAfter keeps only tasks updated strictly after this time.
+	UpdatedAfter *time.Time
+	// CompletedAfter and CompletedBefore keep only tasks completed within
+	// the window, bounds included. Tasks not done drop out once either is set.
+	CompletedAfter  *time.Time
+	CompletedBefore *time.Time
+	// Urgency weighs the "urgency" sort, scored as of UrgencyAt. Sorting by
+	// urgency without weights falls back to the default order.
+	Urgency   *UrgencyWeights
+	UrgencyAt time.Time
+}
+
+// ColumnOrder sorts a board column. An empty SortBy keeps the default
+// ordering: most urgent first, newest first within a priority.
+type ColumnOrder struct {
 	SortBy   string
 	SortDesc bool
 }
 
+// Cursor is a position in the default created_at DESC, id DESC ordering.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// BatchError reports which item of a batch write failed. The whole batch is
+// rolled back when it is returned.
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// PurgeResult reports what PurgeByUser removed. Tasks carry only their id,
+// status, priority and deleted_at, enough to drop them from the cache and
+// the gauges.
+type PurgeResult struct {
+	Tasks           []*model.Task
+	WebhooksDeleted int64
+}
+
 type taskRepository struct {
 	db     *gorm.DB
 	logger *zap.Logger
@@ -42,13 +134,17 @@ func NewTaskRepository(db *gorm.DB) TaskRepository {
 	}
 }
 
+// Create stores the task last in its status column.
 func (r *taskRepository) Create(ctx context.Context, task *model.Task) (*model.Task, error) {
 	r.logger.Debug("Creating new task", 
 		zap.String("user_id", task.UserID),
 		zap.String("title", task.Title),
 	)
 
-	if err := r.db.WithContext(ctx).Create(task).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createInColumn(tx, task)
+	})
+	if err != nil {
 		r.logger.Error("Failed to create task", zap.Error(err))
 		return nil, err
 	}
@@ -60,6 +156,29 @@ func (r *taskRepository) Create(ctx context.Context, task *model.Task) (*model.T
 	return task, nil
 }
 
+// CreateBatch inserts all tasks in a single transaction, each last in its
+// status column. If any insert fails nothing is persisted and a *BatchError
+// naming the failed index is returned.
+func (r *taskRepository) CreateBatch(ctx context.Context, tasks []*model.Task) error {
+	r.logger.Debug("Creating task batch", zap.Int("count", len(tasks)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, task := range tasks {
+			if err := createInColumn(tx, task); err != nil {
+				return &BatchError{Index: i, Err: err}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to create task batch", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info("Task batch created successfully", zap.Int("count", len(tasks)))
+	return nil
+}
+
 func (r *taskRepository) FindByID(ctx context.Context, id string) (*model.Task, error) {
 	r.logger.Debug("Finding task by ID", zap.String("id", id))
 
@@ -100,73 +219,582 @@ func (r *taskRepository) FindByIDAndUser(ctx context.Context, id, userID string)
 	return &task, nil
 }
 
-func (r *taskRepository) Update(ctx context.Context, task *model.Task) (*model.Task, error) {
-	r.logger.Debug("Updating task", zap.String("id", task.ID))
+// FindByIDAndUserIncludingDeleted is FindByIDAndUser that also finds the
+// user's soft-deleted task.
+func (r *taskRepository) FindByIDAndUserIncludingDeleted(ctx context.Context, id, userID string) (*model.Task, error) {
+	r.logger.Debug("Finding task by ID and user, including deleted",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
 
-	result := r.db.WithContext(ctx).Save(task)
-	if result.Error != nil {
+	var task model.Task
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find task by ID and user, including deleted", zap.Error(err))
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// editableColumns are the task columns Update writes when no fields are
+// named. Ownership, timestamps and soft-delete state are never written.
+var editableColumns = []string{"title", "description", "status", "priority", "due_date", "tags", "recurrence_rule", "completed_at"}
+
+// Update writes the named columns of task, or every editable column when
+// none are named. Named columns are written even when zero, so a nil
+// due_date clears it; all other columns keep their stored values. A task
+// whose status changes goes last in its new column.
+func (r *taskRepository) Update(ctx context.Context, task *model.Task, fields ...string) (*model.Task, error) {
+	r.logger.Debug("Updating task", zap.String("id", task.ID), zap.Strings("fields", fields))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateInColumn(tx, task, fields)
+	})
+	if err != nil {
 		r.logger.Error("Failed to update task", 
-			zap.Error(result.Error),
+			zap.Error(err),
 			zap.String("id", task.ID),
 		)
-		return nil, result.Error
+		return nil, err
 	}
 
-	if result.RowsAffected == 0 {
-		r.logger.Warn("No rows affected when updating task", zap.String("id", task.ID))
-		return nil, errors.New("no task found to update")
+	r.logger.Info("Task updated successfully", zap.String("id", task.ID))
+	return task, nil
+}
+
+// UpdateWithNext writes task like Update and creates next in the same
+// transaction, so a completed recurring task and the instance that
+// follows it are either both stored or neither is.
+func (r *taskRepository) UpdateWithNext(ctx context.Context, task, next *model.Task, fields ...string) (*model.Task, error) {
+	r.logger.Debug("Updating task with next instance", zap.String("id", task.ID), zap.Strings("fields", fields))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateInColumn(tx, task, fields); err != nil {
+			return err
+		}
+		return createInColumn(tx, next)
+	})
+	if err != nil {
+		r.logger.Error("Failed to update task with next instance", 
+			zap.Error(err),
+			zap.String("id", task.ID),
+		)
+		return nil, err
 	}
 
-	r.logger.Info("Task updated successfully", zap.String("id", task.ID))
+	r.logger.Info("Task updated with next instance", 
+		zap.String("id", task.ID),
+		zap.String("next_id", next.ID),
+	)
 	return task, nil
 }
 
-func (r *taskRepository) Delete(ctx context.Context, id string) error {
-	r.logger.Debug("Deleting task", zap.String("id", id))
+// ErrVersionConflict is returned when a task's stored version no longer
+// matches the version the update was based on, because someone else
+// changed or deleted the task in between.
+var ErrVersionConflict = errors.New("task was modified")
 
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Task{})
+// ErrTooManyTags is returned when adding tags would leave a task with more
+// than the allowed number of tags.
+var ErrTooManyTags = errors.New("task has too many tags")
+
+// updateColumns writes the named columns of task and bumps its version,
+// but only if the stored version is still task.Version.
+func updateColumns(db *gorm.DB, task *model.Task, fields []string) error {
+	if len(fields) == 0 {
+		fields = editableColumns
+	}
+	columns := append(slices.Clone(fields), "updated_at", "version")
+
+	read := task.Version
+	task.Version++
+	result := db.Model(task).Where("version = ?", read).Select(columns).Updates(task)
 	if result.Error != nil {
-		r.logger.Error("Failed to delete task", 
-			zap.Error(result.Error),
-			zap.String("id", id),
-		)
+		task.Version = read
 		return result.Error
 	}
-
 	if result.RowsAffected == 0 {
-		r.logger.Warn("No rows affected when deleting task", zap.String("id", id))
-		return errors.New("no task found to delete")
+		task.Version = read
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// updateInColumn writes task like updateColumns. When the write changes the
+// stored status, the task leaves a closed gap in its old column and goes
+// last in the new one.
+func updateInColumn(tx *gorm.DB, task *model.Task, fields []string) error {
+	if len(fields) == 0 {
+		fields = editableColumns
+	}
+	if !slices.Contains(fields, "status") {
+		return updateColumns(tx, task, fields)
 	}
 
-	r.logger.Info("Task deleted successfully", zap.String("id", id))
+	var stored model.Task
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("status").
+		Where("id = ? AND version = ?", task.ID, task.Version).
+		Take(&stored).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrVersionConflict
+	}
+	if err != nil {
+		return err
+	}
+	if stored.Status == task.Status {
+		return updateColumns(tx, task, fields)
+	}
+
+	if err := closeColumn(tx, task.UserID, stored.Status, task.ID); err != nil {
+		return err
+	}
+	siblings, err := columnSiblings(tx, task.UserID, task.Status, task.ID)
+	if err != nil {
+		return err
+	}
+	read := task.Position
+	task.Position = len(siblings)
+	if err := updateColumns(tx, task, append(slices.Clone(fields), "position")); err != nil {
+		task.Position = read
+		return err
+	}
+	return nil
+}
+
+// createInColumn creates task last in its status column.
+func createInColumn(tx *gorm.DB, task *model.Task) error {
+	if task.Status == "" {
+		task.Status = model.StatusTodo
+	}
+	siblings, err := columnSiblings(tx, task.UserID, task.Status, "")
+	if err != nil {
+		return err
+	}
+	task.Position = len(siblings)
+	return tx.Create(task).Error
+}
+
+// columnSiblings locks the user's live tasks in the status column, other
+// than the one with excludeID, and returns them in board order. Ties in
+// position fall back to creation order.
+func columnSiblings(tx *gorm.DB, userID string, status model.TaskStatus, excludeID string) ([]*model.Task, error) {
+	query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "position").
+		Where("user_id = ? AND status = ?", userID, status)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	var siblings []*model.Task
+	if err := query.Order("position ASC, created_at ASC, id ASC").Find(&siblings).Error; err != nil {
+		return nil, err
+	}
+	return siblings, nil
+}
+
+// closeColumn renumbers the column a task with excludeID is leaving, so no
+// gap is left behind.
+func closeColumn(tx *gorm.DB, userID string, status model.TaskStatus, excludeID string) error {
+	siblings, err := columnSiblings(tx, userID, status, excludeID)
+	if err != nil {
+		return err
+	}
+	return renumber(tx, siblings, -1)
+}
+
+// renumber gives siblings, in board order, the positions from 0, skipping
+// gap so a task can take it. A negative gap leaves none.
+func renumber(tx *gorm.DB, siblings []*model.Task, gap int) error {
+	for i, sibling := range siblings {
+		want := i
+		if gap >= 0 && i >= gap {
+			want = i + 1
+		}
+		if sibling.Position == want {
+			continue
+		}
+		if err := tx.Model(&model.Task{}).Where("id = ?", sibling.ID).
+			UpdateColumn("position", want).Error; err != nil {
+			return err
+		}
+		sibling.Position = want
+	}
 	return nil
 }
 
-func (r *taskRepository) DeleteByUser(ctx context.Context, id, userID string) error {
+// Delete soft-deletes the task and returns it as it was when deleted. It
+// returns gorm.ErrRecordNotFound when there is no such task.
+func (r *taskRepository) Delete(ctx context.Context, id string) (*model.Task, error) {
+	r.logger.Debug("Deleting task", zap.String("id", id))
+
+	task, err := r.deleteLocked(ctx, "id = ?", id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to delete task", 
+				zap.Error(err),
+				zap.String("id", id),
+			)
+		}
+		return nil, err
+	}
+
+	r.logger.Info("Task deleted successfully", zap.String("id", id))
+	return task, nil
+}
+
+// DeleteByUser soft-deletes the user's task and returns it as it was when
+// deleted. It returns gorm.ErrRecordNotFound when the user has no such task.
+func (r *taskRepository) DeleteByUser(ctx context.Context, id, userID string) (*model.Task, error) {
 	r.logger.Debug("Deleting task by user", 
 		zap.String("id", id),
 		zap.String("user_id", userID),
 	)
 
-	result := r.db.WithContext(ctx).
-		Where("id = ? AND user_id = ?", id, userID).
-		Delete(&model.Task{})
-	
-	if result.Error != nil {
-		r.logger.Error("Failed to delete task by user", zap.Error(result.Error))
-		return result.Error
+	task, err := r.deleteLocked(ctx, "id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to delete task by user", zap.Error(err))
+		}
+		return nil, err
 	}
 
-	if result.RowsAffected == 0 {
-		r.logger.Warn("No rows affected when deleting task by user", 
+	r.logger.Info("Task deleted successfully by user", zap.String("id", id))
+	return task, nil
+}
+
+// deleteLocked locks the task matching the condition, soft-deletes it and
+// returns the locked row, so callers see the status and priority the task
+// had when it was deleted rather than what an earlier read saw. The rest of
+// the task's column closes up behind it.
+func (r *taskRepository) deleteLocked(ctx context.Context, query string, args ...any) (*model.Task, error) {
+	var task model.Task
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(query, args...).
+			Take(&task).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&task).Error; err != nil {
+			return err
+		}
+		return closeColumn(tx, task.UserID, task.Status, task.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// Restore clears deleted_at on one of the user's soft-deleted tasks and puts
+// it last in its column, since its old place has been closed up. It returns
+// nil when there is no such task in the trash.
+func (r *taskRepository) Restore(ctx context.Context, id, userID string) (*model.Task, error) {
+	r.logger.Debug("Restoring task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	var restored bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var task model.Task
+		err := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID).
+			Take(&task).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		siblings, err := columnSiblings(tx, userID, task.Status, id)
+		if err != nil {
+			return err
+		}
+		restored = true
+		return tx.Unscoped().
+			Model(&model.Task{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"deleted_at": nil,
+				"position":   len(siblings),
+				"updated_by": userID,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to restore task", zap.Error(err), zap.String("id", id))
+		return nil, err
+	}
+
+	if !restored {
+		r.logger.Debug("No deleted task to restore",
 			zap.String("id", id),
 			zap.String("user_id", userID),
 		)
-		return errors.New("no task found to delete")
+		return nil, nil
 	}
 
-	r.logger.Info("Task deleted successfully by user", zap.String("id", id))
-	return nil
+	r.logger.Info("Task restored successfully", zap.String("id", id))
+	return r.FindByIDAndUser(ctx, id, userID)
+}
+
+// RestoreAllByUser brings back all of the user's soft-deleted tasks, or only
+// those deleted after deletedAfter when it is not nil, and returns them.
+// Restored tasks go last in their columns, keeping their old relative order.
+func (r *taskRepository) RestoreAllByUser(ctx context.Context, userID string, deletedAfter *time.Time) ([]*model.Task, error) {
+	r.logger.Debug("Restoring all deleted tasks", zap.String("user_id", userID))
+
+	var restored []*model.Task
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+		if deletedAfter != nil {
+			query = query.Where("deleted_at > ?", *deletedAfter)
+		}
+		if err := query.Order("position ASC, created_at ASC, id ASC").Find(&restored).Error; err != nil {
+			return err
+		}
+
+		next := make(map[model.TaskStatus]int)
+		for _, task := range restored {
+			position, ok := next[task.Status]
+			if !ok {
+				siblings, err := columnSiblings(tx, userID, task.Status, "")
+				if err != nil {
+					return err
+				}
+				position = len(siblings)
+			}
+			next[task.Status] = position + 1
+
+			if err := tx.Unscoped().
+				Model(&model.Task{}).
+				Where("id = ?", task.ID).
+				Updates(map[string]any{
+					"deleted_at": nil,
+					"position":   position,
+					"updated_by": userID,
+					"version":    gorm.Expr("version + 1"),
+				}).Error; err != nil {
+				return err
+			}
+			task.DeletedAt = gorm.DeletedAt{}
+			task.Position = position
+			task.UpdatedBy = &userID
+			task.Version++
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to restore deleted tasks", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	r.logger.Info("Deleted tasks restored",
+		zap.String("user_id", userID),
+		zap.Int("restored", len(restored)),
+	)
+	return restored, nil
+}
+
+// Purge permanently deletes one of the user's soft-deleted tasks and returns
+// it as it was. Live tasks are left alone; it returns nil when there is no
+// such task in the trash.
+func (r *taskRepository) Purge(ctx context.Context, id, userID string) (*model.Task, error) {
+	r.logger.Debug("Purging task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	var purged []*model.Task
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Clauses(clause.Returning{}).
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID).
+		Delete(&purged).Error
+	if err != nil {
+		r.logger.Error("Failed to purge task", zap.Error(err), zap.String("id", id))
+		return nil, err
+	}
+
+	if len(purged) == 0 {
+		r.logger.Debug("No deleted task to purge",
+			zap.String("id", id),
+			zap.String("user_id", userID),
+		)
+		return nil, nil
+	}
+
+	r.logger.Info("Task purged successfully", zap.String("id", id))
+	return purged[0], nil
+}
+
+// BulkSetDueDate sets the due date of the user's tasks among ids in a single
+// UPDATE, clearing it when dueDate is nil, and returns the updated tasks.
+// Ids the user does not own are skipped.
+func (r *taskRepository) BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) ([]*model.Task, error) {
+	r.logger.Debug("Setting due date in bulk",
+		zap.String("user_id", userID),
+		zap.Int("count", len(ids)),
+	)
+
+	var updated []*model.Task
+	err := r.db.WithContext(ctx).
+		Model(&updated).
+		Clauses(clause.Returning{}).
+		Where("user_id = ? AND id IN ?", userID, ids).
+		Updates(map[string]any{"due_date": dueDate, "updated_by": userID, "version": gorm.Expr("version + 1")}).Error
+	if err != nil {
+		r.logger.Error("Failed to set due date in bulk", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	r.logger.Debug("Due dates set", zap.Int("updated", len(updated)))
+	return updated, nil
+}
+
+// BulkAddTags adds tags to each of the user's tasks among ids that lacks
+// them, in one transaction, and returns the tasks that changed. If any task
+// would end up with more than maxTags tags, nothing is changed and
+// ErrTooManyTags is returned.
+func (r *taskRepository) BulkAddTags(ctx context.Context, userID string, ids []string, tags model.Tags, maxTags int) ([]*model.Task, error) {
+	r.logger.Debug("Adding tags in bulk",
+		zap.String("user_id", userID),
+		zap.Int("count", len(ids)),
+	)
+
+	return r.bulkUpdateTags(ctx, userID, ids, func(current model.Tags) (model.Tags, error) {
+		updated := slices.Clone(current)
+		for _, tag := range tags {
+			if !slices.Contains(updated, tag) {
+				updated = append(updated, tag)
+			}
+		}
+		if len(updated) > maxTags {
+			return nil, ErrTooManyTags
+		}
+		return updated, nil
+	})
+}
+
+// BulkRemoveTags removes tags from each of the user's tasks among ids, in
+// one transaction, and returns the tasks that changed.
+func (r *taskRepository) BulkRemoveTags(ctx context.Context, userID string, ids []string, tags model.Tags) ([]*model.Task, error) {
+	r.logger.Debug("Removing tags in bulk",
+		zap.String("user_id", userID),
+		zap.Int("count", len(ids)),
+	)
+
+	return r.bulkUpdateTags(ctx, userID, ids, func(current model.Tags) (model.Tags, error) {
+		return slices.DeleteFunc(slices.Clone(current), func(tag string) bool {
+			return slices.Contains(tags, tag)
+		}), nil
+	})
+}
+
+// bulkUpdateTags locks the user's tasks among ids, rewrites the tags of
+// those update changes and returns them.
+func (r *taskRepository) bulkUpdateTags(ctx context.Context, userID string, ids []string, update func(model.Tags) (model.Tags, error)) ([]*model.Task, error) {
+	var changed []*model.Task
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tasks []*model.Task
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND id IN ?", userID, ids).
+			Order("id").
+			Find(&tasks).Error; err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			tags, err := update(task.Tags)
+			if err != nil {
+				return err
+			}
+			if slices.Equal(tags, task.Tags) {
+				continue
+			}
+			if err := tx.Model(&model.Task{}).
+				Where("id = ?", task.ID).
+				Updates(map[string]any{"tags": tags, "updated_by": userID, "version": gorm.Expr("version + 1")}).Error; err != nil {
+				return err
+			}
+			task.Tags = tags
+			task.UpdatedBy = &userID
+			task.Version++
+			changed = append(changed, task)
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, ErrTooManyTags) {
+			r.logger.Error("Failed to update tags in bulk", zap.Error(err), zap.String("user_id", userID))
+		}
+		return nil, err
+	}
+
+	r.logger.Debug("Tags updated", zap.Int("updated", len(changed)))
+	return changed, nil
+}
+
+// SnoozeOverdue pushes the due date of the user's tasks due before now that
+// are neither done nor archived forward by by, in a single UPDATE, and
+// returns the snoozed tasks.
+func (r *taskRepository) SnoozeOverdue(ctx context.Context, userID string, now time.Time, by time.Duration) ([]*model.Task, error) {
+	r.logger.Debug("Snoozing overdue tasks",
+		zap.String("user_id", userID),
+		zap.Duration("by", by),
+	)
+
+	var snoozed []*model.Task
+	err := r.db.WithContext(ctx).
+		Model(&snoozed).
+		Clauses(clause.Returning{}).
+		Where("user_id = ? AND due_date < ? AND status NOT IN ?", userID, now, []model.TaskStatus{model.StatusDone, model.StatusArchived}).
+		Updates(map[string]any{
+			"due_date":   gorm.Expr("due_date + make_interval(secs => ?)", by.Seconds()),
+			"updated_by": userID,
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+	if err != nil {
+		r.logger.Error("Failed to snooze overdue tasks", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	r.logger.Debug("Overdue tasks snoozed", zap.Int("snoozed", len(snoozed)))
+	return snoozed, nil
+}
+
+// DeleteCompletedByUser soft-deletes all of the user's done and archived
+// tasks in one statement and returns them as they were when deleted.
+func (r *taskRepository) DeleteCompletedByUser(ctx context.Context, userID string) ([]*model.Task, error) {
+	r.logger.Debug("Deleting completed tasks", zap.String("user_id", userID))
+
+	var deleted []*model.Task
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("user_id = ? AND status IN ?", userID, []model.TaskStatus{model.StatusDone, model.StatusArchived}).
+		Delete(&deleted).Error
+	if err != nil {
+		r.logger.Error("Failed to delete completed tasks", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	r.logger.Info("Completed tasks deleted",
+		zap.String("user_id", userID),
+		zap.Int("deleted", len(deleted)),
+	)
+	return deleted, nil
 }
 
 func (r *taskRepository) List(ctx context.Context, filter *TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
@@ -179,16 +807,10 @@ func (r *taskRepository) List(ctx context.Context, filter *TaskFilter, page, pag
 	query := r.db.WithContext(ctx).Model(&model.Task{})
 
 	// Apply filters
-	if filter != nil {
-		if filter.Status != nil && *filter.Status != "" {
-			query = query.Where("status = ?", *filter.Status)
-		}
-		if filter.Priority != nil && *filter.Priority != "" {
-			query = query.Where("priority = ?", *filter.Priority)
-		}
-		if filter.UserID != nil && *filter.UserID != "" {
-			query = query.Where("user_id = ?", *filter.UserID)
-		}
+	query = applyFilters(query, filter)
+	query = excludeArchived(query, filter)
+	if filter != nil && filter.UserID != nil && *filter.UserID != "" {
+		query = query.Where("user_id = ?", *filter.UserID)
 	}
 
 	// Get total count
@@ -215,6 +837,32 @@ func (r *taskRepository) List(ctx context.Context, filter *TaskFilter, page, pag
 	return tasks, total, nil
 }
 
+// ListAfter returns up to limit tasks that come after the cursor in the
+// default ordering, or from the start when after is nil. Unlike List it
+// seeks instead of skipping rows, so concurrent inserts cannot shift pages.
+func (r *taskRepository) ListAfter(ctx context.Context, filter *TaskFilter, after *Cursor, limit int) ([]*model.Task, error) {
+	r.logger.Debug("Listing tasks after cursor", zap.Int("limit", limit))
+
+	query := r.db.WithContext(ctx).Model(&model.Task{})
+	query = applyFilters(query, filter)
+	query = excludeArchived(query, filter)
+	if filter != nil && filter.UserID != nil && *filter.UserID != "" {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var tasks []*model.Task
+	if err := query.Order("created_at DESC").Order("id DESC").Limit(limit).Find(&tasks).Error; err != nil {
+		r.logger.Error("Failed to list tasks after cursor", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Debug("Tasks listed successfully", zap.Int("count", len(tasks)))
+	return tasks, nil
+}
+
 func (r *taskRepository) ListByUser(ctx context.Context, userID string, filter *TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
 	r.logger.Debug("Listing tasks by user", 
 		zap.String("user_id", userID),
@@ -226,14 +874,8 @@ func (r *taskRepository) ListByUser(ctx context.Context, userID string, filter *
 	query := r.db.WithContext(ctx).Model(&model.Task{}).Where("user_id = ?", userID)
 
 	// Apply additional filters
-	if filter != nil {
-		if filter.Status != nil && *filter.Status != "" {
-			query = query.Where("status = ?", *filter.Status)
-		}
-		if filter.Priority != nil && *filter.Priority != "" {
-			query = query.Where("priority = ?", *filter.Priority)
-		}
-	}
+	query = applyFilters(query, filter)
+	query = excludeArchived(query, filter)
 
 	// Get total count
 	var total int64
@@ -260,6 +902,622 @@ func (r *taskRepository) ListByUser(ctx context.Context, userID string, filter *
 	return tasks, total, nil
 }
 
+// ListByUpdatedBy returns the tasks last modified by userID, whoever owns
+// them, most recently updated first.
+func (r *taskRepository) ListByUpdatedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	r.logger.Debug("Listing tasks by last modifier",
+		zap.String("updated_by", userID),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+	)
+
+	offset := (page - 1) * pageSize
+	query := r.db.WithContext(ctx).Model(&model.Task{}).Where("updated_by = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.Error("Failed to count tasks by last modifier", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var tasks []*model.Task
+	err := query.Order("updated_at DESC").Order("id").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&tasks).Error
+	if err != nil {
+		r.logger.Error("Failed to list tasks by last modifier", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+// ListOverdue returns the user's tasks due before now that are neither done
+// nor archived, most overdue first.
+func (r *taskRepository) ListOverdue(ctx context.Context, userID string, now time.Time, page, pageSize int) ([]*model.Task, int64, error) {
+	r.logger.Debug("Listing overdue tasks",
+		zap.String("user_id", userID),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+	)
+
+	offset := (page - 1) * pageSize
+	query := r.db.WithContext(ctx).Model(&model.Task{}).
+		Where("user_id = ? AND due_date < ? AND status NOT IN ?", userID, now, []model.TaskStatus{model.StatusDone, model.StatusArchived})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.Error("Failed to count overdue tasks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var tasks []*model.Task
+	err := query.Order("due_date ASC").Order("id").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&tasks).Error
+	if err != nil {
+		r.logger.Error("Failed to list overdue tasks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+// ListDeleted returns the user's soft-deleted tasks, most recently deleted
+// first.
+func (r *taskRepository) ListDeleted(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	r.logger.Debug("Listing deleted tasks",
+		zap.String("user_id", userID),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+	)
+
+	offset := (page - 1) * pageSize
+	query := r.db.WithContext(ctx).Unscoped().Model(&model.Task{}).
+		Where("deleted_at IS NOT NULL AND user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.Error("Failed to count deleted tasks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var tasks []*model.Task
+	err := query.Order("deleted_at DESC").Order("id").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&tasks).Error
+	if err != nil {
+		r.logger.Error("Failed to list deleted tasks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+// Search returns the user's tasks whose title, description or one of whose
+// tags contains term, case-insensitively. Title matches rank first, then
+// description matches, then tag matches; ties go newest first.
+func (r *taskRepository) Search(ctx context.Context, userID, term string, page, pageSize int) ([]*model.Task, int64, error) {
+	r.logger.Debug("Searching tasks",
+		zap.String("user_id", userID),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+	)
+
+	pattern := "%" + escapeLike(term) + "%"
+	offset := (page - 1) * pageSize
+	query := r.db.WithContext(ctx).Model(&model.Task{}).
+		Where("user_id = ?", userID).
+		Where("(title ILIKE @p OR description ILIKE @p OR "+tagMatch+")", sql.Named("p", pattern))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.Error("Failed to count search results", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// The rank is a single ORDER BY expression because gorm drops
+	// expression orderings when further columns are appended
+	order := clause.NamedExpr{
+		SQL:  "CASE WHEN title ILIKE @p THEN 0 WHEN description ILIKE @p THEN 1 ELSE 2 END, created_at DESC, id",
+		Vars: []any{sql.Named("p", pattern)},
+	}
+	var tasks []*model.Task
+	err := query.
+		Order(clause.OrderBy{Expression: order}).
+		Offset(offset).
+		Limit(pageSize).
+		Find(&tasks).Error
+	if err != nil {
+		r.logger.Error("Failed to search tasks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	r.logger.Debug("Tasks searched successfully",
+		zap.Int64("total", total),
+		zap.Int("count", len(tasks)),
+	)
+	return tasks, total, nil
+}
+
+// tagMatch is true when any of a task's tags contains the @p pattern.
+const tagMatch = "EXISTS (SELECT 1 FROM jsonb_array_elements_text(tags) AS tag WHERE tag ILIKE @p)"
+
+// ListTopByUserAndStatus returns up to limit of the user's tasks in the given
+// status, most urgent first.
+func (r *taskRepository) ListTopByUserAndStatus(ctx context.Context, userID string, status model.TaskStatus, limit int, order ColumnOrder) ([]*model.Task, error) {
+	r.logger.Debug("Listing top tasks by user and status", 
+		zap.String("user_id", userID),
+		zap.String("status", string(status)),
+		zap.Int("limit", limit),
+	)
+
+	var tasks []*model.Task
+	query := r.db.WithContext(ctx).Where("user_id = ? AND status = ?", userID, status)
+	err := applyColumnOrder(query, order).
+		Limit(limit).
+		Find(&tasks).Error
+	if err != nil {
+		r.logger.Error("Failed to list top tasks by user and status", zap.Error(err))
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// MoveOnBoard moves the user's task to position within the status column in
+// one transaction, renumbering its old and new columns from 0 as Reorder
+// does, and creates next alongside when not nil. Positions past the end of
+// the column put the task last. It returns nil without an error when the
+// task does not exist.
+func (r *taskRepository) MoveOnBoard(ctx context.Context, id, userID string, status model.TaskStatus, position int, next *model.Task) (*model.Task, error) {
+	r.logger.Debug("Moving task on board",
+		zap.String("id", id),
+		zap.String("status", string(status)),
+		zap.Int("position", position),
+	)
+
+	var task *model.Task
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current model.Task
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", id, userID).
+			Take(&current).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Close the gap the task leaves behind
+		if current.Status != status {
+			if err := closeColumn(tx, userID, current.Status, id); err != nil {
+				return err
+			}
+		}
+
+		// Make room at the new position
+		siblings, err := columnSiblings(tx, userID, status, id)
+		if err != nil {
+			return err
+		}
+		position = min(max(position, 0), len(siblings))
+		if err := renumber(tx, siblings, position); err != nil {
+			return err
+		}
+
+		columns := []string{"status", "position", "updated_by"}
+		if current.SetStatus(status, time.Now()) {
+			columns = append(columns, "completed_at")
+		}
+		current.Position = position
+		current.UpdatedBy = &userID
+		if err := updateColumns(tx, &current, columns); err != nil {
+			return err
+		}
+		if next != nil {
+			if err := createInColumn(tx, next); err != nil {
+				return err
+			}
+		}
+
+		task = &current
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to move task on board", zap.Error(err), zap.String("id", id))
+		return nil, err
+	}
+
+	if task == nil {
+		r.logger.Debug("No task to move on board",
+			zap.String("id", id),
+			zap.String("user_id", userID),
+		)
+		return nil, nil
+	}
+
+	r.logger.Info("Task moved on board", zap.String("id", id))
+	return task, nil
+}
+
+// Reorder moves the user's task to position within its status column and
+// renumbers the whole column from 0 in the same transaction, so positions
+// end up contiguous even where earlier writes left gaps or ties. Positions
+// past the end of the column put the task last. It returns nil without an
+// error when the task does not exist.
+func (r *taskRepository) Reorder(ctx context.Context, id, userID string, position int) (*model.Task, error) {
+	r.logger.Debug("Reordering task",
+		zap.String("id", id),
+		zap.Int("position", position),
+	)
+
+	var task *model.Task
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current model.Task
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", id, userID).
+			Take(&current).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		siblings, err := columnSiblings(tx, userID, current.Status, id)
+		if err != nil {
+			return err
+		}
+
+		position = min(max(position, 0), len(siblings))
+		if err := renumber(tx, siblings, position); err != nil {
+			return err
+		}
+
+		current.Position = position
+		current.UpdatedBy = &userID
+		if err := updateColumns(tx, &current, []string{"position", "updated_by"}); err != nil {
+			return err
+		}
+
+		task = &current
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to reorder task", zap.Error(err), zap.String("id", id))
+		return nil, err
+	}
+
+	if task == nil {
+		r.logger.Debug("No task to reorder",
+			zap.String("id", id),
+			zap.String("user_id", userID),
+		)
+		return nil, nil
+	}
+
+	r.logger.Info("Task reordered", zap.String("id", id), zap.Int("position", task.Position))
+	return task, nil
+}
+
+// ListAttentionCandidates returns the user's open tasks that are due before
+// dueBefore, or are high or urgent and unchanged since staleBefore.
+func (r *taskRepository) ListAttentionCandidates(ctx context.Context, userID string, dueBefore, staleBefore time.Time) ([]*model.Task, error) {
+	r.logger.Debug("Listing tasks needing attention", zap.String("user_id", userID))
+
+	var tasks []*model.Task
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status IN ?", userID, []model.TaskStatus{model.StatusTodo, model.StatusInProgress}).
+		Where(r.db.
+			Where("due_date < ?", dueBefore).
+			Or("priority IN ? AND updated_at < ?", []model.TaskPriority{model.PriorityHigh, model.PriorityUrgent}, staleBefore)).
+		Find(&tasks).Error
+	if err != nil {
+		r.logger.Error("Failed to list tasks needing attention", zap.Error(err))
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// CountByUser counts the user's tasks matching filter without loading them.
+// Like the listings, it leaves archived tasks out unless the filter asks
+// for them.
+func (r *taskRepository) CountByUser(ctx context.Context, userID string, filter *TaskFilter) (int64, error) {
+	r.logger.Debug("Counting tasks by user", zap.String("user_id", userID))
+
+	query := r.db.WithContext(ctx).Model(&model.Task{}).Where("user_id = ?", userID)
+	query = applyFilters(query, filter)
+	query = excludeArchived(query, filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.Error("Failed to count tasks by user", zap.Error(err))
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// CountByStatusAndPriority counts the user's tasks per status and priority
+// in one grouped query. Combinations without tasks are absent.
+func (r *taskRepository) CountByStatusAndPriority(ctx context.Context, userID string) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	r.logger.Debug("Counting tasks by status and priority", zap.String("user_id", userID))
+
+	return r.countByStatusAndPriority(r.db.WithContext(ctx).Where("user_id = ?", userID))
+}
+
+// CountAllByStatusAndPriority is CountByStatusAndPriority across every
+// user's tasks.
+func (r *taskRepository) CountAllByStatusAndPriority(ctx context.Context) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	r.logger.Debug("Counting all tasks by status and priority")
+
+	return r.countByStatusAndPriority(r.db.WithContext(ctx))
+}
+
+func (r *taskRepository) countByStatusAndPriority(db *gorm.DB) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	var rows []struct {
+		Status   model.TaskStatus
+		Priority model.TaskPriority
+		Count    int64
+	}
+	err := db.
+		Model(&model.Task{}).
+		Select("status, priority, COUNT(*) AS count").
+		Group("status, priority").
+		Scan(&rows).Error
+	if err != nil {
+		r.logger.Error("Failed to count tasks by status and priority", zap.Error(err))
+		return nil, err
+	}
+
+	matrix := make(map[model.TaskStatus]map[model.TaskPriority]int64)
+	for _, row := range rows {
+		if matrix[row.Status] == nil {
+			matrix[row.Status] = make(map[model.TaskPriority]int64)
+		}
+		matrix[row.Status][row.Priority] = row.Count
+	}
+	return matrix, nil
+}
+
+// CountByStatus counts the user's tasks per status in one grouped query.
+// Statuses without tasks are absent.
+func (r *taskRepository) CountByStatus(ctx context.Context, userID string) (map[model.TaskStatus]int64, error) {
+	r.logger.Debug("Counting tasks by status", zap.String("user_id", userID))
+
+	var rows []struct {
+		Status model.TaskStatus
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&model.Task{}).
+		Select("status, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		r.logger.Error("Failed to count tasks by status", zap.Error(err))
+		return nil, err
+	}
+
+	counts := make(map[model.TaskStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// MetricsByUser computes the user's task metrics in a single aggregate
+// query, counting tasks due before now as overdue.
+func (r *taskRepository) MetricsByUser(ctx context.Context, userID string, now time.Time) (*model.TaskMetrics, error) {
+	r.logger.Debug("Computing task metrics by user", zap.String("user_id", userID))
+
+	var metrics model.TaskMetrics
+	err := r.db.WithContext(ctx).
+		Model(&model.Task{}).
+		Select(
+			"COUNT(*) AS total, "+
+				"COUNT(*) FILTER (WHERE status = ?) AS completed, "+
+				"COUNT(*) FILTER (WHERE due_date < ? AND status NOT IN ?) AS overdue, "+
+				"MAX(updated_at) AS last_activity_at",
+			model.StatusDone, now, []model.TaskStatus{model.StatusDone, model.StatusArchived},
+		).
+		Where("user_id = ?", userID).
+		Scan(&metrics).Error
+	if err != nil {
+		r.logger.Error("Failed to compute task metrics by user", zap.Error(err))
+		return nil, err
+	}
+
+	return &metrics, nil
+}
+
+// CountCompletedByPeriod counts the user's done tasks completed in
+// [from, to), grouped by the UTC start of their granularity period as
+// date_trunc computes it ("day", "week" or "month"). Periods without
+// completions are absent.
+func (r *taskRepository) CountCompletedByPeriod(ctx context.Context, userID, granularity string, from, to time.Time) (map[time.Time]int64, error) {
+	r.logger.Debug("Counting completed tasks by period",
+		zap.String("user_id", userID),
+		zap.String("granularity", granularity),
+	)
+
+	var rows []struct {
+		Period time.Time
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&model.Task{}).
+		Select("date_trunc(?, completed_at AT TIME ZONE 'UTC') AS period, COUNT(*) AS count", granularity).
+		Where("user_id = ? AND status = ? AND completed_at >= ? AND completed_at < ?", userID, model.StatusDone, from, to).
+		Group("period").
+		Scan(&rows).Error
+	if err != nil {
+		r.logger.Error("Failed to count completed tasks by period", zap.Error(err))
+		return nil, err
+	}
+
+	counts := make(map[time.Time]int64, len(rows))
+	for _, row := range rows {
+		period := row.Period
+		counts[time.Date(period.Year(), period.Month(), period.Day(), 0, 0, 0, 0, time.UTC)] = row.Count
+	}
+	return counts, nil
+}
+
+// FindByIDs loads the tasks with the given ids in a single query. Missing
+// ids are skipped and the result is in no particular order.
+func (r *taskRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.Task, error) {
+	r.logger.Debug("Finding tasks by IDs", zap.Int("count", len(ids)))
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var tasks []*model.Task
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&tasks).Error; err != nil {
+		r.logger.Error("Failed to find tasks by IDs", zap.Error(err))
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// ExistingIDs returns the subset of ids that still have a task row.
+func (r *taskRepository) ExistingIDs(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var existing []string
+	if err := r.db.WithContext(ctx).
+		Model(&model.Task{}).
+		Where("id IN ?", ids).
+		Pluck("id", &existing).Error; err != nil {
+		r.logger.Error("Failed to look up existing task IDs", zap.Error(err))
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// PurgeByUser permanently removes all of the user's tasks, including
+// soft-deleted ones, and their webhooks with any dead letters, in one
+// transaction.
+func (r *taskRepository) PurgeByUser(ctx context.Context, userID string) (*PurgeResult, error) {
+	r.logger.Debug("Purging user data", zap.String("user_id", userID))
+
+	result := &PurgeResult{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status", "priority", "deleted_at").
+			Where("user_id = ?", userID).
+			Find(&result.Tasks).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("user_id = ?", userID).Delete(&model.Task{}).Error; err != nil {
+			return err
+		}
+
+		webhookIDs := tx.Model(&model.Webhook{}).Select("id").Where("user_id = ?", userID)
+		if err := tx.Where("webhook_id IN (?)", webhookIDs).Delete(&model.WebhookDeadLetter{}).Error; err != nil {
+			return err
+		}
+		deleted := tx.Where("user_id = ?", userID).Delete(&model.Webhook{})
+		if deleted.Error != nil {
+			return deleted.Error
+		}
+		result.WebhooksDeleted = deleted.RowsAffected
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to purge user data", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	r.logger.Info("User data purged",
+		zap.String("user_id", userID),
+		zap.Int("tasks", len(result.Tasks)),
+		zap.Int64("webhooks", result.WebhooksDeleted),
+	)
+	return result, nil
+}
+
+// priorityRankOrder sorts by priority severity rather than alphabetically.
+const priorityRankOrder = "CASE priority WHEN 'urgent' THEN 0 WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END"
+
+// applyFilters applies the status, priority, due-date, tag and search filters.
+// Status and priority arrive in their proto spelling (e.g. IN_PROGRESS) and
+// are translated to the stored column values.
+func applyFilters(query *gorm.DB, filter *TaskFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+	if filter.IncludeDeleted {
+		query = query.Unscoped()
+	}
+	if filter.Status != nil && *filter.Status != "" {
+		query = query.Where("status = ?", strings.ToLower(*filter.Status))
+	}
+	if filter.Priority != nil && *filter.Priority != "" {
+		query = query.Where("priority = ?", strings.ToLower(*filter.Priority))
+	}
+	// Comparisons against NULL are never true, so tasks without a due date
+	// drop out as soon as either bound is set.
+	if filter.DueBefore != nil {
+		query = query.Where("due_date <= ?", *filter.DueBefore)
+	}
+	if filter.DueAfter != nil {
+		query = query.Where("due_date >= ?", *filter.DueAfter)
+	}
+	if filter.UpdatedAfter != nil {
+		query = query.Where("updated_at > ?", *filter.UpdatedAfter)
+	}
+	if filter.CompletedAfter != nil {
+		query = query.Where("completed_at >= ?", *filter.CompletedAfter)
+	}
+	if filter.CompletedBefore != nil {
+		query = query.Where("completed_at <= ?", *filter.CompletedBefore)
+	}
+	if len(filter.Tags) > 0 {
+		tags := make(model.Tags, 0, len(filter.Tags))
+		for _, tag := range filter.Tags {
+			tag = strings.TrimSpace(tag)
+			if !filter.CaseSensitiveTags {
+				tag = strings.ToLower(tag)
+			}
+			tags = append(tags, tag)
+		}
+		query = query.Where("tags @> ?::jsonb", tags)
+	}
+	if filter.Search != nil && *filter.Search != "" {
+		pattern := "%" + escapeLike(*filter.Search) + "%"
+		query = query.Where("(title ILIKE ? OR description ILIKE ?)", pattern, pattern)
+	}
+	return query
+}
+
+// excludeArchived hides archived tasks from a listing unless the filter
+// asks for them or for a particular status.
+func excludeArchived(query *gorm.DB, filter *TaskFilter) *gorm.DB {
+	if filter != nil && (filter.IncludeArchived || (filter.Status != nil && *filter.Status != "")) {
+		return query
+	}
+	return query.Where("status <> ?", model.StatusArchived)
+}
+
+// escapeLike makes LIKE wildcards in user input match literally.
+func escapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term)
+}
+
 func applySorting(query *gorm.DB, filter *TaskFilter) *gorm.DB {
 	if filter == nil || filter.SortBy == "" {
 		// Default sorting by creation date descending
@@ -271,11 +1529,35 @@ func applySorting(query *gorm.DB, filter *TaskFilter) *gorm.DB {
 		order = "DESC"
 	}
 
+	if strings.EqualFold(filter.SortBy, "urgency") {
+		if filter.Urgency == nil {
+			return query.Order("created_at DESC")
+		}
+		return query.Order(filter.Urgency.urgencyOrder(filter.UrgencyAt, filter.SortDesc)).Order("created_at DESC")
+	}
+
 	// Map sort field to database column
 	sortField := mapSortField(filter.SortBy)
 	return query.Order(fmt.Sprintf("%s %s", sortField, order))
 }
 
+func applyColumnOrder(query *gorm.DB, order ColumnOrder) *gorm.DB {
+	switch {
+	case order.SortBy == "":
+		query = query.Order(priorityRankOrder)
+	case strings.EqualFold(order.SortBy, "priority"):
+		// The rank is lowest for the most urgent priority
+		direction := "DESC"
+		if order.SortDesc {
+			direction = "ASC"
+		}
+		query = query.Order(priorityRankOrder + " " + direction)
+	default:
+		query = applySorting(query, &TaskFilter{SortBy: order.SortBy, SortDesc: order.SortDesc})
+	}
+	return query.Order("created_at DESC")
+}
+
 func mapSortField(field string) string {
 	switch strings.ToLower(field) {
 	case "title":
@@ -290,6 +1572,8 @@ func mapSortField(field string) string {
 		return "created_at"
 	case "updated_at":
 		return "updated_at"
+	case "position":
+		return "position"
 	default:
 		return "created_at"
 	}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"math"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+// UrgencyWeights scores tasks for the "urgency" sort. A task's score is
+//
+//	Priority*p + DueDate*d + Staleness*s
+//
+// where p ranks the priority from 0 (low) to 1 (urgent), d grows from 0 at
+// DueHorizon before the due date to 1 once the task is due, and s grows
+// from 0 to 1 as the task goes StaleHorizon without an update. Done and
+// archived tasks score 0.
+type UrgencyWeights struct {
+	Priority  float64
+	DueDate   float64
+	Staleness float64
+
+	DueHorizon   time.Duration
+	StaleHorizon time.Duration
+}
+
+// DefaultUrgencyWeights lets a close due date outweigh priority, and
+// priority outweigh a task merely having sat untouched.
+func DefaultUrgencyWeights() UrgencyWeights {
+	return UrgencyWeights{
+		Priority:     1,
+		DueDate:      2,
+		Staleness:    0.5,
+		DueHorizon:   7 * 24 * time.Hour,
+		StaleHorizon: 14 * 24 * time.Hour,
+	}
+}
+
+// urgencyPriorityRank is p in the UrgencyWeights formula.
+const urgencyPriorityRank = "CASE priority WHEN 'urgent' THEN 1 WHEN 'high' THEN 2 / 3.0 WHEN 'medium' THEN 1 / 3.0 ELSE 0 END"
+
+// Score computes in Go what urgencyOrder sorts by in SQL; keep the two in
+// step.
+func (w UrgencyWeights) Score(task *model.Task, now time.Time) float64 {
+	if task.Status == model.StatusDone || task.Status == model.StatusArchived {
+		return 0
+	}
+
+	var priority float64
+	switch task.Priority {
+	case model.PriorityUrgent:
+		priority = 1
+	case model.PriorityHigh:
+		priority = 2 / 3.0
+	case model.PriorityMedium:
+		priority = 1 / 3.0
+	}
+
+	var due float64
+	if task.DueDate != nil {
+		due = clamp01(1 - task.DueDate.Sub(now).Seconds()/horizonSeconds(w.DueHorizon))
+	}
+
+	stale := clamp01(now.Sub(task.UpdatedAt).Seconds() / horizonSeconds(w.StaleHorizon))
+
+	return w.Priority*priority + w.DueDate*due + w.Staleness*stale
+}
+
+// urgencyOrder sorts by the score as of now.
+func (w UrgencyWeights) urgencyOrder(now time.Time, desc bool) clause.OrderBy {
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL: "CASE WHEN status IN ('done', 'archived') THEN 0 ELSE " +
+			"CAST(? AS double precision) * " + urgencyPriorityRank + " + " +
+			"CAST(? AS double precision) * CASE WHEN due_date IS NULL THEN 0 ELSE " +
+			"LEAST(1, GREATEST(0, 1 - EXTRACT(EPOCH FROM (due_date - CAST(? AS timestamptz))) / CAST(? AS double precision))) END + " +
+			"CAST(? AS double precision) * " +
+			"LEAST(1, GREATEST(0, EXTRACT(EPOCH FROM (CAST(? AS timestamptz) - updated_at)) / CAST(? AS double precision))) " +
+			"END " + direction,
+		Vars: []any{
+			w.Priority,
+			w.DueDate, now, horizonSeconds(w.DueHorizon),
+			w.Staleness, now, horizonSeconds(w.StaleHorizon),
+		},
+		WithoutParentheses: true,
+	}}
+}
+
+// horizonSeconds keeps a misconfigured horizon from dividing by zero.
+func horizonSeconds(horizon time.Duration) float64 {
+	return math.Max(horizon.Seconds(), 1)
+}
+
+func clamp01(v float64) float64 {
+	return math.Min(1, math.Max(0, v))
+}
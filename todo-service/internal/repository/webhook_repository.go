@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	FindByIDAndUser(ctx context.Context, id, userID string) (*model.Webhook, error)
+	Update(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	DeleteByUser(ctx context.Context, id, userID string) error
+	ListByUser(ctx context.Context, userID string) ([]*model.Webhook, error)
+	ListActiveByUser(ctx context.Context, userID string) ([]*model.Webhook, error)
+	CreateDeadLetter(ctx context.Context, deadLetter *model.WebhookDeadLetter) error
+}
+
+type webhookRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{
+		db:     db,
+		logger: zap.L().Named("webhook_repository"),
+	}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	r.logger.Debug("Creating new webhook", zap.String("user_id", webhook.UserID))
+
+	if err := r.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		r.logger.Error("Failed to create webhook", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info("Webhook created successfully",
+		zap.String("id", webhook.ID),
+		zap.String("user_id", webhook.UserID),
+	)
+	return webhook, nil
+}
+
+func (r *webhookRepository) FindByIDAndUser(ctx context.Context, id, userID string) (*model.Webhook, error) {
+	r.logger.Debug("Finding webhook by ID and user",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	var webhook model.Webhook
+	if err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&webhook).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find webhook by ID and user", zap.Error(err))
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (r *webhookRepository) Update(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	r.logger.Debug("Updating webhook", zap.String("id", webhook.ID))
+
+	if err := r.db.WithContext(ctx).Save(webhook).Error; err != nil {
+		r.logger.Error("Failed to update webhook",
+			zap.Error(err),
+			zap.String("id", webhook.ID),
+		)
+		return nil, err
+	}
+
+	r.logger.Info("Webhook updated successfully", zap.String("id", webhook.ID))
+	return webhook, nil
+}
+
+func (r *webhookRepository) DeleteByUser(ctx context.Context, id, userID string) error {
+	r.logger.Debug("Deleting webhook by user",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&model.Webhook{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete webhook", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	r.logger.Info("Webhook deleted successfully", zap.String("id", id))
+	return nil
+}
+
+func (r *webhookRepository) ListByUser(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	var webhooks []*model.Webhook
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&webhooks).Error; err != nil {
+		r.logger.Error("Failed to list webhooks by user", zap.Error(err))
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepository) ListActiveByUser(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	var webhooks []*model.Webhook
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND active = ?", userID, true).
+		Find(&webhooks).Error; err != nil {
+		r.logger.Error("Failed to list active webhooks by user", zap.Error(err))
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepository) CreateDeadLetter(ctx context.Context, deadLetter *model.WebhookDeadLetter) error {
+	if err := r.db.WithContext(ctx).Create(deadLetter).Error; err != nil {
+		r.logger.Error("Failed to record webhook dead letter",
+			zap.Error(err),
+			zap.String("webhook_id", deadLetter.WebhookID),
+		)
+		return err
+	}
+
+	r.logger.Warn("Webhook event moved to dead letter",
+		zap.String("webhook_id", deadLetter.WebhookID),
+		zap.String("event_type", deadLetter.EventType),
+		zap.Int("attempts", deadLetter.Attempts),
+	)
+	return nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+)
+
+// Reasons a task needs attention.
+const (
+	ReasonOverdue               = "overdue"
+	ReasonDueSoon               = "due_soon"
+	ReasonHighPriorityUntouched = "high_priority_untouched"
+)
+
+// AttentionConfig tunes ListTasksNeedingAttention. A task's score is the
+// sum of the weights of the reasons that apply to it.
+type AttentionConfig struct {
+	// DueSoonWindow is how far ahead a due date counts as due soon.
+	DueSoonWindow time.Duration
+	// StaleAfter is how long a high or urgent task may go unchanged before
+	// it counts as untouched.
+	StaleAfter time.Duration
+
+	OverdueWeight               float64
+	DueSoonWeight               float64
+	HighPriorityUntouchedWeight float64
+
+	// MaxResults bounds the limit a single call may request.
+	MaxResults int
+}
+
+// DefaultAttentionConfig ranks overdue work above work due soon, and both
+// above neglected high-priority tasks.
+func DefaultAttentionConfig() AttentionConfig {
+	return AttentionConfig{
+		DueSoonWindow:               48 * time.Hour,
+		StaleAfter:                  72 * time.Hour,
+		OverdueWeight:               3,
+		DueSoonWeight:               2,
+		HighPriorityUntouchedWeight: 1,
+		MaxResults:                  50,
+	}
+}
+
+// AttentionTask is a task with its attention score and the reasons behind it.
+type AttentionTask struct {
+	Task    *model.Task
+	Score   float64
+	Reasons []string
+}
+
+// scoreAttention scores task as of now. Tasks no reason applies to score 0.
+func (c AttentionConfig) scoreAttention(task *model.Task, now time.Time) AttentionTask {
+	scored := AttentionTask{Task: task}
+	add := func(reason string, weight float64) {
+		scored.Reasons = append(scored.Reasons, reason)
+		scored.Score += weight
+	}
+
+	if task.DueDate != nil {
+		switch {
+		case task.DueDate.Before(now):
+			add(ReasonOverdue, c.OverdueWeight)
+		case task.DueDate.Before(now.Add(c.DueSoonWindow)):
+			add(ReasonDueSoon, c.DueSoonWeight)
+		}
+	}
+	if (task.Priority == model.PriorityHigh || task.Priority == model.PriorityUrgent) &&
+		task.UpdatedAt.Before(now.Add(-c.StaleAfter)) {
+		add(ReasonHighPriorityUntouched, c.HighPriorityUntouchedWeight)
+	}
+
+	return scored
+}
+
+// rankAttention orders tasks by score, then earliest due date, then least
+// recently updated, and keeps the top limit.
+func (c AttentionConfig) rankAttention(tasks []*model.Task, now time.Time, limit int) []AttentionTask {
+	ranked := make([]AttentionTask, 0, len(tasks))
+	for _, task := range tasks {
+		if scored := c.scoreAttention(task, now); scored.Score > 0 {
+			ranked = append(ranked, scored)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].Task, ranked[j].Task
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		if (a.DueDate == nil) != (b.DueDate == nil) {
+			return a.DueDate != nil
+		}
+		if a.DueDate != nil && !a.DueDate.Equal(*b.DueDate) {
+			return a.DueDate.Before(*b.DueDate)
+		}
+		if !a.UpdatedAt.Equal(b.UpdatedAt) {
+			return a.UpdatedAt.Before(b.UpdatedAt)
+		}
+		return a.ID < b.ID
+	})
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// sweepBatchSize bounds the number of ids checked against the database per
+// query.
+const sweepBatchSize = 500
+
+// CacheSweeper periodically removes cached tasks whose rows no longer exist,
+// e.g. after a delete that bypassed the service.
+type CacheSweeper struct {
+	repo     repository.TaskRepository
+	cache    cache.TaskCache
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func NewCacheSweeper(repo repository.TaskRepository, cache cache.TaskCache, interval time.Duration) *CacheSweeper {
+	return &CacheSweeper{
+		repo:     repo,
+		cache:    cache,
+		interval: interval,
+		logger:   zap.L().Named("cache_sweeper"),
+	}
+}
+
+// Run sweeps every interval until ctx is cancelled.
+func (s *CacheSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Sweep(ctx); err != nil {
+				s.logger.Error("Cache sweep failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Sweep deletes orphaned task entries once and returns how many were removed.
+func (s *CacheSweeper) Sweep(ctx context.Context) (int, error) {
+	ids, err := s.cache.CachedTaskIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for start := 0; start < len(ids); start += sweepBatchSize {
+		batch := ids[start:min(start+sweepBatchSize, len(ids))]
+
+		existing, err := s.repo.ExistingIDs(ctx, batch)
+		if err != nil {
+			return removed, err
+		}
+
+		found := make(map[string]bool, len(existing))
+		for _, id := range existing {
+			found[id] = true
+		}
+
+		for _, id := range batch {
+			if found[id] {
+				continue
+			}
+			if err := s.cache.DeleteTask(ctx, id); err != nil {
+				return removed, err
+			}
+			removed++
+		}
+	}
+
+	if removed > 0 {
+		s.logger.Info("Removed orphaned tasks from cache", zap.Int("count", removed))
+	}
+	return removed, nil
+}
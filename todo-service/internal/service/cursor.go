@@ -0,0 +1,38 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+	"github.com/google/uuid"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// encodeCursor returns the opaque cursor pointing just past task.
+func encodeCursor(task *model.Task) string {
+	raw := task.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + task.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (*repository.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || uuid.Validate(id) != nil {
+		return nil, errInvalidCursor
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &repository.Cursor{CreatedAt: parsed, ID: id}, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"go.uber.org/zap"
+)
+
+// Recurrence frequencies understood by PreviewRecurrence.
+const (
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+)
+
+// recurrenceRule is a parsed rule such as "WEEKLY" or "MONTHLY;INTERVAL=3".
+type recurrenceRule struct {
+	frequency string
+	interval  int
+}
+
+func parseRecurrenceRule(rule string) (recurrenceRule, error) {
+	parts := strings.Split(strings.ToUpper(strings.TrimSpace(rule)), ";")
+
+	parsed := recurrenceRule{frequency: parts[0], interval: 1}
+	switch parsed.frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	case "":
+		return parsed, fmt.Errorf("invalid recurrence rule %q: frequency is required", rule)
+	default:
+		return parsed, fmt.Errorf("invalid recurrence rule %q: frequency must be DAILY, WEEKLY or MONTHLY", rule)
+	}
+
+	for _, part := range parts[1:] {
+		key, value, _ := strings.Cut(part, "=")
+		if key != "INTERVAL" {
+			return parsed, fmt.Errorf("invalid recurrence rule %q: unknown part %q", rule, part)
+		}
+		interval, err := strconv.Atoi(value)
+		if err != nil || interval < 1 {
+			return parsed, fmt.Errorf("invalid recurrence rule %q: interval must be a positive integer", rule)
+		}
+		parsed.interval = interval
+	}
+
+	return parsed, nil
+}
+
+// String returns the canonical form of the rule, omitting an interval of 1.
+func (r recurrenceRule) String() string {
+	if r.interval == 1 {
+		return r.frequency
+	}
+	return fmt.Sprintf("%s;INTERVAL=%d", r.frequency, r.interval)
+}
+
+// normalizeRecurrenceRule parses rule and returns its canonical form, or nil
+// for an empty rule.
+func normalizeRecurrenceRule(rule string) (*string, error) {
+	if strings.TrimSpace(rule) == "" {
+		return nil, nil
+	}
+	parsed, err := parseRecurrenceRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	normalized := parsed.String()
+	return &normalized, nil
+}
+
+// occurrence returns the k-th occurrence after from. Monthly rules keep
+// from's day of month, clamped to the last day of shorter months, so a
+// rule starting on the 31st lands on the 30th or the 28th/29th.
+func (r recurrenceRule) occurrence(from time.Time, k int) time.Time {
+	steps := k * r.interval
+	switch r.frequency {
+	case FrequencyDaily:
+		return from.AddDate(0, 0, steps)
+	case FrequencyWeekly:
+		return from.AddDate(0, 0, 7*steps)
+	default:
+		firstOfMonth := time.Date(from.Year(), from.Month(), 1, from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), from.Location())
+		target := firstOfMonth.AddDate(0, steps, 0)
+		day := min(from.Day(), daysIn(target.Year(), target.Month()))
+		return target.AddDate(0, 0, day-1)
+	}
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// PreviewRecurrence returns the n occurrences of rule that follow from.
+// Rules are a frequency (DAILY, WEEKLY or MONTHLY) optionally followed by
+// ";INTERVAL=n", e.g. "WEEKLY;INTERVAL=2" for every other week.
+func PreviewRecurrence(rule string, from time.Time, n int) ([]time.Time, error) {
+	parsed, err := parseRecurrenceRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("occurrence count must be positive, got %d", n)
+	}
+
+	occurrences := make([]time.Time, n)
+	for i := range occurrences {
+		occurrences[i] = parsed.occurrence(from, i+1)
+	}
+	return occurrences, nil
+}
+
+// nextRecurringTask returns the instance that follows task once it is
+// completed at now, or nil when task does not recur. The next due date is
+// one step of the rule after the current one; tasks without a due date
+// step from the completion time instead.
+func nextRecurringTask(task *model.Task, now time.Time) *model.Task {
+	if task.RecurrenceRule == nil {
+		return nil
+	}
+	parsed, err := parseRecurrenceRule(*task.RecurrenceRule)
+	if err != nil {
+		zap.L().Named("task_service").Warn("Skipping task with invalid recurrence rule",
+			zap.String("id", task.ID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	from := now
+	if task.DueDate != nil {
+		from = *task.DueDate
+	}
+	dueDate := parsed.occurrence(from, 1)
+	rule := *task.RecurrenceRule
+	parentID := task.ID
+
+	return &model.Task{
+		UserID:         task.UserID,
+		Title:          task.Title,
+		Description:    task.Description,
+		Status:         model.StatusTodo,
+		Priority:       task.Priority,
+		DueDate:        &dueDate,
+		Tags:           append(model.Tags(nil), task.Tags...),
+		UpdatedBy:      task.UpdatedBy,
+		RecurrenceRule: &rule,
+		ParentTaskID:   &parentID,
+	}
+}
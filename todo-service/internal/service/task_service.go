@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/events"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -22,23 +24,175 @@ import (
 
 type TaskService interface {
 	CreateTask(ctx context.Context, req *CreateTaskRequest) (*model.Task, error)
+	CreateTasksBatch(ctx context.Context, reqs []*CreateTaskRequest) ([]*model.Task, error)
 	GetTask(ctx context.Context, id string) (*model.Task, error)
-	GetTaskByUser(ctx context.Context, id, userID string) (*model.Task, error)
+	GetTaskByUser(ctx context.Context, id, userID string, includeDeleted bool) (*model.Task, error)
+	GetTasksByIDs(ctx context.Context, ids []string) ([]*model.Task, error)
 	UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*model.Task, error)
+	CompleteTask(ctx context.Context, id, userID string) (*model.Task, *model.Task, error)
+	ArchiveTask(ctx context.Context, id, userID string) (*model.Task, error)
+	UnarchiveTask(ctx context.Context, id, userID string) (*model.Task, error)
 	DeleteTask(ctx context.Context, id string) error
 	DeleteTaskByUser(ctx context.Context, id, userID string) error
+	RestoreTask(ctx context.Context, id, userID string) (*model.Task, error)
+	PurgeTask(ctx context.Context, id, userID string) error
+	RestoreAllTasks(ctx context.Context, userID string) (int64, error)
+	BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) (int, error)
+	BulkAddTags(ctx context.Context, userID string, ids, tags []string) (int, error)
+	BulkRemoveTags(ctx context.Context, userID string, ids, tags []string) (int, error)
+	PurgeUserData(ctx context.Context, userID string) (*repository.PurgeResult, error)
 	ListTasks(ctx context.Context, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error)
+	ListTasksCursor(ctx context.Context, filter *repository.TaskFilter, cursor string, pageSize int) ([]*model.Task, string, error)
 	ListTasksByUser(ctx context.Context, userID string, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error)
+	GetBoard(ctx context.Context, userID string, perColumn int) (map[string][]*model.Task, error)
+	MoveTaskOnBoard(ctx context.Context, id, userID, newStatus string, newPosition int) (*model.Task, error)
+	ReorderTask(ctx context.Context, id, userID string, newPosition int) (*model.Task, error)
+	MarkAllSeen(ctx context.Context, userID string) (time.Time, error)
+	GetLastSeen(ctx context.Context, userID string) (*time.Time, error)
+	GetUnreadCount(ctx context.Context, userID string) (int64, *time.Time, error)
+	CountTasks(ctx context.Context, userID string, filter *repository.TaskFilter) (int64, error)
+	GetTaskMatrix(ctx context.Context, userID string) (map[string]map[string]int64, error)
+	GetTaskStats(ctx context.Context, userID string) (map[string]int64, error)
+	GetUserMetrics(ctx context.Context, userID string) (*model.TaskMetrics, error)
+	GetCompletionTrends(ctx context.Context, userID, granularity string, from, to time.Time) ([]TrendPoint, error)
+	RefreshMetrics(ctx context.Context) error
+	ListTasksNeedingAttention(ctx context.Context, userID string, limit int) ([]AttentionTask, error)
+	PreviewRecurrence(ctx context.Context, rule string, from time.Time, count int) ([]time.Time, error)
+	ListTasksModifiedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error)
+	ListOverdueTasks(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error)
+	ListDeletedTasks(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error)
+	SnoozeOverdue(ctx context.Context, userID string, by time.Duration) (int, error)
+	DeleteCompletedTasks(ctx context.Context, userID string) (int64, error)
+	SearchTasks(ctx context.Context, userID, query string, page, pageSize int) ([]*model.Task, int64, error)
 }
 
 type taskService struct {
 	repo       repository.TaskRepository
 	cache      cache.TaskCache
 	metrics    *MetricsCollector
+	publisher  events.EventPublisher
+	config     Config
 	logger     *zap.Logger
 	tracer     trace.Tracer
 }
 
+// Config holds the tunable limits of the task service.
+type Config struct {
+	// MaxBoardPerColumn bounds how many tasks a single GetBoard call may
+	// request per status column.
+	MaxBoardPerColumn int
+	// MaxBatchCreate bounds how many tasks a single CreateTasksBatch call
+	// may create.
+	MaxBatchCreate int
+	// MaxBatchGet bounds how many ids a single GetTasksByIDs call may look up.
+	MaxBatchGet int
+	// MaxBulkUpdate bounds how many tasks a single bulk update may touch.
+	MaxBulkUpdate int
+	// MaxRecurrencePreview bounds how many occurrences a single
+	// PreviewRecurrence call may compute.
+	MaxRecurrencePreview int
+	// MaxSearchPageSize bounds how many results a single SearchTasks call
+	// may return; larger page sizes are clamped.
+	MaxSearchPageSize int
+	// MaxSnooze bounds how far a single SnoozeOverdue call may push due
+	// dates.
+	MaxSnooze time.Duration
+	// MaxTrendPoints bounds how many periods a single GetCompletionTrends
+	// call may cover.
+	MaxTrendPoints int
+	// RestoreAllWindow limits RestoreAllTasks to tasks deleted within this
+	// long of the call. Zero restores the whole trash.
+	RestoreAllWindow time.Duration
+	// GenerateRecurring creates the next instance of a recurring task when
+	// UpdateTask marks it done.
+	GenerateRecurring bool
+	// FoldTagCase lowercases tags before they are stored or matched, so
+	// tags differing only in case are one tag. When off, tags keep the
+	// case they were written in and filters match them exactly.
+	FoldTagCase bool
+	// TagAllowlist, when not empty, is the only tags tasks may carry. Tags
+	// are compared after normalization, so with FoldTagCase "Work" matches
+	// an allowed "work". Empty allows any tag.
+	TagAllowlist []string
+	// BoardOrder sorts individual board columns. Columns without an entry
+	// keep the repository's default ordering.
+	BoardOrder map[model.TaskStatus]repository.ColumnOrder
+	// Attention scores tasks for ListTasksNeedingAttention.
+	Attention AttentionConfig
+	// Urgency weighs the "urgency" sort of ListTasks and ListTasksByUser.
+	Urgency repository.UrgencyWeights
+}
+
+// DefaultConfig returns the limits used by NewTaskService.
+func DefaultConfig() Config {
+	return Config{
+		MaxBoardPerColumn:    50,
+		MaxBatchCreate:       100,
+		MaxBatchGet:          100,
+		MaxBulkUpdate:        100,
+		MaxRecurrencePreview: 52,
+		MaxSearchPageSize:    50,
+		MaxSnooze:            30 * 24 * time.Hour,
+		MaxTrendPoints:       366,
+		GenerateRecurring:    true,
+		FoldTagCase:          true,
+		Attention:            DefaultAttentionConfig(),
+		Urgency:              repository.DefaultUrgencyWeights(),
+	}
+}
+
+// matrixPriorities are the task matrix columns, least urgent first.
+var matrixPriorities = []model.TaskPriority{
+	model.PriorityLow,
+	model.PriorityMedium,
+	model.PriorityHigh,
+	model.PriorityUrgent,
+}
+
+// boardStatuses are the board columns, in display order.
+var boardStatuses = []model.TaskStatus{
+	model.StatusTodo,
+	model.StatusInProgress,
+	model.StatusDone,
+	model.StatusArchived,
+}
+
+// boardSortFields are the fields a board column may be sorted by.
+var boardSortFields = []string{"title", "priority", "due_date", "created_at", "updated_at", "position"}
+
+// ParseBoardOrder turns a status to "field [asc|desc]" map, as found in the
+// config file, into per-column orderings for Config.BoardOrder.
+func ParseBoardOrder(spec map[string]string) (map[model.TaskStatus]repository.ColumnOrder, error) {
+	orders := make(map[model.TaskStatus]repository.ColumnOrder, len(spec))
+	for key, value := range spec {
+		taskStatus := model.TaskStatus(strings.ToLower(key))
+		if !slices.Contains(boardStatuses, taskStatus) {
+			return nil, fmt.Errorf("board order: unknown status %q", key)
+		}
+
+		fields := strings.Fields(strings.ToLower(value))
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("board order for %s: expected \"field [asc|desc]\", got %q", key, value)
+		}
+		if !slices.Contains(boardSortFields, fields[0]) {
+			return nil, fmt.Errorf("board order for %s: cannot sort by %q", key, fields[0])
+		}
+
+		order := repository.ColumnOrder{SortBy: fields[0]}
+		if len(fields) == 2 {
+			switch fields[1] {
+			case "asc":
+			case "desc":
+				order.SortDesc = true
+			default:
+				return nil, fmt.Errorf("board order for %s: unknown direction %q", key, fields[1])
+			}
+		}
+		orders[taskStatus] = order
+	}
+	return orders, nil
+}
+
 type CreateTaskRequest struct {
 	UserID      string
 	Title       string
@@ -46,6 +200,25 @@ type CreateTaskRequest struct {
 	Status      string
 	Priority    string
 	DueDate     *time.Time
+	Tags        []string
+	// RecurrenceRule makes the task recur; see PreviewRecurrence for the syntax.
+	RecurrenceRule string
+}
+
+// BatchItemError describes why one item of a batch was rejected.
+type BatchItemError struct {
+	Index   int
+	Message string
+}
+
+// BatchCreateError is returned by CreateTasksBatch when some items were
+// rejected. None of the batch has been persisted.
+type BatchCreateError struct {
+	Items []BatchItemError
+}
+
+func (e *BatchCreateError) Error() string {
+	return fmt.Sprintf("%d of the batch items were rejected", len(e.Items))
 }
 
 type UpdateTaskRequest struct {
@@ -56,13 +229,29 @@ type UpdateTaskRequest struct {
 	Status      *string
 	Priority    *string
 	DueDate     *time.Time
+	// ClearDueDate removes the task's due date; DueDate takes precedence.
+	ClearDueDate bool
+	// Tags replaces the task's tags when set; an empty slice clears them.
+	Tags        *[]string
+	// RecurrenceRule replaces the task's rule when set; an empty rule stops
+	// the task from recurring.
+	RecurrenceRule *string
+	// ExpectedVersion is the task version the caller read. When set, the
+	// update is rejected if the task has changed since.
+	ExpectedVersion *int
 }
 
 func NewTaskService(repo repository.TaskRepository, cache cache.TaskCache, metrics *MetricsCollector) TaskService {
+	return NewTaskServiceWithConfig(repo, cache, metrics, events.NewNoopPublisher(), DefaultConfig())
+}
+
+func NewTaskServiceWithConfig(repo repository.TaskRepository, cache cache.TaskCache, metrics *MetricsCollector, publisher events.EventPublisher, config Config) TaskService {
 	return &taskService{
-		repo:    repo,
-		cache:   cache,
-		metrics: metrics,
+		repo:      repo,
+		cache:     cache,
+		metrics:   metrics,
+		publisher: publisher,
+		config:    config,
 		logger:  zap.L().Named("task_service"),
 		tracer:  otel.Tracer("task-service"),
 	}
@@ -89,28 +278,8 @@ func (s *taskService) CreateTask(ctx context.Context, req *CreateTaskRequest) (*
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Create task model
-	task := &model.Task{
-		UserID:      req.UserID,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      model.StatusTodo,
-		Priority:    model.PriorityMedium,
-		DueDate:     req.DueDate,
-	}
-
-	// Set status if provided
-	if req.Status != "" {
-		task.Status = task.FromProtoStatus(req.Status)
-	}
-
-	// Set priority if provided
-	if req.Priority != "" {
-		task.Priority = task.FromProtoPriority(req.Priority)
-	}
-
 	// Create task in database
-	createdTask, err := s.repo.Create(ctx, task)
+	createdTask, err := s.repo.Create(ctx, newTaskFromRequest(req, s.config.FoldTagCase))
 	if err != nil {
 		s.logger.Error("Failed to create task in repository", zap.Error(err))
 		s.metrics.IncrementDatabaseErrors()
@@ -132,6 +301,8 @@ func (s *taskService) CreateTask(ctx context.Context, req *CreateTaskRequest) (*
 		// Don't fail the operation if caching fails
 	}
 
+	s.publishEvent(ctx, events.NewTaskEvent(events.TaskCreated, createdTask, nil))
+
 	s.logger.Info("Task created successfully", 
 		zap.String("id", createdTask.ID),
 		zap.String("user_id", req.UserID),
@@ -143,6 +314,78 @@ func (s *taskService) CreateTask(ctx context.Context, req *CreateTaskRequest) (*
 	return createdTask, nil
 }
 
+func (s *taskService) CreateTasksBatch(ctx context.Context, reqs []*CreateTaskRequest) ([]*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.CreateTasksBatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("batch.size", len(reqs)))
+
+	s.logger.Debug("Creating task batch", zap.Int("count", len(reqs)))
+
+	if len(reqs) == 0 {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "at least one task is required")
+	}
+	if len(reqs) > s.config.MaxBatchCreate {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tasks can be created per batch", s.config.MaxBatchCreate)
+	}
+
+	// Validate every item first so the caller learns about all rejects at once
+	var itemErrors []BatchItemError
+	tasks := make([]*model.Task, 0, len(reqs))
+	for i, req := range reqs {
+		if err := s.validateCreateTaskRequest(req); err != nil {
+			itemErrors = append(itemErrors, BatchItemError{Index: i, Message: err.Error()})
+			continue
+		}
+		tasks = append(tasks, newTaskFromRequest(req, s.config.FoldTagCase))
+	}
+	if len(itemErrors) > 0 {
+		s.logger.Warn("Invalid create task batch", zap.Int("rejected", len(itemErrors)))
+		s.metrics.IncrementValidationErrors()
+		return nil, &BatchCreateError{Items: itemErrors}
+	}
+
+	if err := s.repo.CreateBatch(ctx, tasks); err != nil {
+		s.logger.Error("Failed to create task batch in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+
+		var batchErr *repository.BatchError
+		if errors.As(err, &batchErr) {
+			return nil, &BatchCreateError{Items: []BatchItemError{{Index: batchErr.Index, Message: "failed to create task"}}}
+		}
+		return nil, status.Error(codes.Internal, "failed to create tasks")
+	}
+
+	// Invalidate each affected user's lists once rather than per task
+	invalidated := make(map[string]bool)
+	for _, task := range tasks {
+		if invalidated[task.UserID] {
+			continue
+		}
+		invalidated[task.UserID] = true
+		if err := s.cache.InvalidateUserTasks(ctx, task.UserID); err != nil {
+			s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	for _, task := range tasks {
+		if err := s.cache.SetTask(ctx, task); err != nil {
+			s.logger.Error("Failed to cache newly created task", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+		s.publishEvent(ctx, events.NewTaskEvent(events.TaskCreated, task, nil))
+		s.metrics.UpdateTasksCountByStatus(task.ToProtoStatus(), 1)
+		s.metrics.UpdateTasksCountByPriority(task.ToProtoPriority(), 1)
+	}
+
+	s.logger.Info("Task batch created successfully", zap.Int("count", len(tasks)))
+	return tasks, nil
+}
+
 func (s *taskService) GetTask(ctx context.Context, id string) (*model.Task, error) {
 	ctx, span := s.tracer.Start(ctx, "TaskService.GetTask")
 	defer span.End()
@@ -175,6 +418,12 @@ func (s *taskService) GetTask(ctx context.Context, id string) (*model.Task, erro
 
 	if task == nil {
 		s.logger.Warn("Task not found", zap.String("id", id))
+		// Drop any entry the cache could not serve (e.g. unreadable) so it
+		// does not outlive the row
+		if err := s.cache.DeleteTask(ctx, id); err != nil {
+			s.logger.Error("Failed to delete orphaned task from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
 		return nil, status.Error(codes.NotFound, "task not found")
 	}
 
@@ -189,7 +438,9 @@ func (s *taskService) GetTask(ctx context.Context, id string) (*model.Task, erro
 	return task, nil
 }
 
-func (s *taskService) GetTaskByUser(ctx context.Context, id, userID string) (*model.Task, error) {
+// GetTaskByUser returns one of the user's tasks. With includeDeleted the
+// user's soft-deleted task is returned too, so it can be restored.
+func (s *taskService) GetTaskByUser(ctx context.Context, id, userID string, includeDeleted bool) (*model.Task, error) {
 	ctx, span := s.tracer.Start(ctx, "TaskService.GetTaskByUser")
 	defer span.End()
 
@@ -215,7 +466,9 @@ func (s *taskService) GetTaskByUser(ctx context.Context, id, userID string) (*mo
 				zap.String("expected_user", userID),
 				zap.String("actual_user", cachedTask.UserID),
 			)
-			return nil, status.Error(codes.PermissionDenied, "task not found")
+			// Answer as a cache miss would, so the code does not depend on
+			// cache state or confirm that the id exists
+			return nil, status.Error(codes.NotFound, "task not found")
 		}
 		s.metrics.IncrementCacheHits()
 		s.logger.Debug("Task retrieved from cache by user", zap.String("id", id))
@@ -225,7 +478,12 @@ func (s *taskService) GetTaskByUser(ctx context.Context, id, userID string) (*mo
 	s.metrics.IncrementCacheMisses()
 
 	// Get from database
-	task, err := s.repo.FindByIDAndUser(ctx, id, userID)
+	var task *model.Task
+	if includeDeleted {
+		task, err = s.repo.FindByIDAndUserIncludingDeleted(ctx, id, userID)
+	} else {
+		task, err = s.repo.FindByIDAndUser(ctx, id, userID)
+	}
 	if err != nil {
 		s.logger.Error("Failed to get task by user from repository", zap.Error(err))
 		s.metrics.IncrementDatabaseErrors()
@@ -241,16 +499,90 @@ func (s *taskService) GetTaskByUser(ctx context.Context, id, userID string) (*mo
 		return nil, status.Error(codes.NotFound, "task not found")
 	}
 
-	// Cache the task
-	if err := s.cache.SetTask(ctx, task); err != nil {
-		s.logger.Error("Failed to cache task", zap.Error(err))
-		s.metrics.IncrementCacheErrors()
+	// Cache the task, unless deleted: cached tasks are served as live ones
+	if !task.DeletedAt.Valid {
+		if err := s.cache.SetTask(ctx, task); err != nil {
+			s.logger.Error("Failed to cache task", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
 	}
 
 	s.logger.Debug("Task retrieved from database by user", zap.String("id", id))
 	return task, nil
 }
 
+// GetTasksByIDs returns the tasks with the given ids in request order,
+// omitting ids that do not exist. Cached tasks are served with a single
+// multi-get and the rest are loaded with one query and cached.
+func (s *taskService) GetTasksByIDs(ctx context.Context, ids []string) ([]*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetTasksByIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("task.count", len(ids)))
+
+	if len(ids) > s.config.MaxBatchGet {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tasks can be fetched per batch", s.config.MaxBatchGet)
+	}
+
+	// Malformed ids cannot match a row, so treat them as missing
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if uuid.Validate(id) == nil && !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+
+	found, err := s.cache.GetTasks(ctx, unique)
+	if err != nil {
+		s.logger.Error("Failed to get tasks from cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+		found = make(map[string]*model.Task, len(unique))
+	}
+
+	var misses []string
+	for _, id := range unique {
+		if _, ok := found[id]; ok {
+			s.metrics.IncrementCacheHits()
+		} else {
+			s.metrics.IncrementCacheMisses()
+			misses = append(misses, id)
+		}
+	}
+
+	if len(misses) > 0 {
+		loaded, err := s.repo.FindByIDs(ctx, misses)
+		if err != nil {
+			s.logger.Error("Failed to get tasks from repository", zap.Error(err))
+			s.metrics.IncrementDatabaseErrors()
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to get tasks")
+		}
+
+		for _, task := range loaded {
+			found[task.ID] = task
+			if err := s.cache.SetTask(ctx, task); err != nil {
+				s.logger.Error("Failed to cache task", zap.Error(err))
+				s.metrics.IncrementCacheErrors()
+			}
+		}
+	}
+
+	tasks := make([]*model.Task, 0, len(found))
+	for _, id := range unique {
+		if task, ok := found[id]; ok {
+			tasks = append(tasks, task)
+		}
+	}
+
+	s.logger.Debug("Tasks retrieved by IDs", 
+		zap.Int("requested", len(ids)),
+		zap.Int("found", len(tasks)),
+		zap.Int("cache_misses", len(misses)),
+	)
+	return tasks, nil
+}
+
 func (s *taskService) UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*model.Task, error) {
 	ctx, span := s.tracer.Start(ctx, "TaskService.UpdateTask")
 	defer span.End()
@@ -265,13 +597,118 @@ func (s *taskService) UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*
 		zap.String("user_id", req.UserID),
 	)
 
+	task, _, err := s.updateTask(ctx, req)
+	return task, err
+}
+
+// CompleteTask marks a task done. When that completes a recurring task, the
+// next occurrence is created in the same transaction and returned as next.
+// Completing a task that is already done changes nothing and returns no next.
+func (s *taskService) CompleteTask(ctx context.Context, id, userID string) (*model.Task, *model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.CompleteTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	s.logger.Debug("Completing task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	done := "DONE"
+	return s.updateTask(ctx, &UpdateTaskRequest{ID: id, UserID: userID, Status: &done})
+}
+
+// ArchiveTask sets the task archived, which hides it from listings unless
+// they ask for archived tasks. Archiving an archived task changes nothing.
+func (s *taskService) ArchiveTask(ctx context.Context, id, userID string) (*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ArchiveTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	s.logger.Debug("Archiving task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	return s.setArchived(ctx, id, userID, true)
+}
+
+// UnarchiveTask moves an archived task back to the to-do status.
+// Unarchiving a task that is not archived changes nothing.
+func (s *taskService) UnarchiveTask(ctx context.Context, id, userID string) (*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.UnarchiveTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	s.logger.Debug("Unarchiving task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	return s.setArchived(ctx, id, userID, false)
+}
+
+// setArchived updates the task only when it is not already in the wanted
+// state, so unarchiving never reopens a task that was merely done.
+func (s *taskService) setArchived(ctx context.Context, id, userID string, archived bool) (*model.Task, error) {
+	task, err := s.repo.FindByIDAndUser(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("Failed to get task for archiving", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		trace.SpanFromContext(ctx).RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get task")
+	}
+	if task == nil {
+		s.logger.Warn("Task not found for archiving",
+			zap.String("id", id),
+			zap.String("user_id", userID),
+		)
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+	if (task.Status == model.StatusArchived) == archived {
+		return task, nil
+	}
+
+	newStatus := "TODO"
+	if archived {
+		newStatus = "ARCHIVED"
+	}
+	// Pinning the version read above turns a concurrent change into
+	// Aborted rather than acting on a stale status
+	version := task.Version
+	updated, _, err := s.updateTask(ctx, &UpdateTaskRequest{
+		ID:              id,
+		UserID:          userID,
+		Status:          &newStatus,
+		ExpectedVersion: &version,
+	})
+	return updated, err
+}
+
+// updateTask applies req and also returns the next recurring task it
+// created, if any.
+func (s *taskService) updateTask(ctx context.Context, req *UpdateTaskRequest) (*model.Task, *model.Task, error) {
+	span := trace.SpanFromContext(ctx)
+
 	// Get existing task
 	task, err := s.repo.FindByIDAndUser(ctx, req.ID, req.UserID)
 	if err != nil {
 		s.logger.Error("Failed to get task for update", zap.Error(err))
 		s.metrics.IncrementDatabaseErrors()
 		span.RecordError(err)
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, nil, status.Error(codes.Internal, "failed to get task")
 	}
 
 	if task == nil {
@@ -279,37 +716,99 @@ func (s *taskService) UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*
 			zap.String("id", req.ID),
 			zap.String("user_id", req.UserID),
 		)
-		return nil, status.Error(codes.NotFound, "task not found")
+		return nil, nil, status.Error(codes.NotFound, "task not found")
+	}
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != task.Version {
+		s.logger.Debug("Rejecting update of stale task version",
+			zap.String("id", req.ID),
+			zap.Int("expected", *req.ExpectedVersion),
+			zap.Int("current", task.Version),
+		)
+		return nil, nil, status.Error(codes.Aborted, "task was modified")
 	}
 
 	// Track old status and priority for metrics
 	oldStatus := task.ToProtoStatus()
 	oldPriority := task.ToProtoPriority()
+	before := *task
 
-	// Update fields if provided
+	// Update fields if provided, writing back only those
+	var fields []string
 	if req.Title != nil {
+		if *req.Title == "" || len(*req.Title) > 255 {
+			s.metrics.IncrementValidationErrors()
+			return nil, nil, status.Error(codes.InvalidArgument, "title must be between 1 and 255 characters")
+		}
 		task.Title = *req.Title
+		fields = append(fields, "title")
 	}
 	if req.Description != nil {
 		task.Description = *req.Description
+		fields = append(fields, "description")
 	}
 	if req.Status != nil {
-		task.Status = task.FromProtoStatus(*req.Status)
+		fields = append(fields, "status")
+		if task.SetStatus(task.FromProtoStatus(*req.Status), time.Now()) {
+			fields = append(fields, "completed_at")
+		}
 	}
 	if req.Priority != nil {
 		task.Priority = task.FromProtoPriority(*req.Priority)
+		fields = append(fields, "priority")
 	}
 	if req.DueDate != nil {
 		task.DueDate = req.DueDate
+		fields = append(fields, "due_date")
+	} else if req.ClearDueDate {
+		task.DueDate = nil
+		fields = append(fields, "due_date")
+	}
+	if req.Tags != nil {
+		if err := s.validateTags(*req.Tags); err != nil {
+			s.logger.Warn("Invalid update task request", zap.Error(err))
+			s.metrics.IncrementValidationErrors()
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		task.Tags = normalizeTags(*req.Tags, s.config.FoldTagCase)
+		fields = append(fields, "tags")
+	}
+	if req.RecurrenceRule != nil {
+		rule, err := normalizeRecurrenceRule(*req.RecurrenceRule)
+		if err != nil {
+			s.logger.Warn("Invalid update task request", zap.Error(err))
+			s.metrics.IncrementValidationErrors()
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		task.RecurrenceRule = rule
+		fields = append(fields, "recurrence_rule")
+	}
+
+	updatedBy := req.UserID
+	task.UpdatedBy = &updatedBy
+	fields = append(fields, "updated_by")
+
+	// Completing a recurring task creates its next instance alongside
+	var next *model.Task
+	if s.config.GenerateRecurring && before.Status != model.StatusDone && task.Status == model.StatusDone {
+		next = nextRecurringTask(task, time.Now())
 	}
 
 	// Update task in database
-	updatedTask, err := s.repo.Update(ctx, task)
+	var updatedTask *model.Task
+	if next != nil {
+		updatedTask, err = s.repo.UpdateWithNext(ctx, task, next, fields...)
+	} else {
+		updatedTask, err = s.repo.Update(ctx, task, fields...)
+	}
+	if errors.Is(err, repository.ErrVersionConflict) {
+		s.logger.Debug("Task changed during update", zap.String("id", req.ID))
+		return nil, nil, status.Error(codes.Aborted, "task was modified")
+	}
 	if err != nil {
 		s.logger.Error("Failed to update task in repository", zap.Error(err))
 		s.metrics.IncrementDatabaseErrors()
 		span.RecordError(err)
-		return nil, status.Error(codes.Internal, "failed to update task")
+		return nil, nil, status.Error(codes.Internal, "failed to update task")
 	}
 
 	// Invalidate user tasks cache
@@ -334,8 +833,28 @@ func (s *taskService) UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*
 		s.metrics.UpdateTasksCountByPriority(updatedTask.ToProtoPriority(), 1)
 	}
 
+	eventType := events.TaskUpdated
+	if before.Status != model.StatusDone && updatedTask.Status == model.StatusDone {
+		eventType = events.TaskCompleted
+	}
+	s.publishEvent(ctx, events.NewTaskEvent(eventType, updatedTask, events.TaskChanges(&before, updatedTask)))
+
+	if next != nil {
+		if err := s.cache.SetTask(ctx, next); err != nil {
+			s.logger.Error("Failed to cache next recurring task", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+		s.metrics.UpdateTasksCountByStatus(next.ToProtoStatus(), 1)
+		s.metrics.UpdateTasksCountByPriority(next.ToProtoPriority(), 1)
+		s.publishEvent(ctx, events.NewTaskEvent(events.TaskCreated, next, nil))
+		s.logger.Info("Next recurring task created",
+			zap.String("id", next.ID),
+			zap.String("parent_id", req.ID),
+		)
+	}
+
 	s.logger.Info("Task updated successfully", zap.String("id", req.ID))
-	return updatedTask, nil
+	return updatedTask, next, nil
 }
 
 func (s *taskService) DeleteTask(ctx context.Context, id string) error {
@@ -346,24 +865,12 @@ func (s *taskService) DeleteTask(ctx context.Context, id string) error {
 
 	s.logger.Debug("Deleting task", zap.String("id", id))
 
-	// First get the task to know the user ID for cache invalidation
-	task, err := s.repo.FindByID(ctx, id)
-	if err != nil {
-		s.logger.Error("Failed to get task for deletion", zap.Error(err))
-		s.metrics.IncrementDatabaseErrors()
-		span.RecordError(err)
-		return status.Error(codes.Internal, "failed to delete task")
-	}
-
-	if task == nil {
-		s.logger.Warn("Task not found for deletion", zap.String("id", id))
-		return status.Error(codes.NotFound, "task not found")
-	}
-
-	// Delete from database
-	err = s.repo.Delete(ctx, id)
+	// Delete from database. The deleted row, not a cached or earlier
+	// read, tells which status and priority gauges to decrement.
+	task, err := s.repo.Delete(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Warn("Task not found for deletion", zap.String("id", id))
 			return status.Error(codes.NotFound, "task not found")
 		}
 		s.logger.Error("Failed to delete task from repository", zap.Error(err))
@@ -388,6 +895,8 @@ func (s *taskService) DeleteTask(ctx context.Context, id string) error {
 	s.metrics.UpdateTasksCountByStatus(task.ToProtoStatus(), -1)
 	s.metrics.UpdateTasksCountByPriority(task.ToProtoPriority(), -1)
 
+	s.publishEvent(ctx, events.NewTaskEvent(events.TaskDeleted, task, nil))
+
 	s.logger.Info("Task deleted successfully", zap.String("id", id))
 	return nil
 }
@@ -406,27 +915,15 @@ func (s *taskService) DeleteTaskByUser(ctx context.Context, id, userID string) e
 		zap.String("user_id", userID),
 	)
 
-	// First get the task to update metrics
-	task, err := s.repo.FindByIDAndUser(ctx, id, userID)
-	if err != nil {
-		s.logger.Error("Failed to get task for deletion", zap.Error(err))
-		s.metrics.IncrementDatabaseErrors()
-		span.RecordError(err)
-		return status.Error(codes.Internal, "failed to delete task")
-	}
-
-	if task == nil {
-		s.logger.Warn("Task not found for deletion by user", 
-			zap.String("id", id),
-			zap.String("user_id", userID),
-		)
-		return status.Error(codes.NotFound, "task not found")
-	}
-
-	// Delete from database
-	err = s.repo.DeleteByUser(ctx, id, userID)
+	// Delete from database, reading the status and priority to decrement
+	// from the deleted row
+	task, err := s.repo.DeleteByUser(ctx, id, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Warn("Task not found for deletion by user", 
+				zap.String("id", id),
+				zap.String("user_id", userID),
+			)
 			return status.Error(codes.NotFound, "task not found")
 		}
 		s.logger.Error("Failed to delete task by user from repository", zap.Error(err))
@@ -451,143 +948,1378 @@ func (s *taskService) DeleteTaskByUser(ctx context.Context, id, userID string) e
 	s.metrics.UpdateTasksCountByStatus(task.ToProtoStatus(), -1)
 	s.metrics.UpdateTasksCountByPriority(task.ToProtoPriority(), -1)
 
+	s.publishEvent(ctx, events.NewTaskEvent(events.TaskDeleted, task, nil))
+
 	s.logger.Info("Task deleted successfully by user", zap.String("id", id))
 	return nil
 }
 
-func (s *taskService) ListTasks(ctx context.Context, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
-	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasks")
+// RestoreTask brings one of the user's soft-deleted tasks back.
+func (s *taskService) RestoreTask(ctx context.Context, id, userID string) (*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.RestoreTask")
 	defer span.End()
 
 	span.SetAttributes(
-		attribute.Int("page", page),
-		attribute.Int("page_size", pageSize),
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
 	)
 
-	s.logger.Debug("Listing tasks", 
-		zap.Int("page", page),
-		zap.Int("page_size", pageSize),
+	s.logger.Debug("Restoring task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
 	)
 
-	// Validate pagination
-	if page < 1 {
-		page = 1
+	if id == "" || userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "id and user_id are required")
 	}
-	if pageSize < 1 {
-		pageSize = 10
+
+	task, err := s.repo.Restore(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("Failed to restore task in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to restore task")
 	}
-	if pageSize > 100 {
-		pageSize = 100
+
+	if task == nil {
+		s.logger.Warn("Deleted task not found for restore",
+			zap.String("id", id),
+			zap.String("user_id", userID),
+		)
+		return nil, status.Error(codes.NotFound, "deleted task not found")
 	}
 
-	// Generate cache key
-	cacheKey := s.generateCacheKey("list", filter, page, pageSize)
+	// Invalidate user tasks cache
+	if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+		s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
 
-	// Try to get from cache
-	cachedTasks, cachedTotal, err := s.cache.GetTasksList(ctx, cacheKey)
-	if err != nil {
-		s.logger.Error("Failed to get tasks list from cache", zap.Error(err))
+	// Re-cache the restored task
+	if err := s.cache.SetTask(ctx, task); err != nil {
+		s.logger.Error("Failed to cache restored task", zap.Error(err))
 		s.metrics.IncrementCacheErrors()
-	} else if cachedTasks != nil {
-		s.metrics.IncrementCacheHits()
-		s.logger.Debug("Tasks list retrieved from cache", 
-			zap.String("key", cacheKey),
-			zap.Int("count", len(cachedTasks)),
-		)
-		return cachedTasks, cachedTotal, nil
 	}
 
-	s.metrics.IncrementCacheMisses()
+	s.metrics.UpdateTasksCountByStatus(task.ToProtoStatus(), 1)
+	s.metrics.UpdateTasksCountByPriority(task.ToProtoPriority(), 1)
 
-	// Get from database
-	tasks, total, err := s.repo.List(ctx, filter, page, pageSize)
+	s.publishEvent(ctx, events.NewTaskEvent(events.TaskRestored, task, nil))
+
+	s.logger.Info("Task restored successfully", zap.String("id", id))
+	return task, nil
+}
+
+// RestoreAllTasks brings back the user's soft-deleted tasks, limited to those
+// deleted within Config.RestoreAllWindow when it is set, and returns how many
+// were restored.
+func (s *taskService) RestoreAllTasks(ctx context.Context, userID string) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.RestoreAllTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var deletedAfter *time.Time
+	if s.config.RestoreAllWindow > 0 {
+		after := time.Now().Add(-s.config.RestoreAllWindow)
+		deletedAfter = &after
+	}
+
+	restored, err := s.repo.RestoreAllByUser(ctx, userID, deletedAfter)
 	if err != nil {
-		s.logger.Error("Failed to list tasks from repository", zap.Error(err))
+		s.logger.Error("Failed to restore deleted tasks in repository", zap.Error(err))
 		s.metrics.IncrementDatabaseErrors()
 		span.RecordError(err)
-		return nil, 0, status.Error(codes.Internal, "failed to list tasks")
+		return 0, status.Error(codes.Internal, "failed to restore deleted tasks")
 	}
 
-	// Cache the results
-	if err := s.cache.SetTasksList(ctx, cacheKey, tasks, total); err != nil {
-		s.logger.Error("Failed to cache tasks list", zap.Error(err))
+	byStatus := make(map[string]int)
+	byPriority := make(map[string]int)
+	for _, task := range restored {
+		byStatus[task.ToProtoStatus()]++
+		byPriority[task.ToProtoPriority()]++
+		s.publishEvent(ctx, events.NewTaskEvent(events.TaskRestored, task, nil))
+	}
+	for taskStatus, count := range byStatus {
+		s.metrics.UpdateTasksCountByStatus(taskStatus, count)
+	}
+	for priority, count := range byPriority {
+		s.metrics.UpdateTasksCountByPriority(priority, count)
+	}
+	if len(restored) > 0 {
+		if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+			s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Info("Deleted tasks restored",
+		zap.String("user_id", userID),
+		zap.Int("restored", len(restored)),
+	)
+	return int64(len(restored)), nil
+}
+
+// PurgeTask permanently deletes one of the user's soft-deleted tasks. Live
+// tasks have to be deleted first, so they are reported as not found. The
+// task already left the gauges when it was soft-deleted.
+func (s *taskService) PurgeTask(ctx context.Context, id, userID string) error {
+	ctx, span := s.tracer.Start(ctx, "TaskService.PurgeTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	s.logger.Debug("Purging task",
+		zap.String("id", id),
+		zap.String("user_id", userID),
+	)
+
+	if id == "" || userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return status.Error(codes.InvalidArgument, "id and user_id are required")
+	}
+
+	task, err := s.repo.Purge(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("Failed to purge task in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to purge task")
+	}
+
+	if task == nil {
+		s.logger.Warn("Deleted task not found for purge",
+			zap.String("id", id),
+			zap.String("user_id", userID),
+		)
+		return status.Error(codes.NotFound, "deleted task not found")
+	}
+
+	// Remove any lingering cache entry
+	if err := s.cache.DeleteTask(ctx, id); err != nil {
+		s.logger.Error("Failed to delete task from cache", zap.Error(err))
 		s.metrics.IncrementCacheErrors()
 	}
 
-	s.logger.Debug("Tasks listed successfully", 
-		zap.Int("count", len(tasks)),
-		zap.Int64("total", total),
+	// Invalidate user tasks cache
+	if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+		s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	s.logger.Info("Task purged successfully", zap.String("id", id))
+	return nil
+}
+
+// PurgeUserData permanently deletes all of the user's tasks and webhooks,
+// drops everything cached for them and takes their live tasks off the
+// status and priority gauges.
+func (s *taskService) PurgeUserData(ctx context.Context, userID string) (*repository.PurgeResult, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.PurgeUserData")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	result, err := s.repo.PurgeByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to purge user data in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to purge user data")
+	}
+
+	// Tasks already in the trash left the gauges when they were deleted
+	byStatus := make(map[string]int)
+	byPriority := make(map[string]int)
+	for _, task := range result.Tasks {
+		if err := s.cache.DeleteTask(ctx, task.ID); err != nil {
+			s.logger.Error("Failed to delete purged task from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+		if !task.DeletedAt.Valid {
+			byStatus[task.ToProtoStatus()]++
+			byPriority[task.ToProtoPriority()]++
+		}
+	}
+	for taskStatus, count := range byStatus {
+		s.metrics.UpdateTasksCountByStatus(taskStatus, -count)
+	}
+	for priority, count := range byPriority {
+		s.metrics.UpdateTasksCountByPriority(priority, -count)
+	}
+	if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+		s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+	if err := s.cache.DeleteLastSeen(ctx, userID); err != nil {
+		s.logger.Error("Failed to delete last seen from cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	s.logger.Info("User data purged",
+		zap.String("user_id", userID),
+		zap.Int("tasks", len(result.Tasks)),
+		zap.Int64("webhooks", result.WebhooksDeleted),
 	)
-	return tasks, total, nil
+	return result, nil
 }
 
-func (s *taskService) ListTasksByUser(ctx context.Context, userID string, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
-	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasksByUser")
+// BulkSetDueDate sets, or clears when dueDate is nil, the due date of the
+// user's tasks among ids and reports how many were updated. Ids the user
+// does not own are ignored.
+func (s *taskService) BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.BulkSetDueDate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("task.count", len(ids)),
+	)
+
+	if userID == "" || len(ids) == 0 {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Error(codes.InvalidArgument, "user_id and ids are required")
+	}
+	if len(ids) > s.config.MaxBulkUpdate {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Errorf(codes.InvalidArgument, "at most %d tasks can be updated at once", s.config.MaxBulkUpdate)
+	}
+	for _, id := range ids {
+		if uuid.Validate(id) != nil {
+			s.metrics.IncrementValidationErrors()
+			return 0, status.Errorf(codes.InvalidArgument, "invalid task id %q", id)
+		}
+	}
+
+	updated, err := s.repo.BulkSetDueDate(ctx, userID, ids, dueDate)
+	if err != nil {
+		s.logger.Error("Failed to set due dates in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, "failed to set due dates")
+	}
+
+	for _, task := range updated {
+		if err := s.cache.DeleteTask(ctx, task.ID); err != nil {
+			s.logger.Error("Failed to delete task from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+	if len(updated) > 0 {
+		if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+			s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Info("Due dates set in bulk",
+		zap.String("user_id", userID),
+		zap.Int("requested", len(ids)),
+		zap.Int("updated", len(updated)),
+	)
+	return len(updated), nil
+}
+
+// BulkAddTags adds tags to the user's tasks among ids and reports how many
+// tasks gained a tag. Ids the user does not own are ignored.
+func (s *taskService) BulkAddTags(ctx context.Context, userID string, ids, tags []string) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.BulkAddTags")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("task.count", len(ids)),
+	)
+
+	if err := s.validateBulkTags(userID, ids, tags); err != nil {
+		return 0, err
+	}
+	if err := s.validateTags(tags); err != nil {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.repo.BulkAddTags(ctx, userID, ids, normalizeTags(tags, s.config.FoldTagCase), maxTagsPerTask)
+	if errors.Is(err, repository.ErrTooManyTags) {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Errorf(codes.InvalidArgument, "a task can have at most %d tags", maxTagsPerTask)
+	}
+	if err != nil {
+		s.logger.Error("Failed to add tags in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, "failed to add tags")
+	}
+
+	s.invalidateBulkTags(ctx, userID, updated)
+
+	s.logger.Info("Tags added in bulk",
+		zap.String("user_id", userID),
+		zap.Int("requested", len(ids)),
+		zap.Int("updated", len(updated)),
+	)
+	return len(updated), nil
+}
+
+// BulkRemoveTags removes tags from the user's tasks among ids and reports
+// how many tasks lost a tag. Ids the user does not own are ignored.
+func (s *taskService) BulkRemoveTags(ctx context.Context, userID string, ids, tags []string) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.BulkRemoveTags")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("task.count", len(ids)),
+	)
+
+	if err := s.validateBulkTags(userID, ids, tags); err != nil {
+		return 0, err
+	}
+	// Tags outside the allowlist may still be removed
+	if err := validateTagValues(tags); err != nil {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.repo.BulkRemoveTags(ctx, userID, ids, normalizeTags(tags, s.config.FoldTagCase))
+	if err != nil {
+		s.logger.Error("Failed to remove tags in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, "failed to remove tags")
+	}
+
+	s.invalidateBulkTags(ctx, userID, updated)
+
+	s.logger.Info("Tags removed in bulk",
+		zap.String("user_id", userID),
+		zap.Int("requested", len(ids)),
+		zap.Int("updated", len(updated)),
+	)
+	return len(updated), nil
+}
+
+// validateBulkTags checks the arguments BulkAddTags and BulkRemoveTags share.
+func (s *taskService) validateBulkTags(userID string, ids, tags []string) error {
+	if userID == "" || len(ids) == 0 || len(tags) == 0 {
+		s.metrics.IncrementValidationErrors()
+		return status.Error(codes.InvalidArgument, "user_id, ids and tags are required")
+	}
+	if len(ids) > s.config.MaxBulkUpdate {
+		s.metrics.IncrementValidationErrors()
+		return status.Errorf(codes.InvalidArgument, "at most %d tasks can be updated at once", s.config.MaxBulkUpdate)
+	}
+	for _, id := range ids {
+		if uuid.Validate(id) != nil {
+			s.metrics.IncrementValidationErrors()
+			return status.Errorf(codes.InvalidArgument, "invalid task id %q", id)
+		}
+	}
+	return nil
+}
+
+// invalidateBulkTags drops the cached copies of the retagged tasks and the
+// user's cached lists.
+func (s *taskService) invalidateBulkTags(ctx context.Context, userID string, updated []*model.Task) {
+	for _, task := range updated {
+		if err := s.cache.DeleteTask(ctx, task.ID); err != nil {
+			s.logger.Error("Failed to delete task from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+	if len(updated) > 0 {
+		if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+			s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+}
+
+// SnoozeOverdue pushes the due date of each of the user's open overdue
+// tasks forward by by and reports how many were snoozed.
+func (s *taskService) SnoozeOverdue(ctx context.Context, userID string, by time.Duration) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.SnoozeOverdue")
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.String("user.id", userID),
+		attribute.String("snooze.by", by.String()),
+	)
+
+	if userID == "" {
+		return 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if by <= 0 || by > s.config.MaxSnooze {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Errorf(codes.InvalidArgument, "duration must be positive and at most %s", s.config.MaxSnooze)
+	}
+
+	snoozed, err := s.repo.SnoozeOverdue(ctx, userID, time.Now(), by)
+	if err != nil {
+		s.logger.Error("Failed to snooze overdue tasks in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, "failed to snooze overdue tasks")
+	}
+
+	for _, task := range snoozed {
+		if err := s.cache.DeleteTask(ctx, task.ID); err != nil {
+			s.logger.Error("Failed to delete task from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+	if len(snoozed) > 0 {
+		if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+			s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Info("Overdue tasks snoozed",
+		zap.String("user_id", userID),
+		zap.Duration("by", by),
+		zap.Int("snoozed", len(snoozed)),
+	)
+	return len(snoozed), nil
+}
+
+// DeleteCompletedTasks soft-deletes all of the user's done and archived
+// tasks and reports how many were deleted.
+func (s *taskService) DeleteCompletedTasks(ctx context.Context, userID string) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.DeleteCompletedTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	deleted, err := s.repo.DeleteCompletedByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to delete completed tasks in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, "failed to delete completed tasks")
+	}
+
+	byStatus := make(map[string]int)
+	byPriority := make(map[string]int)
+	for _, task := range deleted {
+		if err := s.cache.DeleteTask(ctx, task.ID); err != nil {
+			s.logger.Error("Failed to delete task from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+		byStatus[task.ToProtoStatus()]++
+		byPriority[task.ToProtoPriority()]++
+		s.publishEvent(ctx, events.NewTaskEvent(events.TaskDeleted, task, nil))
+	}
+	for taskStatus, count := range byStatus {
+		s.metrics.UpdateTasksCountByStatus(taskStatus, -count)
+	}
+	for priority, count := range byPriority {
+		s.metrics.UpdateTasksCountByPriority(priority, -count)
+	}
+	if len(deleted) > 0 {
+		if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+			s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Info("Completed tasks deleted",
+		zap.String("user_id", userID),
+		zap.Int("deleted", len(deleted)),
+	)
+	return int64(len(deleted)), nil
+}
+
+func (s *taskService) ListTasks(ctx context.Context, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasks")
+	defer span.End()
+
+	span.SetAttributes(
 		attribute.Int("page", page),
 		attribute.Int("page_size", pageSize),
 	)
 
-	s.logger.Debug("Listing tasks by user", 
-		zap.String("user_id", userID),
+	s.logger.Debug("Listing tasks", 
 		zap.Int("page", page),
 		zap.Int("page_size", pageSize),
 	)
 
-	// Validate pagination
-	if page < 1 {
-		page = 1
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	s.prepareTagFilter(filter)
+	now := time.Now()
+	byUrgency := s.prepareUrgencySort(filter, now)
+
+	// Generate cache key
+	cacheKey := s.generateCacheKey("list", filter, page, pageSize)
+
+	// Try to get from cache
+	if !byUrgency {
+		cachedTasks, cachedTotal, err := s.cache.GetTasksList(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get tasks list from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedTasks != nil {
+			s.metrics.IncrementCacheHits()
+			s.logger.Debug("Tasks list retrieved from cache", 
+				zap.String("key", cacheKey),
+				zap.Int("count", len(cachedTasks)),
+			)
+			return cachedTasks, cachedTotal, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	// Get from database
+	tasks, total, err := s.repo.List(ctx, filter, page, pageSize)
+	if err != nil {
+		s.logger.Error("Failed to list tasks from repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, "failed to list tasks")
+	}
+
+	// Cache the results
+	if byUrgency {
+		s.scoreUrgency(tasks, now)
+	} else if err := s.cache.SetTasksList(ctx, cacheKey, tasks, total); err != nil {
+		s.logger.Error("Failed to cache tasks list", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	s.logger.Debug("Tasks listed successfully", 
+		zap.Int("count", len(tasks)),
+		zap.Int64("total", total),
+	)
+	return tasks, total, nil
+}
+
+// ListTasksCursor returns the page of tasks after cursor, newest first, and
+// the cursor of the following page or "" on the last one. An empty cursor
+// starts from the newest task. Results are not cached since a cursor only
+// ever gets read once.
+func (s *taskService) ListTasksCursor(ctx context.Context, filter *repository.TaskFilter, cursor string, pageSize int) ([]*model.Task, string, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasksCursor")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("page_size", pageSize))
+
+	if filter != nil && filter.SortBy != "" && (filter.SortBy != "created_at" || !filter.SortDesc) {
+		s.metrics.IncrementValidationErrors()
+		return nil, "", status.Error(codes.InvalidArgument, "cursor pagination only supports newest-first ordering")
+	}
+
+	var after *repository.Cursor
+	if cursor != "" {
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			s.metrics.IncrementValidationErrors()
+			return nil, "", status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		after = decoded
+	}
+
+	// Validate page size
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	s.prepareTagFilter(filter)
+	// Fetch one extra row to learn whether another page follows
+	tasks, err := s.repo.ListAfter(ctx, filter, after, pageSize+1)
+	if err != nil {
+		s.logger.Error("Failed to list tasks from repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, "", status.Error(codes.Internal, "failed to list tasks")
+	}
+
+	nextCursor := ""
+	if len(tasks) > pageSize {
+		tasks = tasks[:pageSize]
+		nextCursor = encodeCursor(tasks[pageSize-1])
+	}
+
+	s.logger.Debug("Tasks listed successfully",
+		zap.Int("count", len(tasks)),
+		zap.Bool("has_more", nextCursor != ""),
+	)
+	return tasks, nextCursor, nil
+}
+
+func (s *taskService) ListTasksByUser(ctx context.Context, userID string, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasksByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+	)
+
+	s.logger.Debug("Listing tasks by user", 
+		zap.String("user_id", userID),
+		zap.Int("page", page),
+		zap.Int("page_size", pageSize),
+	)
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	s.prepareTagFilter(filter)
+	now := time.Now()
+	byUrgency := s.prepareUrgencySort(filter, now)
+
+	// Generate cache key
+	namespace, cacheable := s.userCacheNamespace(ctx, userID)
+	cacheable = cacheable && !byUrgency
+	cacheKey := s.generateUserCacheKey(namespace, "list", filter, page, pageSize)
+
+	// Try to get from cache
+	if cacheable {
+		cachedTasks, cachedTotal, err := s.cache.GetTasksList(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get user tasks list from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedTasks != nil {
+			s.metrics.IncrementCacheHits()
+			s.logger.Debug("User tasks list retrieved from cache", 
+				zap.String("key", cacheKey),
+				zap.Int("count", len(cachedTasks)),
+			)
+			return cachedTasks, cachedTotal, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	// Get from database
+	tasks, total, err := s.repo.ListByUser(ctx, userID, filter, page, pageSize)
+	if err != nil {
+		s.logger.Error("Failed to list tasks by user from repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, "failed to list tasks")
+	}
+	if byUrgency {
+		s.scoreUrgency(tasks, now)
+	}
+
+	// Cache the results
+	if cacheable {
+		if err := s.cache.SetTasksList(ctx, cacheKey, tasks, total); err != nil {
+			s.logger.Error("Failed to cache user tasks list", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Debug("Tasks listed by user successfully", 
+		zap.String("user_id", userID),
+		zap.Int("count", len(tasks)),
+		zap.Int64("total", total),
+	)
+	return tasks, total, nil
+}
+
+// GetBoard returns up to perColumn tasks for each status, keyed by status.
+func (s *taskService) GetBoard(ctx context.Context, userID string, perColumn int) (map[string][]*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetBoard")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("per_column", perColumn),
+	)
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Validate column size
+	if perColumn < 1 {
+		perColumn = 10
+	}
+	if perColumn > s.config.MaxBoardPerColumn {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "per_column must not exceed %d", s.config.MaxBoardPerColumn)
+	}
+
+	namespace, cacheable := s.userCacheNamespace(ctx, userID)
+	cacheKey := s.generateBoardCacheKey(namespace, perColumn)
+
+	// Try to get from cache
+	if cacheable {
+		cachedBoard, err := s.cache.GetBoard(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get board from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedBoard != nil {
+			s.metrics.IncrementCacheHits()
+			s.logger.Debug("Board retrieved from cache", zap.String("key", cacheKey))
+			return cachedBoard, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	board := make(map[string][]*model.Task, len(boardStatuses))
+	for _, taskStatus := range boardStatuses {
+		tasks, err := s.repo.ListTopByUserAndStatus(ctx, userID, taskStatus, perColumn, s.config.BoardOrder[taskStatus])
+		if err != nil {
+			s.logger.Error("Failed to list board column from repository", 
+				zap.Error(err),
+				zap.String("status", string(taskStatus)),
+			)
+			s.metrics.IncrementDatabaseErrors()
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to get board")
+		}
+		board[string(taskStatus)] = tasks
+	}
+
+	// Cache the results
+	if cacheable {
+		if err := s.cache.SetBoard(ctx, cacheKey, board); err != nil {
+			s.logger.Error("Failed to cache board", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Debug("Board retrieved successfully", 
+		zap.String("user_id", userID),
+		zap.Int("per_column", perColumn),
+	)
+	return board, nil
+}
+
+// MoveTaskOnBoard moves the user's task to newPosition within the
+// newStatus column, changing both together. Moving a recurring task into
+// done creates its next instance, as UpdateTask does.
+func (s *taskService) MoveTaskOnBoard(ctx context.Context, id, userID, newStatus string, newPosition int) (*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.MoveTaskOnBoard")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	s.logger.Debug("Moving task on board",
+		zap.String("id", id),
+		zap.String("status", newStatus),
+		zap.Int("position", newPosition),
+	)
+
+	if id == "" || userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "id and user_id are required")
+	}
+	validStatuses := []string{"TODO", "IN_PROGRESS", "DONE", "ARCHIVED"}
+	if !contains(validStatuses, strings.ToUpper(newStatus)) {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "status must be one of: %v", validStatuses)
+	}
+	if newPosition < 0 {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "position must not be negative")
+	}
+
+	before, err := s.repo.FindByIDAndUser(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("Failed to get task for board move", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get task")
+	}
+	if before == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+
+	targetStatus := before.FromProtoStatus(strings.ToUpper(newStatus))
+
+	// Completing a recurring task creates its next instance alongside
+	var next *model.Task
+	if s.config.GenerateRecurring && before.Status != model.StatusDone && targetStatus == model.StatusDone {
+		completed := *before
+		completed.Status = targetStatus
+		next = nextRecurringTask(&completed, time.Now())
+	}
+
+	task, err := s.repo.MoveOnBoard(ctx, id, userID, targetStatus, newPosition, next)
+	if err != nil {
+		s.logger.Error("Failed to move task on board in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to move task")
+	}
+	if task == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+
+	// Invalidate user tasks cache
+	if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+		s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	// Update cache
+	if err := s.cache.SetTask(ctx, task); err != nil {
+		s.logger.Error("Failed to update task in cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	if before.Status != task.Status {
+		s.metrics.UpdateTasksCountByStatus(before.ToProtoStatus(), -1)
+		s.metrics.UpdateTasksCountByStatus(task.ToProtoStatus(), 1)
+	}
+
+	eventType := events.TaskUpdated
+	if before.Status != model.StatusDone && task.Status == model.StatusDone {
+		eventType = events.TaskCompleted
+	}
+	s.publishEvent(ctx, events.NewTaskEvent(eventType, task, events.TaskChanges(before, task)))
+
+	if next != nil {
+		if err := s.cache.SetTask(ctx, next); err != nil {
+			s.logger.Error("Failed to cache next recurring task", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+		s.metrics.UpdateTasksCountByStatus(next.ToProtoStatus(), 1)
+		s.metrics.UpdateTasksCountByPriority(next.ToProtoPriority(), 1)
+		s.publishEvent(ctx, events.NewTaskEvent(events.TaskCreated, next, nil))
+	}
+
+	s.logger.Info("Task moved on board successfully",
+		zap.String("id", id),
+		zap.String("status", string(task.Status)),
+		zap.Int("position", task.Position),
+	)
+	return task, nil
+}
+
+// ReorderTask moves the user's task to newPosition within its current status
+// column, shifting the other tasks in the column so their positions stay
+// contiguous.
+func (s *taskService) ReorderTask(ctx context.Context, id, userID string, newPosition int) (*model.Task, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ReorderTask")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	s.logger.Debug("Reordering task",
+		zap.String("id", id),
+		zap.Int("position", newPosition),
+	)
+
+	if id == "" || userID == "" {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "id and user_id are required")
+	}
+	if newPosition < 0 {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "position must not be negative")
+	}
+
+	before, err := s.repo.FindByIDAndUser(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("Failed to get task for reorder", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get task")
+	}
+	if before == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+
+	task, err := s.repo.Reorder(ctx, id, userID, newPosition)
+	if err != nil {
+		s.logger.Error("Failed to reorder task in repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to reorder task")
+	}
+	if task == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+
+	// Invalidate user tasks cache
+	if err := s.cache.InvalidateUserTasks(ctx, userID); err != nil {
+		s.logger.Error("Failed to invalidate user tasks cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	// Update cache
+	if err := s.cache.SetTask(ctx, task); err != nil {
+		s.logger.Error("Failed to update task in cache", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+	}
+
+	s.publishEvent(ctx, events.NewTaskEvent(events.TaskUpdated, task, events.TaskChanges(before, task)))
+
+	s.logger.Info("Task reordered successfully",
+		zap.String("id", id),
+		zap.Int("position", task.Position),
+	)
+	return task, nil
+}
+
+// CountTasks returns how many of the user's tasks match filter. Sorting in
+// filter is ignored.
+func (s *taskService) CountTasks(ctx context.Context, userID string, filter *repository.TaskFilter) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.CountTasks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	s.prepareTagFilter(filter)
+	namespace, cacheable := s.userCacheNamespace(ctx, userID)
+	cacheKey := s.generateCountCacheKey(namespace, filter)
+
+	// Try to get from cache
+	if cacheable {
+		cachedCount, err := s.cache.GetCount(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get task count from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedCount != nil {
+			s.metrics.IncrementCacheHits()
+			return *cachedCount, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	count, err := s.repo.CountByUser(ctx, userID, filter)
+	if err != nil {
+		s.logger.Error("Failed to count tasks from repository", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, "failed to count tasks")
+	}
+
+	// Cache the result
+	if cacheable {
+		if err := s.cache.SetCount(ctx, cacheKey, count); err != nil {
+			s.logger.Error("Failed to cache task count", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Debug("Tasks counted successfully", 
+		zap.String("user_id", userID),
+		zap.Int64("count", count),
+	)
+	return count, nil
+}
+
+// GetTaskMatrix counts the user's tasks per status and priority. Both keys
+// use the proto spelling ("IN_PROGRESS", "HIGH") and every cell is present,
+// zero or not.
+func (s *taskService) GetTaskMatrix(ctx context.Context, userID string) (map[string]map[string]int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetTaskMatrix")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	namespace, cacheable := s.userCacheNamespace(ctx, userID)
+	cacheKey := s.generateMatrixCacheKey(namespace)
+
+	// Try to get from cache
+	if cacheable {
+		cachedMatrix, err := s.cache.GetMatrix(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get task matrix from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedMatrix != nil {
+			s.metrics.IncrementCacheHits()
+			return cachedMatrix, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	counts, err := s.repo.CountByStatusAndPriority(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count tasks by status and priority", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get task matrix")
+	}
+
+	matrix := make(map[string]map[string]int64, len(boardStatuses))
+	for _, taskStatus := range boardStatuses {
+		row := make(map[string]int64, len(matrixPriorities))
+		for _, priority := range matrixPriorities {
+			row[(&model.Task{Priority: priority}).ToProtoPriority()] = counts[taskStatus][priority]
+		}
+		matrix[(&model.Task{Status: taskStatus}).ToProtoStatus()] = row
+	}
+
+	// Cache the result
+	if cacheable {
+		if err := s.cache.SetMatrix(ctx, cacheKey, matrix); err != nil {
+			s.logger.Error("Failed to cache task matrix", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Debug("Task matrix retrieved successfully", zap.String("user_id", userID))
+	return matrix, nil
+}
+
+// GetCompletionTrends counts the user's tasks completed in each
+// granularity period from the one containing from up to to, oldest first
+// and with zero for periods without completions.
+func (s *taskService) GetCompletionTrends(ctx context.Context, userID, granularity string, from, to time.Time) ([]TrendPoint, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetCompletionTrends")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.String("trend.granularity", granularity),
+	)
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	granularity = strings.ToLower(granularity)
+	if granularity == "" {
+		granularity = GranularityDay
+	}
+	if !contains(trendGranularities, granularity) {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "granularity must be one of: %v", trendGranularities)
+	}
+	if !to.After(from) {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+	if tooManyPeriods(from, to, granularity, s.config.MaxTrendPoints) {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "range must cover at most %d periods", s.config.MaxTrendPoints)
+	}
+
+	counts, err := s.repo.CountCompletedByPeriod(ctx, userID, granularity, periodStart(from, granularity), to)
+	if err != nil {
+		s.logger.Error("Failed to count completed tasks by period", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get completion trends")
+	}
+
+	s.logger.Debug("Completion trends computed",
+		zap.String("user_id", userID),
+		zap.String("granularity", granularity),
+	)
+	return fillTrend(counts, from, to, granularity), nil
+}
+
+// GetTaskStats counts the user's tasks per status, keyed by the proto
+// spelling ("IN_PROGRESS") with every status present, zero or not.
+func (s *taskService) GetTaskStats(ctx context.Context, userID string) (map[string]int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetTaskStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	namespace, cacheable := s.userCacheNamespace(ctx, userID)
+	cacheKey := s.generateStatsCacheKey(namespace)
+
+	// Try to get from cache
+	if cacheable {
+		cachedStats, err := s.cache.GetStats(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get task stats from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedStats != nil {
+			s.metrics.IncrementCacheHits()
+			return cachedStats, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	counts, err := s.repo.CountByStatus(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count tasks by status", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get task stats")
+	}
+
+	stats := make(map[string]int64, len(boardStatuses))
+	for _, taskStatus := range boardStatuses {
+		stats[(&model.Task{Status: taskStatus}).ToProtoStatus()] = counts[taskStatus]
+	}
+
+	// Cache the result
+	if cacheable {
+		if err := s.cache.SetStats(ctx, cacheKey, stats); err != nil {
+			s.logger.Error("Failed to cache task stats", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Debug("Task stats retrieved successfully", zap.String("user_id", userID))
+	return stats, nil
+}
+
+// GetUserMetrics summarises the user's tasks: how many there are, how many
+// are done or overdue, and when they last changed.
+func (s *taskService) GetUserMetrics(ctx context.Context, userID string) (*model.TaskMetrics, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetUserMetrics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	namespace, cacheable := s.userCacheNamespace(ctx, userID)
+	cacheKey := s.generateMetricsCacheKey(namespace)
+
+	// Try to get from cache
+	if cacheable {
+		cachedMetrics, err := s.cache.GetMetrics(ctx, cacheKey)
+		if err != nil {
+			s.logger.Error("Failed to get user metrics from cache", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		} else if cachedMetrics != nil {
+			s.metrics.IncrementCacheHits()
+			return cachedMetrics, nil
+		}
+	}
+
+	s.metrics.IncrementCacheMisses()
+
+	metrics, err := s.repo.MetricsByUser(ctx, userID, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to compute user metrics", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get user metrics")
+	}
+
+	// Cache the result
+	if cacheable {
+		if err := s.cache.SetMetrics(ctx, cacheKey, metrics); err != nil {
+			s.logger.Error("Failed to cache user metrics", zap.Error(err))
+			s.metrics.IncrementCacheErrors()
+		}
+	}
+
+	s.logger.Debug("User metrics retrieved successfully", zap.String("user_id", userID))
+	return metrics, nil
+}
+
+// RefreshMetrics sets the task count gauges from the database, so they are
+// right after a restart rather than only once tasks change.
+func (s *taskService) RefreshMetrics(ctx context.Context) error {
+	ctx, span := s.tracer.Start(ctx, "TaskService.RefreshMetrics")
+	defer span.End()
+
+	matrix, err := s.repo.CountAllByStatusAndPriority(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count tasks for metrics", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return err
+	}
+
+	total := 0
+	byPriority := make(map[model.TaskPriority]int)
+	for _, taskStatus := range boardStatuses {
+		statusCount := 0
+		for _, priority := range matrixPriorities {
+			count := int(matrix[taskStatus][priority])
+			statusCount += count
+			byPriority[priority] += count
+		}
+		total += statusCount
+		s.metrics.UpdateTasksCountByStatus((&model.Task{Status: taskStatus}).ToProtoStatus(), statusCount)
+	}
+	for _, priority := range matrixPriorities {
+		s.metrics.UpdateTasksCountByPriority((&model.Task{Priority: priority}).ToProtoPriority(), byPriority[priority])
+	}
+	s.metrics.UpdateTasksCount(total)
+
+	s.logger.Debug("Task metrics refreshed", zap.Int("total", total))
+	return nil
+}
+
+// ListTasksNeedingAttention ranks the user's open tasks that are overdue,
+// due soon or high priority and untouched, and returns the top limit.
+func (s *taskService) ListTasksNeedingAttention(ctx context.Context, userID string, limit int) ([]AttentionTask, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasksNeedingAttention")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("limit", limit),
+	)
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Validate limit
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > s.config.Attention.MaxResults {
+		s.metrics.IncrementValidationErrors()
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not exceed %d", s.config.Attention.MaxResults)
+	}
+
+	now := time.Now()
+	candidates, err := s.repo.ListAttentionCandidates(ctx, userID,
+		now.Add(s.config.Attention.DueSoonWindow),
+		now.Add(-s.config.Attention.StaleAfter),
+	)
+	if err != nil {
+		s.logger.Error("Failed to list tasks needing attention", zap.Error(err))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list tasks needing attention")
+	}
+
+	ranked := s.config.Attention.rankAttention(candidates, now, limit)
+
+	s.logger.Debug("Tasks needing attention listed",
+		zap.String("user_id", userID),
+		zap.Int("candidates", len(candidates)),
+		zap.Int("returned", len(ranked)),
+	)
+	return ranked, nil
+}
+
+func (s *taskService) MarkAllSeen(ctx context.Context, userID string) (time.Time, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.MarkAllSeen")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	if pageSize < 1 {
-		pageSize = 10
+
+	seenAt := time.Now().UTC()
+	err := s.cache.SetLastSeen(ctx, userID, seenAt)
+	if errors.Is(err, cache.ErrLastSeenUnavailable) {
+		s.logger.Warn("Cannot mark tasks as seen without Redis", zap.String("user_id", userID))
+		return time.Time{}, status.Error(codes.Unavailable, "marking tasks as seen is temporarily unavailable")
 	}
-	if pageSize > 100 {
-		pageSize = 100
+	if err != nil {
+		s.logger.Error("Failed to store last seen timestamp", zap.Error(err))
+		s.metrics.IncrementCacheErrors()
+		span.RecordError(err)
+		return time.Time{}, status.Error(codes.Internal, "failed to mark tasks as seen")
 	}
 
-	// Generate cache key
-	cacheKey := s.generateUserCacheKey(userID, "list", filter, page, pageSize)
+	s.logger.Debug("Tasks marked as seen", 
+		zap.String("user_id", userID),
+		zap.Time("seen_at", seenAt),
+	)
+	return seenAt, nil
+}
 
-	// Try to get from cache
-	cachedTasks, cachedTotal, err := s.cache.GetTasksList(ctx, cacheKey)
+func (s *taskService) GetLastSeen(ctx context.Context, userID string) (*time.Time, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetLastSeen")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	seenAt, err := s.cache.GetLastSeen(ctx, userID)
 	if err != nil {
-		s.logger.Error("Failed to get user tasks list from cache", zap.Error(err))
+		s.logger.Error("Failed to get last seen timestamp", zap.Error(err))
 		s.metrics.IncrementCacheErrors()
-	} else if cachedTasks != nil {
-		s.metrics.IncrementCacheHits()
-		s.logger.Debug("User tasks list retrieved from cache", 
-			zap.String("key", cacheKey),
-			zap.Int("count", len(cachedTasks)),
-		)
-		return cachedTasks, cachedTotal, nil
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get last seen timestamp")
 	}
 
-	s.metrics.IncrementCacheMisses()
+	return seenAt, nil
+}
 
-	// Get from database
-	tasks, total, err := s.repo.ListByUser(ctx, userID, filter, page, pageSize)
-	if err != nil {
-		s.logger.Error("Failed to list tasks by user from repository", zap.Error(err))
-		s.metrics.IncrementDatabaseErrors()
-		span.RecordError(err)
-		return nil, 0, status.Error(codes.Internal, "failed to list tasks")
+// GetUnreadCount counts the user's tasks updated since they last marked
+// their tasks as seen, matching the is_new flags of the task list: before
+// the first MarkAllSeen every task is unread. The count is cached like
+// CountTasks, and a new MarkAllSeen moves it to a fresh key.
+func (s *taskService) GetUnreadCount(ctx context.Context, userID string) (int64, *time.Time, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.GetUnreadCount")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return 0, nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 
-	// Cache the results
-	if err := s.cache.SetTasksList(ctx, cacheKey, tasks, total); err != nil {
-		s.logger.Error("Failed to cache user tasks list", zap.Error(err))
-		s.metrics.IncrementCacheErrors()
+	lastSeen, err := s.GetLastSeen(ctx, userID)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	s.logger.Debug("Tasks listed by user successfully", 
-		zap.String("user_id", userID),
-		zap.Int("count", len(tasks)),
-		zap.Int64("total", total),
-	)
-	return tasks, total, nil
+	count, err := s.CountTasks(ctx, userID, &repository.TaskFilter{UpdatedAfter: lastSeen})
+	if err != nil {
+		return 0, nil, err
+	}
+	return count, lastSeen, nil
 }
 
 func (s *taskService) validateCreateTaskRequest(req *CreateTaskRequest) error {
@@ -612,9 +2344,102 @@ func (s *taskService) validateCreateTaskRequest(req *CreateTaskRequest) error {
 			return fmt.Errorf("priority must be one of: %v", validPriorities)
 		}
 	}
+	if req.RecurrenceRule != "" {
+		if _, err := parseRecurrenceRule(req.RecurrenceRule); err != nil {
+			return err
+		}
+	}
+	return s.validateTags(req.Tags)
+}
+
+const (
+	maxTagsPerTask = 20
+	maxTagLength   = 50
+)
+
+func (s *taskService) validateTags(tags []string) error {
+	if len(tags) > maxTagsPerTask {
+		return fmt.Errorf("a task can have at most %d tags", maxTagsPerTask)
+	}
+	if err := validateTagValues(tags); err != nil {
+		return err
+	}
+	if len(s.config.TagAllowlist) == 0 {
+		return nil
+	}
+	allowed := normalizeTags(s.config.TagAllowlist, s.config.FoldTagCase)
+	for _, tag := range normalizeTags(tags, s.config.FoldTagCase) {
+		if !slices.Contains(allowed, tag) {
+			return fmt.Errorf("tag %q is not allowed", tag)
+		}
+	}
 	return nil
 }
 
+// validateTagValues checks each tag on its own, not the set as a whole.
+func validateTagValues(tags []string) error {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return errors.New("tags must not be empty")
+		}
+		if len(tag) > maxTagLength {
+			return fmt.Errorf("tags must be at most %d characters", maxTagLength)
+		}
+	}
+	return nil
+}
+
+// normalizeTags trims tags, lowercases them when foldCase is set and drops
+// duplicates, keeping the first occurrence's position. Duplicates are found
+// after folding, so " Work" and "work" collapse into one tag.
+func normalizeTags(tags []string, foldCase bool) model.Tags {
+	normalized := make(model.Tags, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if foldCase {
+			tag = strings.ToLower(tag)
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+// prepareTagFilter makes the filter match tags the way they are stored:
+// lowercased, or exactly as written when tag case is not folded.
+func (s *taskService) prepareTagFilter(filter *repository.TaskFilter) {
+	if filter != nil {
+		filter.CaseSensitiveTags = !s.config.FoldTagCase
+	}
+}
+
+// newTaskFromRequest builds the task model for a validated create request,
+// defaulting status and priority when they are not set.
+func newTaskFromRequest(req *CreateTaskRequest, foldTagCase bool) *model.Task {
+	createdBy := req.UserID
+	task := &model.Task{
+		UserID:      req.UserID,
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      model.StatusTodo,
+		Priority:    model.PriorityMedium,
+		DueDate:     req.DueDate,
+		Tags:        normalizeTags(req.Tags, foldTagCase),
+		UpdatedBy:   &createdBy,
+	}
+	// Validation already rejected malformed rules
+	task.RecurrenceRule, _ = normalizeRecurrenceRule(req.RecurrenceRule)
+	if req.Status != "" {
+		task.SetStatus(task.FromProtoStatus(req.Status), time.Now())
+	}
+	if req.Priority != "" {
+		task.Priority = task.FromProtoPriority(req.Priority)
+	}
+	return task
+}
+
 func (s *taskService) generateCacheKey(prefix string, filter *repository.TaskFilter, page, pageSize int) string {
 	var parts []string
 	parts = append(parts, prefix)
@@ -629,6 +2454,7 @@ func (s *taskService) generateCacheKey(prefix string, filter *repository.TaskFil
 		if filter.UserID != nil && *filter.UserID != "" {
 			parts = append(parts, fmt.Sprintf("user:%s", *filter.UserID))
 		}
+		parts = append(parts, filterKeyParts(filter)...)
 		if filter.SortBy != "" {
 			sortDir := "asc"
 			if filter.SortDesc {
@@ -644,9 +2470,11 @@ func (s *taskService) generateCacheKey(prefix string, filter *repository.TaskFil
 	return strings.Join(parts, ":")
 }
 
-func (s *taskService) generateUserCacheKey(userID, prefix string, filter *repository.TaskFilter, page, pageSize int) string {
+// generateUserCacheKey keys one page of the user's task list by filter,
+// sort and page.
+func (s *taskService) generateUserCacheKey(namespace, prefix string, filter *repository.TaskFilter, page, pageSize int) string {
 	var parts []string
-	parts = append(parts, prefix, fmt.Sprintf("user:%s", userID))
+	parts = append(parts, namespace, prefix)
 	
 	if filter != nil {
 		if filter.Status != nil && *filter.Status != "" {
@@ -655,6 +2483,7 @@ func (s *taskService) generateUserCacheKey(userID, prefix string, filter *reposi
 		if filter.Priority != nil && *filter.Priority != "" {
 			parts = append(parts, fmt.Sprintf("priority:%s", *filter.Priority))
 		}
+		parts = append(parts, filterKeyParts(filter)...)
 		if filter.SortBy != "" {
 			sortDir := "asc"
 			if filter.SortDesc {
@@ -670,6 +2499,290 @@ func (s *taskService) generateUserCacheKey(userID, prefix string, filter *reposi
 	return strings.Join(parts, ":")
 }
 
+// publishEvent hands a committed change to the event publisher. Like cache
+// writes, a failure is logged but never fails the operation.
+func (s *taskService) publishEvent(ctx context.Context, event events.Event) {
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		s.logger.Error("Failed to publish task event", 
+			zap.Error(err),
+			zap.String("type", string(event.Type)),
+			zap.String("task_id", event.TaskID),
+		)
+	}
+}
+
+// userCacheNamespace is the prefix for every per-user cache key: listings,
+// board, counts, matrix, stats and metrics. It carries the user's cache
+// version, so InvalidateUserTasks clears them all by bumping the version.
+// When the version cannot be read the caller should bypass the cache, since
+// the namespace may be stale.
+func (s *taskService) userCacheNamespace(ctx context.Context, userID string) (string, bool) {
+	version, err := s.cache.UserCacheVersion(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user cache version", 
+			zap.Error(err),
+			zap.String("user_id", userID),
+		)
+		s.metrics.IncrementCacheErrors()
+		return "", false
+	}
+	return cache.UserNamespace(userID, version), true
+}
+
+func (s *taskService) generateBoardCacheKey(namespace string, perColumn int) string {
+	return fmt.Sprintf("%s:board:%d", namespace, perColumn)
+}
+
+func (s *taskService) generateMatrixCacheKey(namespace string) string {
+	return namespace + ":matrix"
+}
+
+func (s *taskService) generateStatsCacheKey(namespace string) string {
+	return namespace + ":stats"
+}
+
+func (s *taskService) generateMetricsCacheKey(namespace string) string {
+	return namespace + ":metrics"
+}
+
+// generateCountCacheKey leaves out sorting and paging, which do not change a
+// count, and lowercases status and priority so either case shares an entry.
+func (s *taskService) generateCountCacheKey(namespace string, filter *repository.TaskFilter) string {
+	key := namespace + ":count"
+	if filter != nil {
+		if filter.Status != nil && *filter.Status != "" {
+			key += fmt.Sprintf(":status:%s", strings.ToLower(*filter.Status))
+		}
+		if filter.Priority != nil && *filter.Priority != "" {
+			key += fmt.Sprintf(":priority:%s", strings.ToLower(*filter.Priority))
+		}
+		for _, part := range filterKeyParts(filter) {
+			key += ":" + part
+		}
+	}
+	return key
+}
+
+// filterKeyParts keeps cached listings for different due-date windows,
+// search terms, tag sets and trash visibility apart. Search is
+// case-insensitive and so are tags unless they are matched exactly, so their
+// keys are too; tags are sorted since their order does not matter.
+func filterKeyParts(filter *repository.TaskFilter) []string {
+	var parts []string
+	if filter.Search != nil && *filter.Search != "" {
+		parts = append(parts, fmt.Sprintf("search:%s", strings.ToLower(*filter.Search)))
+	}
+	if filter.DueAfter != nil {
+		parts = append(parts, fmt.Sprintf("due_after:%d", filter.DueAfter.Unix()))
+	}
+	if len(filter.Tags) > 0 {
+		tags := normalizeTags(filter.Tags, !filter.CaseSensitiveTags)
+		slices.Sort(tags)
+		parts = append(parts, fmt.Sprintf("tags:%s", strings.Join(tags, ",")))
+	}
+	if filter.IncludeDeleted {
+		parts = append(parts, "include_deleted")
+	}
+	if filter.IncludeArchived {
+		parts = append(parts, "include_archived")
+	}
+	if filter.DueBefore != nil {
+		parts = append(parts, fmt.Sprintf("due_before:%d", filter.DueBefore.Unix()))
+	}
+	if filter.UpdatedAfter != nil {
+		parts = append(parts, fmt.Sprintf("updated_after:%d", filter.UpdatedAfter.UnixNano()))
+	}
+	if filter.CompletedAfter != nil {
+		parts = append(parts, fmt.Sprintf("completed_after:%d", filter.CompletedAfter.Unix()))
+	}
+	if filter.CompletedBefore != nil {
+		parts = append(parts, fmt.Sprintf("completed_before:%d", filter.CompletedBefore.Unix()))
+	}
+	return parts
+}
+
+// ListTasksModifiedBy lists the tasks userID modified last, across all
+// owners. It is an audit query and is not cached.
+func (s *taskService) ListTasksModifiedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListTasksModifiedBy")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+	)
+
+	if userID == "" {
+		return nil, 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	tasks, total, err := s.repo.ListByUpdatedBy(ctx, userID, page, pageSize)
+	if err != nil {
+		s.logger.Error("Failed to list tasks by last modifier", zap.Error(err), zap.String("user_id", userID))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, "failed to list tasks")
+	}
+
+	return tasks, total, nil
+}
+
+// ListOverdueTasks lists the user's open tasks past their due date, most
+// overdue first. It skips the cache: what counts as overdue changes with
+// the clock, not just with writes.
+func (s *taskService) ListOverdueTasks(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListOverdueTasks")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+	)
+
+	if userID == "" {
+		return nil, 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	tasks, total, err := s.repo.ListOverdue(ctx, userID, time.Now(), page, pageSize)
+	if err != nil {
+		s.logger.Error("Failed to list overdue tasks", zap.Error(err), zap.String("user_id", userID))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, "failed to list overdue tasks")
+	}
+
+	return tasks, total, nil
+}
+
+// ListDeletedTasks lists the user's trash, most recently deleted first. It
+// skips the cache, as trashed tasks are rarely listed.
+func (s *taskService) ListDeletedTasks(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.ListDeletedTasks")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+	)
+
+	if userID == "" {
+		return nil, 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	tasks, total, err := s.repo.ListDeleted(ctx, userID, page, pageSize)
+	if err != nil {
+		s.logger.Error("Failed to list deleted tasks", zap.Error(err), zap.String("user_id", userID))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, "failed to list deleted tasks")
+	}
+
+	return tasks, total, nil
+}
+
+// SearchTasks matches query against the user's task titles, descriptions
+// and tags, best matches first.
+func (s *taskService) SearchTasks(ctx context.Context, userID, query string, page, pageSize int) ([]*model.Task, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "TaskService.SearchTasks")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", userID),
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+	)
+
+	if userID == "" {
+		return nil, 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		s.metrics.IncrementValidationErrors()
+		return nil, 0, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > s.config.MaxSearchPageSize {
+		pageSize = s.config.MaxSearchPageSize
+	}
+
+	tasks, total, err := s.repo.Search(ctx, userID, query, page, pageSize)
+	if err != nil {
+		s.logger.Error("Failed to search tasks", zap.Error(err), zap.String("user_id", userID))
+		s.metrics.IncrementDatabaseErrors()
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, "failed to search tasks")
+	}
+
+	return tasks, total, nil
+}
+
+// PreviewRecurrence lists the next count occurrences of rule after from,
+// without touching any task.
+func (s *taskService) PreviewRecurrence(ctx context.Context, rule string, from time.Time, count int) ([]time.Time, error) {
+	_, span := s.tracer.Start(ctx, "TaskService.PreviewRecurrence")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("recurrence.rule", rule),
+		attribute.Int("recurrence.count", count),
+	)
+
+	if count < 1 || count > s.config.MaxRecurrencePreview {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", s.config.MaxRecurrencePreview)
+	}
+
+	occurrences, err := PreviewRecurrence(rule, from, count)
+	if err != nil {
+		s.logger.Debug("Invalid recurrence rule", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return occurrences, nil
+}
+
 func contains(slice []string, item string) bool {
 	return slices.Contains(slice, item)
 }
\ No newline at end of file
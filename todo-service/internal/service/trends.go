@@ -0,0 +1,70 @@
+package service
+
+import (
+	"time"
+)
+
+// Granularities a completion trend can be bucketed by.
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+)
+
+var trendGranularities = []string{GranularityDay, GranularityWeek, GranularityMonth}
+
+// TrendPoint counts the tasks completed in the period starting at Start.
+type TrendPoint struct {
+	Start     time.Time
+	Completed int64
+}
+
+// periodStart returns the UTC start of the period containing t, matching
+// Postgres date_trunc: weeks start on Monday.
+func periodStart(t time.Time, granularity string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch granularity {
+	case GranularityWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case GranularityMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+// nextPeriod returns the start of the period after the one starting at start.
+func nextPeriod(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// tooManyPeriods reports whether more than limit periods cover [from, to),
+// without walking a huge range to the end.
+func tooManyPeriods(from, to time.Time, granularity string, limit int) bool {
+	n := 0
+	for start := periodStart(from, granularity); start.Before(to); start = nextPeriod(start, granularity) {
+		n++
+		if n > limit {
+			return true
+		}
+	}
+	return false
+}
+
+// fillTrend lays counts out as one point per period covering [from, to),
+// oldest first, with zero for periods that have no completions.
+func fillTrend(counts map[time.Time]int64, from, to time.Time, granularity string) []TrendPoint {
+	var points []TrendPoint
+	for start := periodStart(from, granularity); start.Before(to); start = nextPeriod(start, granularity) {
+		points = append(points, TrendPoint{Start: start, Completed: counts[start]})
+	}
+	return points
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+)
+
+// prepareUrgencySort arms filter for the "urgency" sort as of now and
+// reports whether the sort applies. Urgency-sorted lists are not cached,
+// since their scores drift with time.
+func (s *taskService) prepareUrgencySort(filter *repository.TaskFilter, now time.Time) bool {
+	if filter == nil || !strings.EqualFold(filter.SortBy, "urgency") {
+		return false
+	}
+
+	weights := s.config.Urgency
+	filter.Urgency = &weights
+	filter.UrgencyAt = now
+	return true
+}
+
+// scoreUrgency sets the urgency score the tasks were sorted by.
+func (s *taskService) scoreUrgency(tasks []*model.Task, now time.Time) {
+	for _, task := range tasks {
+		score := s.config.Urgency.Score(task, now)
+		task.UrgencyScore = &score
+	}
+}
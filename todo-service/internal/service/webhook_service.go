@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, userID, rawURL string) (*model.Webhook, error)
+	GetWebhook(ctx context.Context, id, userID string) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, id, userID string) error
+	ListWebhooks(ctx context.Context, userID string) ([]*model.Webhook, error)
+}
+
+type UpdateWebhookRequest struct {
+	ID     string
+	UserID string
+	URL    *string
+	Active *bool
+}
+
+type webhookService struct {
+	repo   repository.WebhookRepository
+	logger *zap.Logger
+	tracer trace.Tracer
+}
+
+func NewWebhookService(repo repository.WebhookRepository) WebhookService {
+	return &webhookService{
+		repo:   repo,
+		logger: zap.L().Named("webhook_service"),
+		tracer: otel.Tracer("webhook-service"),
+	}
+}
+
+func (s *webhookService) CreateWebhook(ctx context.Context, userID, rawURL string) (*model.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.CreateWebhook")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := validateWebhookURL(rawURL); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate webhook secret", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	webhook := &model.Webhook{
+		UserID: userID,
+		URL:    rawURL,
+		Secret: secret,
+		Active: true,
+	}
+
+	created, err := s.repo.Create(ctx, webhook)
+	if err != nil {
+		s.logger.Error("Failed to create webhook in repository", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	s.logger.Info("Webhook created successfully",
+		zap.String("id", created.ID),
+		zap.String("user_id", userID),
+	)
+	return created, nil
+}
+
+func (s *webhookService) GetWebhook(ctx context.Context, id, userID string) (*model.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.GetWebhook")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("webhook.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	webhook, err := s.repo.FindByIDAndUser(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("Failed to get webhook from repository", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get webhook")
+	}
+
+	if webhook == nil {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	return webhook, nil
+}
+
+func (s *webhookService) UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*model.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.UpdateWebhook")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("webhook.id", req.ID),
+		attribute.String("user.id", req.UserID),
+	)
+
+	webhook, err := s.GetWebhook(ctx, req.ID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		webhook.URL = *req.URL
+	}
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+
+	updated, err := s.repo.Update(ctx, webhook)
+	if err != nil {
+		s.logger.Error("Failed to update webhook in repository", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to update webhook")
+	}
+
+	s.logger.Info("Webhook updated successfully", zap.String("id", req.ID))
+	return updated, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, id, userID string) error {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.DeleteWebhook")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("webhook.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	if err := s.repo.DeleteByUser(ctx, id, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "webhook not found")
+		}
+		s.logger.Error("Failed to delete webhook from repository", zap.Error(err))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to delete webhook")
+	}
+
+	s.logger.Info("Webhook deleted successfully", zap.String("id", id))
+	return nil
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.ListWebhooks")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	webhooks, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list webhooks from repository", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list webhooks")
+	}
+
+	return webhooks, nil
+}
+
+func validateWebhookURL(rawURL string) error {
+	if rawURL == "" {
+		return errors.New("url is required")
+	}
+	if len(rawURL) > 2048 {
+		return errors.New("url must be less than 2048 characters")
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return errors.New("url must be absolute")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.New("url must use http or https")
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/events"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+)
+
+type Config struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	Timeout        time.Duration
+	// AllowPrivateAddresses lets deliveries reach loopback, private,
+	// link-local and unspecified addresses. Leave it off in production so
+	// webhooks can't be pointed at internal services.
+	AllowPrivateAddresses bool
+}
+
+// ErrBlockedAddress is returned for deliveries whose host resolves to an
+// address webhooks may not reach.
+var ErrBlockedAddress = errors.New("webhook address is not allowed")
+
+// Sign returns the HMAC-SHA256 signature of payload sent in SignatureHeader,
+// formatted as "sha256=<hex>".
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher is an events.EventPublisher that POSTs each event to the
+// owning user's active webhooks.
+type Dispatcher struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	config Config
+	logger *zap.Logger
+}
+
+func NewDispatcher(repo repository.WebhookRepository, config Config) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		client: newHTTPClient(config),
+		config: config,
+		logger: zap.L().Named("webhook_dispatcher"),
+	}
+}
+
+// newHTTPClient returns the client deliveries are sent with. It never
+// follows redirects, and unless config allows it, refuses to connect to
+// private addresses. The check runs on the address each connection
+// actually dials, after DNS resolution, so a hostname can't be pointed at
+// an internal service after the webhook was registered.
+func newHTTPClient(config Config) *http.Client {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateAddresses {
+		dialer.Control = refusePrivateAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be the address checked instead of the receiver
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   config.Timeout,
+		Transport: transport,
+		// A redirect is answered as a failed delivery rather than followed
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// refusePrivateAddress is a net.Dialer Control function that rejects
+// loopback, private, link-local and unspecified addresses.
+func refusePrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+	ip = ip.Unmap()
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+	}
+	return nil
+}
+
+// Publish looks up the user's webhooks and delivers to each in the
+// background so slow receivers never hold up the task operation.
+func (d *Dispatcher) Publish(ctx context.Context, event events.Event) error {
+	webhooks, err := d.repo.ListActiveByUser(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	deliveryCtx := context.WithoutCancel(ctx)
+	for _, webhook := range webhooks {
+		go d.Deliver(deliveryCtx, webhook, event.Type, payload)
+	}
+	return nil
+}
+
+// Deliver sends payload to webhook, retrying with exponential backoff. Once
+// retries are exhausted the event is recorded as a dead letter.
+func (d *Dispatcher) Deliver(ctx context.Context, webhook *model.Webhook, eventType events.EventType, payload []byte) error {
+	attempts := d.config.MaxRetries + 1
+	backoff := d.config.InitialBackoff
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		lastErr = d.send(ctx, webhook, eventType, payload)
+		if lastErr == nil {
+			d.logger.Debug("Webhook delivered",
+				zap.String("webhook_id", webhook.ID),
+				zap.String("event_type", string(eventType)),
+				zap.Int("attempt", attempt),
+			)
+			return nil
+		}
+
+		d.logger.Warn("Webhook delivery attempt failed",
+			zap.Error(lastErr),
+			zap.String("webhook_id", webhook.ID),
+			zap.String("event_type", string(eventType)),
+			zap.Int("attempt", attempt),
+			zap.Int("max_attempts", attempts),
+		)
+
+		if attempt < attempts {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			backoff *= 2
+		}
+	}
+
+	deadLetter := &model.WebhookDeadLetter{
+		WebhookID: webhook.ID,
+		EventType: string(eventType),
+		Payload:   string(payload),
+		Attempts:  attempts,
+		LastError: lastErr.Error(),
+	}
+	if err := d.repo.CreateDeadLetter(ctx, deadLetter); err != nil {
+		d.logger.Error("Failed to record webhook dead letter", zap.Error(err))
+	}
+
+	return lastErr
+}
+
+func (d *Dispatcher) send(ctx context.Context, webhook *model.Webhook, eventType events.EventType, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(eventType))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
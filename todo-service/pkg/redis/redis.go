@@ -21,12 +21,19 @@ type Config struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	CacheTTL     time.Duration
+
+	// TaskCacheTTL and ListCacheTTL expire single tasks and task lists.
+	// Zero falls back to CacheTTL.
+	TaskCacheTTL time.Duration
+	ListCacheTTL time.Duration
 }
 
 type RedisClient struct {
-	client    *redis.Client
-	logger    *zap.Logger
-	cacheTTL  time.Duration
+	client       *redis.Client
+	logger       *zap.Logger
+	cacheTTL     time.Duration
+	taskCacheTTL time.Duration
+	listCacheTTL time.Duration
 }
 
 func NewRedisClient(cfg Config) (*RedisClient, error) {
@@ -59,12 +66,32 @@ func NewRedisClient(cfg Config) (*RedisClient, error) {
 	)
 
 	return &RedisClient{
-		client:   rdb,
-		logger:   logger,
-		cacheTTL: cfg.CacheTTL,
+		client:       rdb,
+		logger:       logger,
+		cacheTTL:     cfg.CacheTTL,
+		taskCacheTTL: ttlOrDefault(cfg.TaskCacheTTL, cfg.CacheTTL),
+		listCacheTTL: ttlOrDefault(cfg.ListCacheTTL, cfg.CacheTTL),
 	}, nil
 }
 
+func ttlOrDefault(ttl, fallback time.Duration) time.Duration {
+	if ttl > 0 {
+		return ttl
+	}
+	return fallback
+}
+
+// TaskCacheTTL is how long a single cached task lives.
+func (r *RedisClient) TaskCacheTTL() time.Duration {
+	return r.taskCacheTTL
+}
+
+// ListCacheTTL is how long a cached task list lives. Lists go stale sooner
+// than single tasks, so this is usually shorter.
+func (r *RedisClient) ListCacheTTL() time.Duration {
+	return r.listCacheTTL
+}
+
 func (r *RedisClient) Set(ctx context.Context, key string, value any) error {
 	r.logger.Debug("Setting cache key", zap.String("key", key))
 	
@@ -77,6 +104,32 @@ func (r *RedisClient) Set(ctx context.Context, key string, value any) error {
 	return nil
 }
 
+// SetPersistent stores a value without applying the cache TTL.
+func (r *RedisClient) SetPersistent(ctx context.Context, key string, value any) error {
+	r.logger.Debug("Setting persistent key", zap.String("key", key))
+	
+	err := r.client.Set(ctx, key, value, 0).Err()
+	if err != nil {
+		r.logger.Error("Failed to set persistent key", zap.Error(err), zap.String("key", key))
+		return err
+	}
+	
+	return nil
+}
+
+// SetWithTTL stores a value with an explicit expiry instead of the cache TTL.
+func (r *RedisClient) SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) error {
+	r.logger.Debug("Setting cache key with TTL", zap.String("key", key), zap.Duration("ttl", ttl))
+	
+	err := r.client.Set(ctx, key, value, ttl).Err()
+	if err != nil {
+		r.logger.Error("Failed to set cache key", zap.Error(err), zap.String("key", key))
+		return err
+	}
+	
+	return nil
+}
+
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
 	r.logger.Debug("Getting cache key", zap.String("key", key))
 	
@@ -94,6 +147,18 @@ func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
 	return value, nil
 }
 
+// Incr atomically adds one to the counter at key, creating it at zero
+// first, and returns the new value. Counters never expire.
+func (r *RedisClient) Incr(ctx context.Context, key string) (int64, error) {
+	value, err := r.client.Incr(ctx, key).Result()
+	if err != nil {
+		r.logger.Error("Failed to increment cache key", zap.Error(err), zap.String("key", key))
+		return 0, err
+	}
+
+	return value, nil
+}
+
 func (r *RedisClient) Delete(ctx context.Context, key string) error {
 	r.logger.Debug("Deleting cache key", zap.String("key", key))
 	
@@ -127,6 +192,59 @@ func (r *RedisClient) DeletePattern(ctx context.Context, pattern string) error {
 	return nil
 }
 
+// ScanKeys returns all keys matching pattern, iterating with SCAN so the
+// server is not blocked.
+func (r *RedisClient) ScanKeys(ctx context.Context, pattern string) ([]string, error) {
+	var keys []string
+	iter := r.client.Scan(ctx, 0, pattern, 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		r.logger.Error("Failed to scan cache keys", zap.Error(err), zap.String("pattern", pattern))
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// MGet fetches several keys in one round trip. The result lines up with
+// keys, with "" for each key that is not set.
+func (r *RedisClient) MGet(ctx context.Context, keys ...string) ([]string, error) {
+	r.logger.Debug("Getting cache keys", zap.Int("count", len(keys)))
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		r.logger.Error("Failed to get cache keys", zap.Error(err), zap.Int("count", len(keys)))
+		return nil, err
+	}
+
+	result := make([]string, len(values))
+	for i, value := range values {
+		if str, ok := value.(string); ok {
+			result[i] = str
+		}
+	}
+	return result, nil
+}
+
+func (r *RedisClient) Publish(ctx context.Context, channel string, payload []byte) error {
+	r.logger.Debug("Publishing message", zap.String("channel", channel))
+	
+	if err := r.client.Publish(ctx, channel, payload).Err(); err != nil {
+		r.logger.Error("Failed to publish message", zap.Error(err), zap.String("channel", channel))
+		return err
+	}
+	
+	return nil
+}
+
+// Ping checks that Redis is reachable.
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
 func (r *RedisClient) Close() error {
 	r.logger.Info("Closing Redis connection")
 	return r.client.Close()
@@ -24,10 +24,43 @@ const _ = grpc.SupportPackageIsVersion7
 type TodoServiceClient interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
+	GetTaskByUser(ctx context.Context, in *GetTaskByUserRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
+	GetTasksByIDs(ctx context.Context, in *GetTasksByIDsRequest, opts ...grpc.CallOption) (*GetTasksByIDsResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error)
+	ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*ArchiveTaskResponse, error)
+	UnarchiveTask(ctx context.Context, in *UnarchiveTaskRequest, opts ...grpc.CallOption) (*UnarchiveTaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	DeleteTaskByUser(ctx context.Context, in *DeleteTaskByUserRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error)
+	BulkSetDueDate(ctx context.Context, in *BulkSetDueDateRequest, opts ...grpc.CallOption) (*BulkSetDueDateResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	ListTasksByUser(ctx context.Context, in *ListTasksByUserRequest, opts ...grpc.CallOption) (*ListTasksByUserResponse, error)
+	ListTasksModifiedBy(ctx context.Context, in *ListTasksModifiedByRequest, opts ...grpc.CallOption) (*ListTasksModifiedByResponse, error)
+	MarkAllSeen(ctx context.Context, in *MarkAllSeenRequest, opts ...grpc.CallOption) (*MarkAllSeenResponse, error)
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
+	GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardResponse, error)
+	CountTasks(ctx context.Context, in *CountTasksRequest, opts ...grpc.CallOption) (*CountTasksResponse, error)
+	PreviewRecurrence(ctx context.Context, in *PreviewRecurrenceRequest, opts ...grpc.CallOption) (*PreviewRecurrenceResponse, error)
+	CreateTasksBatch(ctx context.Context, in *CreateTasksBatchRequest, opts ...grpc.CallOption) (*CreateTasksBatchResponse, error)
+	PurgeUserData(ctx context.Context, in *PurgeUserDataRequest, opts ...grpc.CallOption) (*PurgeUserDataResponse, error)
+	GetHealthDetails(ctx context.Context, in *GetTodoHealthDetailsRequest, opts ...grpc.CallOption) (*GetTodoHealthDetailsResponse, error)
+	SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error)
+	GetTaskMatrix(ctx context.Context, in *GetTaskMatrixRequest, opts ...grpc.CallOption) (*GetTaskMatrixResponse, error)
+	GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*GetTaskStatsResponse, error)
+	GetUserTaskMetrics(ctx context.Context, in *GetUserTaskMetricsRequest, opts ...grpc.CallOption) (*GetUserTaskMetricsResponse, error)
+	GetCompletionTrends(ctx context.Context, in *GetCompletionTrendsRequest, opts ...grpc.CallOption) (*GetCompletionTrendsResponse, error)
+	ListTasksNeedingAttention(ctx context.Context, in *ListTasksNeedingAttentionRequest, opts ...grpc.CallOption) (*ListTasksNeedingAttentionResponse, error)
+	MoveTaskOnBoard(ctx context.Context, in *MoveTaskOnBoardRequest, opts ...grpc.CallOption) (*MoveTaskOnBoardResponse, error)
+	ReorderTask(ctx context.Context, in *ReorderTaskRequest, opts ...grpc.CallOption) (*ReorderTaskResponse, error)
+	ListOverdueTasks(ctx context.Context, in *ListOverdueTasksRequest, opts ...grpc.CallOption) (*ListOverdueTasksResponse, error)
+	SnoozeOverdue(ctx context.Context, in *SnoozeOverdueRequest, opts ...grpc.CallOption) (*SnoozeOverdueResponse, error)
+	DeleteCompletedTasks(ctx context.Context, in *DeleteCompletedTasksRequest, opts ...grpc.CallOption) (*DeleteCompletedTasksResponse, error)
+	PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	RestoreAllTasks(ctx context.Context, in *RestoreAllTasksRequest, opts ...grpc.CallOption) (*RestoreAllTasksResponse, error)
+	ListDeletedTasks(ctx context.Context, in *ListDeletedTasksRequest, opts ...grpc.CallOption) (*ListDeletedTasksResponse, error)
+	BulkAddTags(ctx context.Context, in *BulkTagsRequest, opts ...grpc.CallOption) (*BulkTagsResponse, error)
+	BulkRemoveTags(ctx context.Context, in *BulkTagsRequest, opts ...grpc.CallOption) (*BulkTagsResponse, error)
 }
 
 type todoServiceClient struct {
@@ -56,6 +89,24 @@ func (c *todoServiceClient) GetTask(ctx context.Context, in *GetTaskRequest, opt
 	return out, nil
 }
 
+func (c *todoServiceClient) GetTaskByUser(ctx context.Context, in *GetTaskByUserRequest, opts ...grpc.CallOption) (*GetTaskResponse, error) {
+	out := new(GetTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetTaskByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetTasksByIDs(ctx context.Context, in *GetTasksByIDsRequest, opts ...grpc.CallOption) (*GetTasksByIDsResponse, error) {
+	out := new(GetTasksByIDsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetTasksByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *todoServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error) {
 	out := new(UpdateTaskResponse)
 	err := c.cc.Invoke(ctx, "/todo.TodoService/UpdateTask", in, out, opts...)
@@ -65,6 +116,33 @@ func (c *todoServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskReques
 	return out, nil
 }
 
+func (c *todoServiceClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error) {
+	out := new(CompleteTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/CompleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*ArchiveTaskResponse, error) {
+	out := new(ArchiveTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ArchiveTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UnarchiveTask(ctx context.Context, in *UnarchiveTaskRequest, opts ...grpc.CallOption) (*UnarchiveTaskResponse, error) {
+	out := new(UnarchiveTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/UnarchiveTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *todoServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error) {
 	out := new(DeleteTaskResponse)
 	err := c.cc.Invoke(ctx, "/todo.TodoService/DeleteTask", in, out, opts...)
@@ -74,6 +152,33 @@ func (c *todoServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskReques
 	return out, nil
 }
 
+func (c *todoServiceClient) DeleteTaskByUser(ctx context.Context, in *DeleteTaskByUserRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error) {
+	out := new(DeleteTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/DeleteTaskByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error) {
+	out := new(RestoreTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/RestoreTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BulkSetDueDate(ctx context.Context, in *BulkSetDueDateRequest, opts ...grpc.CallOption) (*BulkSetDueDateResponse, error) {
+	out := new(BulkSetDueDateResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/BulkSetDueDate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *todoServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
 	out := new(ListTasksResponse)
 	err := c.cc.Invoke(ctx, "/todo.TodoService/ListTasks", in, out, opts...)
@@ -92,16 +197,274 @@ func (c *todoServiceClient) ListTasksByUser(ctx context.Context, in *ListTasksBy
 	return out, nil
 }
 
+func (c *todoServiceClient) ListTasksModifiedBy(ctx context.Context, in *ListTasksModifiedByRequest, opts ...grpc.CallOption) (*ListTasksModifiedByResponse, error) {
+	out := new(ListTasksModifiedByResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ListTasksModifiedBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) MarkAllSeen(ctx context.Context, in *MarkAllSeenRequest, opts ...grpc.CallOption) (*MarkAllSeenResponse, error) {
+	out := new(MarkAllSeenResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/MarkAllSeen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error) {
+	out := new(GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetUnreadCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardResponse, error) {
+	out := new(GetBoardResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CountTasks(ctx context.Context, in *CountTasksRequest, opts ...grpc.CallOption) (*CountTasksResponse, error) {
+	out := new(CountTasksResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/CountTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) PreviewRecurrence(ctx context.Context, in *PreviewRecurrenceRequest, opts ...grpc.CallOption) (*PreviewRecurrenceResponse, error) {
+	out := new(PreviewRecurrenceResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/PreviewRecurrence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateTasksBatch(ctx context.Context, in *CreateTasksBatchRequest, opts ...grpc.CallOption) (*CreateTasksBatchResponse, error) {
+	out := new(CreateTasksBatchResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/CreateTasksBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) PurgeUserData(ctx context.Context, in *PurgeUserDataRequest, opts ...grpc.CallOption) (*PurgeUserDataResponse, error) {
+	out := new(PurgeUserDataResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/PurgeUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetHealthDetails(ctx context.Context, in *GetTodoHealthDetailsRequest, opts ...grpc.CallOption) (*GetTodoHealthDetailsResponse, error) {
+	out := new(GetTodoHealthDetailsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetHealthDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error) {
+	out := new(SearchTasksResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/SearchTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetTaskMatrix(ctx context.Context, in *GetTaskMatrixRequest, opts ...grpc.CallOption) (*GetTaskMatrixResponse, error) {
+	out := new(GetTaskMatrixResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetTaskMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*GetTaskStatsResponse, error) {
+	out := new(GetTaskStatsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetTaskStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetUserTaskMetrics(ctx context.Context, in *GetUserTaskMetricsRequest, opts ...grpc.CallOption) (*GetUserTaskMetricsResponse, error) {
+	out := new(GetUserTaskMetricsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetUserTaskMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetCompletionTrends(ctx context.Context, in *GetCompletionTrendsRequest, opts ...grpc.CallOption) (*GetCompletionTrendsResponse, error) {
+	out := new(GetCompletionTrendsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/GetCompletionTrends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTasksNeedingAttention(ctx context.Context, in *ListTasksNeedingAttentionRequest, opts ...grpc.CallOption) (*ListTasksNeedingAttentionResponse, error) {
+	out := new(ListTasksNeedingAttentionResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ListTasksNeedingAttention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) MoveTaskOnBoard(ctx context.Context, in *MoveTaskOnBoardRequest, opts ...grpc.CallOption) (*MoveTaskOnBoardResponse, error) {
+	out := new(MoveTaskOnBoardResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/MoveTaskOnBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ReorderTask(ctx context.Context, in *ReorderTaskRequest, opts ...grpc.CallOption) (*ReorderTaskResponse, error) {
+	out := new(ReorderTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ReorderTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListOverdueTasks(ctx context.Context, in *ListOverdueTasksRequest, opts ...grpc.CallOption) (*ListOverdueTasksResponse, error) {
+	out := new(ListOverdueTasksResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ListOverdueTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) SnoozeOverdue(ctx context.Context, in *SnoozeOverdueRequest, opts ...grpc.CallOption) (*SnoozeOverdueResponse, error) {
+	out := new(SnoozeOverdueResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/SnoozeOverdue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteCompletedTasks(ctx context.Context, in *DeleteCompletedTasksRequest, opts ...grpc.CallOption) (*DeleteCompletedTasksResponse, error) {
+	out := new(DeleteCompletedTasksResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/DeleteCompletedTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error) {
+	out := new(DeleteTaskResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/PurgeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RestoreAllTasks(ctx context.Context, in *RestoreAllTasksRequest, opts ...grpc.CallOption) (*RestoreAllTasksResponse, error) {
+	out := new(RestoreAllTasksResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/RestoreAllTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListDeletedTasks(ctx context.Context, in *ListDeletedTasksRequest, opts ...grpc.CallOption) (*ListDeletedTasksResponse, error) {
+	out := new(ListDeletedTasksResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ListDeletedTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BulkAddTags(ctx context.Context, in *BulkTagsRequest, opts ...grpc.CallOption) (*BulkTagsResponse, error) {
+	out := new(BulkTagsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/BulkAddTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BulkRemoveTags(ctx context.Context, in *BulkTagsRequest, opts ...grpc.CallOption) (*BulkTagsResponse, error) {
+	out := new(BulkTagsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/BulkRemoveTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TodoServiceServer is the server API for TodoService service.
 // All implementations must embed UnimplementedTodoServiceServer
 // for forward compatibility
 type TodoServiceServer interface {
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
+	GetTaskByUser(context.Context, *GetTaskByUserRequest) (*GetTaskResponse, error)
+	GetTasksByIDs(context.Context, *GetTasksByIDsRequest) (*GetTasksByIDsResponse, error)
 	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
+	CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error)
+	ArchiveTask(context.Context, *ArchiveTaskRequest) (*ArchiveTaskResponse, error)
+	UnarchiveTask(context.Context, *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	DeleteTaskByUser(context.Context, *DeleteTaskByUserRequest) (*DeleteTaskResponse, error)
+	RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error)
+	BulkSetDueDate(context.Context, *BulkSetDueDateRequest) (*BulkSetDueDateResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	ListTasksByUser(context.Context, *ListTasksByUserRequest) (*ListTasksByUserResponse, error)
+	ListTasksModifiedBy(context.Context, *ListTasksModifiedByRequest) (*ListTasksModifiedByResponse, error)
+	MarkAllSeen(context.Context, *MarkAllSeenRequest) (*MarkAllSeenResponse, error)
+	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
+	GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error)
+	CountTasks(context.Context, *CountTasksRequest) (*CountTasksResponse, error)
+	PreviewRecurrence(context.Context, *PreviewRecurrenceRequest) (*PreviewRecurrenceResponse, error)
+	CreateTasksBatch(context.Context, *CreateTasksBatchRequest) (*CreateTasksBatchResponse, error)
+	PurgeUserData(context.Context, *PurgeUserDataRequest) (*PurgeUserDataResponse, error)
+	GetHealthDetails(context.Context, *GetTodoHealthDetailsRequest) (*GetTodoHealthDetailsResponse, error)
+	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
+	GetTaskMatrix(context.Context, *GetTaskMatrixRequest) (*GetTaskMatrixResponse, error)
+	GetTaskStats(context.Context, *GetTaskStatsRequest) (*GetTaskStatsResponse, error)
+	GetUserTaskMetrics(context.Context, *GetUserTaskMetricsRequest) (*GetUserTaskMetricsResponse, error)
+	GetCompletionTrends(context.Context, *GetCompletionTrendsRequest) (*GetCompletionTrendsResponse, error)
+	ListTasksNeedingAttention(context.Context, *ListTasksNeedingAttentionRequest) (*ListTasksNeedingAttentionResponse, error)
+	MoveTaskOnBoard(context.Context, *MoveTaskOnBoardRequest) (*MoveTaskOnBoardResponse, error)
+	ReorderTask(context.Context, *ReorderTaskRequest) (*ReorderTaskResponse, error)
+	ListOverdueTasks(context.Context, *ListOverdueTasksRequest) (*ListOverdueTasksResponse, error)
+	SnoozeOverdue(context.Context, *SnoozeOverdueRequest) (*SnoozeOverdueResponse, error)
+	DeleteCompletedTasks(context.Context, *DeleteCompletedTasksRequest) (*DeleteCompletedTasksResponse, error)
+	PurgeTask(context.Context, *PurgeTaskRequest) (*DeleteTaskResponse, error)
+	RestoreAllTasks(context.Context, *RestoreAllTasksRequest) (*RestoreAllTasksResponse, error)
+	ListDeletedTasks(context.Context, *ListDeletedTasksRequest) (*ListDeletedTasksResponse, error)
+	BulkAddTags(context.Context, *BulkTagsRequest) (*BulkTagsResponse, error)
+	BulkRemoveTags(context.Context, *BulkTagsRequest) (*BulkTagsResponse, error)
 	mustEmbedUnimplementedTodoServiceServer()
 }
 
@@ -115,18 +478,117 @@ func (UnimplementedTodoServiceServer) CreateTask(context.Context, *CreateTaskReq
 func (UnimplementedTodoServiceServer) GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
 }
+func (UnimplementedTodoServiceServer) GetTaskByUser(context.Context, *GetTaskByUserRequest) (*GetTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskByUser not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTasksByIDs(context.Context, *GetTasksByIDsRequest) (*GetTasksByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTasksByIDs not implemented")
+}
 func (UnimplementedTodoServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
 }
+func (UnimplementedTodoServiceServer) CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTask not implemented")
+}
+func (UnimplementedTodoServiceServer) ArchiveTask(context.Context, *ArchiveTaskRequest) (*ArchiveTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTask not implemented")
+}
+func (UnimplementedTodoServiceServer) UnarchiveTask(context.Context, *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveTask not implemented")
+}
 func (UnimplementedTodoServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTodoServiceServer) DeleteTaskByUser(context.Context, *DeleteTaskByUserRequest) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskByUser not implemented")
+}
+func (UnimplementedTodoServiceServer) RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedTodoServiceServer) BulkSetDueDate(context.Context, *BulkSetDueDateRequest) (*BulkSetDueDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkSetDueDate not implemented")
+}
 func (UnimplementedTodoServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
 func (UnimplementedTodoServiceServer) ListTasksByUser(context.Context, *ListTasksByUserRequest) (*ListTasksByUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasksByUser not implemented")
 }
+func (UnimplementedTodoServiceServer) ListTasksModifiedBy(context.Context, *ListTasksModifiedByRequest) (*ListTasksModifiedByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasksModifiedBy not implemented")
+}
+func (UnimplementedTodoServiceServer) MarkAllSeen(context.Context, *MarkAllSeenRequest) (*MarkAllSeenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllSeen not implemented")
+}
+func (UnimplementedTodoServiceServer) GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedTodoServiceServer) GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
+}
+func (UnimplementedTodoServiceServer) CountTasks(context.Context, *CountTasksRequest) (*CountTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountTasks not implemented")
+}
+func (UnimplementedTodoServiceServer) PreviewRecurrence(context.Context, *PreviewRecurrenceRequest) (*PreviewRecurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRecurrence not implemented")
+}
+func (UnimplementedTodoServiceServer) CreateTasksBatch(context.Context, *CreateTasksBatchRequest) (*CreateTasksBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTasksBatch not implemented")
+}
+func (UnimplementedTodoServiceServer) PurgeUserData(context.Context, *PurgeUserDataRequest) (*PurgeUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserData not implemented")
+}
+func (UnimplementedTodoServiceServer) GetHealthDetails(context.Context, *GetTodoHealthDetailsRequest) (*GetTodoHealthDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthDetails not implemented")
+}
+func (UnimplementedTodoServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTasks not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTaskMatrix(context.Context, *GetTaskMatrixRequest) (*GetTaskMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskMatrix not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTaskStats(context.Context, *GetTaskStatsRequest) (*GetTaskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStats not implemented")
+}
+func (UnimplementedTodoServiceServer) GetUserTaskMetrics(context.Context, *GetUserTaskMetricsRequest) (*GetUserTaskMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTaskMetrics not implemented")
+}
+func (UnimplementedTodoServiceServer) GetCompletionTrends(context.Context, *GetCompletionTrendsRequest) (*GetCompletionTrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompletionTrends not implemented")
+}
+func (UnimplementedTodoServiceServer) ListTasksNeedingAttention(context.Context, *ListTasksNeedingAttentionRequest) (*ListTasksNeedingAttentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasksNeedingAttention not implemented")
+}
+func (UnimplementedTodoServiceServer) MoveTaskOnBoard(context.Context, *MoveTaskOnBoardRequest) (*MoveTaskOnBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskOnBoard not implemented")
+}
+func (UnimplementedTodoServiceServer) ReorderTask(context.Context, *ReorderTaskRequest) (*ReorderTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderTask not implemented")
+}
+func (UnimplementedTodoServiceServer) ListOverdueTasks(context.Context, *ListOverdueTasksRequest) (*ListOverdueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverdueTasks not implemented")
+}
+func (UnimplementedTodoServiceServer) SnoozeOverdue(context.Context, *SnoozeOverdueRequest) (*SnoozeOverdueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeOverdue not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteCompletedTasks(context.Context, *DeleteCompletedTasksRequest) (*DeleteCompletedTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompletedTasks not implemented")
+}
+func (UnimplementedTodoServiceServer) PurgeTask(context.Context, *PurgeTaskRequest) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTask not implemented")
+}
+func (UnimplementedTodoServiceServer) RestoreAllTasks(context.Context, *RestoreAllTasksRequest) (*RestoreAllTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAllTasks not implemented")
+}
+func (UnimplementedTodoServiceServer) ListDeletedTasks(context.Context, *ListDeletedTasksRequest) (*ListDeletedTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedTasks not implemented")
+}
+func (UnimplementedTodoServiceServer) BulkAddTags(context.Context, *BulkTagsRequest) (*BulkTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAddTags not implemented")
+}
+func (UnimplementedTodoServiceServer) BulkRemoveTags(context.Context, *BulkTagsRequest) (*BulkTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRemoveTags not implemented")
+}
 func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
 
 // UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -176,62 +638,206 @@ func _TodoService_GetTask_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskRequest)
+func _TodoService_GetTaskByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskByUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).UpdateTask(ctx, in)
+		return srv.(TodoServiceServer).GetTaskByUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/todo.TodoService/UpdateTask",
+		FullMethod: "/todo.TodoService/GetTaskByUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+		return srv.(TodoServiceServer).GetTaskByUser(ctx, req.(*GetTaskByUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTaskRequest)
+func _TodoService_GetTasksByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTasksByIDsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).DeleteTask(ctx, in)
+		return srv.(TodoServiceServer).GetTasksByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/todo.TodoService/DeleteTask",
+		FullMethod: "/todo.TodoService/GetTasksByIDs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+		return srv.(TodoServiceServer).GetTasksByIDs(ctx, req.(*GetTasksByIDsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTasksRequest)
+func _TodoService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).ListTasks(ctx, in)
+		return srv.(TodoServiceServer).UpdateTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/todo.TodoService/ListTasks",
+		FullMethod: "/todo.TodoService/UpdateTask",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+		return srv.(TodoServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_ListTasksByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTasksByUserRequest)
+func _TodoService_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CompleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/CompleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ArchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ArchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ArchiveTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ArchiveTask(ctx, req.(*ArchiveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UnarchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UnarchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/UnarchiveTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UnarchiveTask(ctx, req.(*UnarchiveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTaskByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTaskByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/DeleteTaskByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTaskByUser(ctx, req.(*DeleteTaskByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/RestoreTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BulkSetDueDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSetDueDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BulkSetDueDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/BulkSetDueDate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BulkSetDueDate(ctx, req.(*BulkSetDueDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTasksByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksByUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -240,10 +846,460 @@ func _TodoService_ListTasksByUser_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/todo.TodoService/ListTasksByUser",
+		FullMethod: "/todo.TodoService/ListTasksByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTasksByUser(ctx, req.(*ListTasksByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTasksModifiedBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksModifiedByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTasksModifiedBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListTasksModifiedBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTasksModifiedBy(ctx, req.(*ListTasksModifiedByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_MarkAllSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).MarkAllSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/MarkAllSeen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).MarkAllSeen(ctx, req.(*MarkAllSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetUnreadCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetUnreadCount(ctx, req.(*GetUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetBoard(ctx, req.(*GetBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CountTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CountTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/CountTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CountTasks(ctx, req.(*CountTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_PreviewRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).PreviewRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/PreviewRecurrence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).PreviewRecurrence(ctx, req.(*PreviewRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateTasksBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTasksBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTasksBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/CreateTasksBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTasksBatch(ctx, req.(*CreateTasksBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_PurgeUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).PurgeUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/PurgeUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).PurgeUserData(ctx, req.(*PurgeUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetHealthDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoHealthDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetHealthDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetHealthDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetHealthDetails(ctx, req.(*GetTodoHealthDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).SearchTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/SearchTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).SearchTasks(ctx, req.(*SearchTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTaskMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTaskMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetTaskMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTaskMatrix(ctx, req.(*GetTaskMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetTaskStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTaskStats(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetUserTaskMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTaskMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetUserTaskMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetUserTaskMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetUserTaskMetrics(ctx, req.(*GetUserTaskMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetCompletionTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompletionTrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetCompletionTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/GetCompletionTrends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetCompletionTrends(ctx, req.(*GetCompletionTrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTasksNeedingAttention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksNeedingAttentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTasksNeedingAttention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListTasksNeedingAttention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTasksNeedingAttention(ctx, req.(*ListTasksNeedingAttentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_MoveTaskOnBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskOnBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).MoveTaskOnBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/MoveTaskOnBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).MoveTaskOnBoard(ctx, req.(*MoveTaskOnBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ReorderTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ReorderTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ReorderTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ReorderTask(ctx, req.(*ReorderTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListOverdueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverdueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListOverdueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListOverdueTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListOverdueTasks(ctx, req.(*ListOverdueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_SnoozeOverdue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeOverdueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).SnoozeOverdue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/SnoozeOverdue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).SnoozeOverdue(ctx, req.(*SnoozeOverdueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteCompletedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompletedTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteCompletedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/DeleteCompletedTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteCompletedTasks(ctx, req.(*DeleteCompletedTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).PurgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/PurgeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).PurgeTask(ctx, req.(*PurgeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RestoreAllTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAllTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RestoreAllTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/RestoreAllTasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).ListTasksByUser(ctx, req.(*ListTasksByUserRequest))
+		return srv.(TodoServiceServer).RestoreAllTasks(ctx, req.(*RestoreAllTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListDeletedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListDeletedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListDeletedTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListDeletedTasks(ctx, req.(*ListDeletedTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BulkAddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BulkAddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/BulkAddTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BulkAddTags(ctx, req.(*BulkTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BulkRemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BulkRemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/BulkRemoveTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BulkRemoveTags(ctx, req.(*BulkTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -263,14 +1319,46 @@ var TodoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTask",
 			Handler:    _TodoService_GetTask_Handler,
 		},
+		{
+			MethodName: "GetTaskByUser",
+			Handler:    _TodoService_GetTaskByUser_Handler,
+		},
+		{
+			MethodName: "GetTasksByIDs",
+			Handler:    _TodoService_GetTasksByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateTask",
 			Handler:    _TodoService_UpdateTask_Handler,
 		},
+		{
+			MethodName: "CompleteTask",
+			Handler:    _TodoService_CompleteTask_Handler,
+		},
+		{
+			MethodName: "ArchiveTask",
+			Handler:    _TodoService_ArchiveTask_Handler,
+		},
+		{
+			MethodName: "UnarchiveTask",
+			Handler:    _TodoService_UnarchiveTask_Handler,
+		},
 		{
 			MethodName: "DeleteTask",
 			Handler:    _TodoService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "DeleteTaskByUser",
+			Handler:    _TodoService_DeleteTaskByUser_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TodoService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "BulkSetDueDate",
+			Handler:    _TodoService_BulkSetDueDate_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _TodoService_ListTasks_Handler,
@@ -279,6 +1367,336 @@ var TodoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasksByUser",
 			Handler:    _TodoService_ListTasksByUser_Handler,
 		},
+		{
+			MethodName: "ListTasksModifiedBy",
+			Handler:    _TodoService_ListTasksModifiedBy_Handler,
+		},
+		{
+			MethodName: "MarkAllSeen",
+			Handler:    _TodoService_MarkAllSeen_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _TodoService_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "GetBoard",
+			Handler:    _TodoService_GetBoard_Handler,
+		},
+		{
+			MethodName: "CountTasks",
+			Handler:    _TodoService_CountTasks_Handler,
+		},
+		{
+			MethodName: "PreviewRecurrence",
+			Handler:    _TodoService_PreviewRecurrence_Handler,
+		},
+		{
+			MethodName: "CreateTasksBatch",
+			Handler:    _TodoService_CreateTasksBatch_Handler,
+		},
+		{
+			MethodName: "PurgeUserData",
+			Handler:    _TodoService_PurgeUserData_Handler,
+		},
+		{
+			MethodName: "GetHealthDetails",
+			Handler:    _TodoService_GetHealthDetails_Handler,
+		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _TodoService_SearchTasks_Handler,
+		},
+		{
+			MethodName: "GetTaskMatrix",
+			Handler:    _TodoService_GetTaskMatrix_Handler,
+		},
+		{
+			MethodName: "GetTaskStats",
+			Handler:    _TodoService_GetTaskStats_Handler,
+		},
+		{
+			MethodName: "GetUserTaskMetrics",
+			Handler:    _TodoService_GetUserTaskMetrics_Handler,
+		},
+		{
+			MethodName: "GetCompletionTrends",
+			Handler:    _TodoService_GetCompletionTrends_Handler,
+		},
+		{
+			MethodName: "ListTasksNeedingAttention",
+			Handler:    _TodoService_ListTasksNeedingAttention_Handler,
+		},
+		{
+			MethodName: "MoveTaskOnBoard",
+			Handler:    _TodoService_MoveTaskOnBoard_Handler,
+		},
+		{
+			MethodName: "ReorderTask",
+			Handler:    _TodoService_ReorderTask_Handler,
+		},
+		{
+			MethodName: "ListOverdueTasks",
+			Handler:    _TodoService_ListOverdueTasks_Handler,
+		},
+		{
+			MethodName: "SnoozeOverdue",
+			Handler:    _TodoService_SnoozeOverdue_Handler,
+		},
+		{
+			MethodName: "DeleteCompletedTasks",
+			Handler:    _TodoService_DeleteCompletedTasks_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _TodoService_PurgeTask_Handler,
+		},
+		{
+			MethodName: "RestoreAllTasks",
+			Handler:    _TodoService_RestoreAllTasks_Handler,
+		},
+		{
+			MethodName: "ListDeletedTasks",
+			Handler:    _TodoService_ListDeletedTasks_Handler,
+		},
+		{
+			MethodName: "BulkAddTags",
+			Handler:    _TodoService_BulkAddTags_Handler,
+		},
+		{
+			MethodName: "BulkRemoveTags",
+			Handler:    _TodoService_BulkRemoveTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/todo.proto",
+}
+
+// WebhookServiceClient is the client API for WebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookServiceClient interface {
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+}
+
+type webhookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/todo.WebhookService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error) {
+	out := new(GetWebhookResponse)
+	err := c.cc.Invoke(ctx, "/todo.WebhookService/GetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	out := new(UpdateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/todo.WebhookService/UpdateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/todo.WebhookService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/todo.WebhookService/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServiceServer is the server API for WebhookService service.
+// All implementations must embed UnimplementedWebhookServiceServer
+// for forward compatibility
+type WebhookServiceServer interface {
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+// UnimplementedWebhookServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookServiceServer struct {
+}
+
+func (UnimplementedWebhookServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
+
+// UnsafeWebhookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServiceServer will
+// result in compilation errors.
+type UnsafeWebhookServiceServer interface {
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+func RegisterWebhookServiceServer(s grpc.ServiceRegistrar, srv WebhookServiceServer) {
+	s.RegisterService(&WebhookService_ServiceDesc, srv)
+}
+
+func _WebhookService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.WebhookService/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.WebhookService/GetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.WebhookService/UpdateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.WebhookService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.WebhookService/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "todo.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _WebhookService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _WebhookService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _WebhookService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookService_ListWebhooks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/todo.proto",
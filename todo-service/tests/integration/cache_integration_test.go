@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CacheIntegrationTestSuite struct {
+	suite.Suite
+	redisClient *redis.RedisClient
+	cache       cache.TaskCache
+	ctx         context.Context
+}
+
+func (suite *CacheIntegrationTestSuite) SetupSuite() {
+	var err error
+	suite.redisClient, err = redis.NewRedisClient(redis.Config{
+		Host:        "localhost",
+		Port:        6379,
+		DialTimeout: 5 * time.Second,
+		CacheTTL:    time.Minute,
+	})
+	if !assert.NoError(suite.T(), err) {
+		suite.T().FailNow()
+	}
+
+	suite.cache = cache.NewTaskCache(suite.redisClient)
+	suite.ctx = context.Background()
+}
+
+func (suite *CacheIntegrationTestSuite) TearDownSuite() {
+	suite.redisClient.Close()
+}
+
+// statsKey is where the service caches userID's stats right now.
+func (suite *CacheIntegrationTestSuite) statsKey(userID string) string {
+	version, err := suite.cache.UserCacheVersion(suite.ctx, userID)
+	if !assert.NoError(suite.T(), err) {
+		suite.T().FailNow()
+	}
+	return cache.UserNamespace(userID, version) + ":stats"
+}
+
+func (suite *CacheIntegrationTestSuite) TestInvalidateUserTasksDropsStats() {
+	userID := uuid.New().String()
+	otherUserID := uuid.New().String()
+	stats := map[string]int64{"TODO": 5, "IN_PROGRESS": 2, "DONE": 10, "ARCHIVED": 0}
+
+	assert.NoError(suite.T(), suite.cache.SetStats(suite.ctx, suite.statsKey(userID), stats))
+	assert.NoError(suite.T(), suite.cache.SetStats(suite.ctx, suite.statsKey(otherUserID), stats))
+
+	cached, err := suite.cache.GetStats(suite.ctx, suite.statsKey(userID))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stats, cached)
+
+	assert.NoError(suite.T(), suite.cache.InvalidateUserTasks(suite.ctx, userID))
+
+	cached, err = suite.cache.GetStats(suite.ctx, suite.statsKey(userID))
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), cached)
+
+	// Other users keep theirs
+	cached, err = suite.cache.GetStats(suite.ctx, suite.statsKey(otherUserID))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stats, cached)
+	assert.NoError(suite.T(), suite.cache.InvalidateUserTasks(suite.ctx, otherUserID))
+}
+
+func TestCacheIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheIntegrationTestSuite))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -48,7 +49,7 @@ func (suite *RepositoryIntegrationTestSuite) SetupSuite() {
 	err = suite.db.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\"").Error
 	assert.NoError(suite.T(), err)
 	
-	err = suite.db.AutoMigrate(&model.Task{})
+	err = suite.db.AutoMigrate(&model.Task{}, &model.Webhook{}, &model.WebhookDeadLetter{})
 	assert.NoError(suite.T(), err)
 	
 	suite.repo = repository.NewTaskRepository(suite.db)
@@ -61,6 +62,8 @@ func (suite *RepositoryIntegrationTestSuite) SetupSuite() {
 func (suite *RepositoryIntegrationTestSuite) TearDownSuite() {
 	// Clean up database
 	suite.db.Exec("DROP TABLE IF EXISTS tasks")
+	suite.db.Exec("DROP TABLE IF EXISTS webhook_dead_letters")
+	suite.db.Exec("DROP TABLE IF EXISTS webhooks")
 	sqlDB, _ := suite.db.DB()
 	sqlDB.Close()
 }
@@ -68,6 +71,8 @@ func (suite *RepositoryIntegrationTestSuite) TearDownSuite() {
 func (suite *RepositoryIntegrationTestSuite) SetupTest() {
 	// Clean table before each test
 	suite.db.Exec("DELETE FROM tasks")
+	suite.db.Exec("DELETE FROM webhook_dead_letters")
+	suite.db.Exec("DELETE FROM webhooks")
 }
 
 func (suite *RepositoryIntegrationTestSuite) TestCreateAndFindTask() {
@@ -141,6 +146,71 @@ func (suite *RepositoryIntegrationTestSuite) TestUpdateTask() {
 	assert.Equal(suite.T(), "Updated Title", foundTask.Title)
 }
 
+func (suite *RepositoryIntegrationTestSuite) TestUpdateTaskRejectsStaleVersion() {
+	created, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Original Title"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, created.Version)
+
+	// Two writers read the same version
+	first, err := suite.repo.FindByID(suite.ctx, created.ID)
+	assert.NoError(suite.T(), err)
+	second, err := suite.repo.FindByID(suite.ctx, created.ID)
+	assert.NoError(suite.T(), err)
+
+	first.Title = "First writer"
+	updated, err := suite.repo.Update(suite.ctx, first, "title")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, updated.Version)
+
+	second.Title = "Second writer"
+	_, err = suite.repo.Update(suite.ctx, second, "title")
+	assert.ErrorIs(suite.T(), err, repository.ErrVersionConflict)
+	assert.Equal(suite.T(), 1, second.Version)
+
+	found, err := suite.repo.FindByID(suite.ctx, created.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "First writer", found.Title)
+	assert.Equal(suite.T(), 2, found.Version)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestUpdateTaskOnlyWritesNamedFields() {
+	dueDate := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Microsecond)
+	created, err := suite.repo.Create(suite.ctx, &model.Task{
+		UserID:      suite.userID,
+		Title:       "Original Title",
+		Description: "Original Description",
+		Priority:    model.PriorityHigh,
+		DueDate:     &dueDate,
+		Tags:        model.Tags{"planning"},
+	})
+	assert.NoError(suite.T(), err)
+
+	// A sparse task must not blank out the columns it leaves zero
+	_, err = suite.repo.Update(suite.ctx, &model.Task{ID: created.ID, UserID: suite.userID, Title: "Renamed", Version: created.Version}, "title")
+	assert.NoError(suite.T(), err)
+
+	found, err := suite.repo.FindByID(suite.ctx, created.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Renamed", found.Title)
+	assert.Equal(suite.T(), "Original Description", found.Description)
+	assert.Equal(suite.T(), model.PriorityHigh, found.Priority)
+	assert.Equal(suite.T(), model.Tags{"planning"}, found.Tags)
+	assert.True(suite.T(), created.CreatedAt.Equal(found.CreatedAt))
+	if assert.NotNil(suite.T(), found.DueDate) {
+		assert.True(suite.T(), dueDate.Equal(*found.DueDate))
+	}
+
+	// Naming a nullable column writes it even when nil
+	found.DueDate = nil
+	_, err = suite.repo.Update(suite.ctx, found, "due_date")
+	assert.NoError(suite.T(), err)
+
+	cleared, err := suite.repo.FindByID(suite.ctx, created.ID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), cleared.DueDate)
+	assert.Equal(suite.T(), "Renamed", cleared.Title)
+}
+
 func (suite *RepositoryIntegrationTestSuite) TestDeleteTask() {
 	task := &model.Task{
 		UserID: suite.userID,
@@ -151,8 +221,9 @@ func (suite *RepositoryIntegrationTestSuite) TestDeleteTask() {
 	assert.NoError(suite.T(), err)
 
 	// Delete task
-	err = suite.repo.Delete(suite.ctx, createdTask.ID)
+	deleted, err := suite.repo.Delete(suite.ctx, createdTask.ID)
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), createdTask.ID, deleted.ID)
 
 	// Verify task is deleted
 	foundTask, err := suite.repo.FindByID(suite.ctx, createdTask.ID)
@@ -170,8 +241,9 @@ func (suite *RepositoryIntegrationTestSuite) TestDeleteByUser() {
 	assert.NoError(suite.T(), err)
 
 	// Delete task by correct user
-	err = suite.repo.DeleteByUser(suite.ctx, createdTask.ID, suite.userID)
+	deleted, err := suite.repo.DeleteByUser(suite.ctx, createdTask.ID, suite.userID)
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), createdTask.ID, deleted.ID)
 
 	// Verify task is deleted
 	foundTask, err := suite.repo.FindByID(suite.ctx, createdTask.ID)
@@ -179,6 +251,25 @@ func (suite *RepositoryIntegrationTestSuite) TestDeleteByUser() {
 	assert.Nil(suite.T(), foundTask)
 }
 
+func (suite *RepositoryIntegrationTestSuite) TestDelete_ReturnsStoredStatus() {
+	createdTask, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Finished then deleted", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+
+	// An earlier read of the task is now stale
+	stale := *createdTask
+	createdTask.Status = model.StatusDone
+	_, err = suite.repo.Update(suite.ctx, createdTask, "status")
+	assert.NoError(suite.T(), err)
+
+	deleted, err := suite.repo.DeleteByUser(suite.ctx, stale.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusDone, deleted.Status)
+
+	// Deleting again finds nothing
+	_, err = suite.repo.DeleteByUser(suite.ctx, stale.ID, suite.userID)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
+
 func (suite *RepositoryIntegrationTestSuite) TestListTasks() {
 	// Create multiple tasks
 	for i := 1; i <= 5; i++ {
@@ -228,6 +319,49 @@ func (suite *RepositoryIntegrationTestSuite) TestListTasks() {
 	assert.Len(suite.T(), page2Tasks, 2)
 }
 
+func (suite *RepositoryIntegrationTestSuite) TestListAfterStableWhileInserting() {
+	base := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+	var seeded []string
+	for i := 0; i < 5; i++ {
+		task := &model.Task{
+			UserID: suite.userID,
+			Title:  fmt.Sprintf("Cursor Task %d", i),
+			// Two tasks share a timestamp so the id tie-break is exercised
+			CreatedAt: base.Add(-time.Duration(i/2*2) * time.Minute),
+		}
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+		seeded = append(seeded, task.ID)
+	}
+
+	filter := &repository.TaskFilter{UserID: &suite.userID}
+	var seen []string
+	var after *repository.Cursor
+	for pageNum := 0; ; pageNum++ {
+		page, err := suite.repo.ListAfter(suite.ctx, filter, after, 2)
+		assert.NoError(suite.T(), err)
+		if len(page) == 0 {
+			break
+		}
+		for _, task := range page {
+			seen = append(seen, task.ID)
+		}
+		last := page[len(page)-1]
+		after = &repository.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+
+		// New tasks arriving between pages must not shift later pages
+		if pageNum == 0 {
+			for i := 0; i < 2; i++ {
+				_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Inserted"})
+				assert.NoError(suite.T(), err)
+			}
+		}
+	}
+
+	assert.Len(suite.T(), seen, len(seeded))
+	assert.ElementsMatch(suite.T(), seeded, seen)
+}
+
 func (suite *RepositoryIntegrationTestSuite) TestListTasksByUser() {
 	// Create multiple tasks for the user
 	for i := 1; i <= 3; i++ {
@@ -310,6 +444,1351 @@ func (suite *RepositoryIntegrationTestSuite) TestListTasksWithSorting() {
 	assert.Len(suite.T(), descTasks, 3)
 }
 
+func (suite *RepositoryIntegrationTestSuite) TestListTopByUserAndStatus() {
+	priorities := []model.TaskPriority{
+		model.PriorityLow,
+		model.PriorityUrgent,
+		model.PriorityMedium,
+		model.PriorityHigh,
+	}
+	for i, priority := range priorities {
+		task := &model.Task{
+			UserID:   suite.userID,
+			Title:    fmt.Sprintf("Board Task %d", i),
+			Priority: priority,
+		}
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+
+	doneTask := &model.Task{
+		UserID: suite.userID,
+		Title:  "Done Task",
+		Status: model.StatusDone,
+	}
+	_, err := suite.repo.Create(suite.ctx, doneTask)
+	assert.NoError(suite.T(), err)
+
+	// Most urgent first, limited to the column size
+	tasks, err := suite.repo.ListTopByUserAndStatus(suite.ctx, suite.userID, model.StatusTodo, 3, repository.ColumnOrder{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), tasks, 3)
+	assert.Equal(suite.T(), model.PriorityUrgent, tasks[0].Priority)
+	assert.Equal(suite.T(), model.PriorityHigh, tasks[1].Priority)
+	assert.Equal(suite.T(), model.PriorityMedium, tasks[2].Priority)
+
+	doneTasks, err := suite.repo.ListTopByUserAndStatus(suite.ctx, suite.userID, model.StatusDone, 3, repository.ColumnOrder{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), doneTasks, 1)
+	assert.Equal(suite.T(), "Done Task", doneTasks[0].Title)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListTopByUserAndStatus_ColumnOrder() {
+	for i, priority := range []model.TaskPriority{model.PriorityHigh, model.PriorityLow, model.PriorityUrgent} {
+		task := &model.Task{
+			UserID:   suite.userID,
+			Title:    fmt.Sprintf("Done Task %d", i),
+			Status:   model.StatusDone,
+			Priority: priority,
+		}
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+
+	// Touch the first task so it was updated last
+	tasks, err := suite.repo.ListTopByUserAndStatus(suite.ctx, suite.userID, model.StatusDone, 3, repository.ColumnOrder{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), tasks, 3)
+	lastUpdated := tasks[len(tasks)-1]
+	lastUpdated.Description = "touched"
+	_, err = suite.repo.Update(suite.ctx, lastUpdated)
+	assert.NoError(suite.T(), err)
+
+	byUpdated, err := suite.repo.ListTopByUserAndStatus(suite.ctx, suite.userID, model.StatusDone, 3,
+		repository.ColumnOrder{SortBy: "updated_at", SortDesc: true})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), lastUpdated.ID, byUpdated[0].ID)
+
+	// Ascending priority puts the least urgent task first
+	byPriority, err := suite.repo.ListTopByUserAndStatus(suite.ctx, suite.userID, model.StatusDone, 3,
+		repository.ColumnOrder{SortBy: "priority"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.PriorityLow, byPriority[0].Priority)
+	assert.Equal(suite.T(), model.PriorityUrgent, byPriority[2].Priority)
+
+	byPriority, err = suite.repo.ListTopByUserAndStatus(suite.ctx, suite.userID, model.StatusDone, 3,
+		repository.ColumnOrder{SortBy: "priority", SortDesc: true})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.PriorityUrgent, byPriority[0].Priority)
+	assert.Equal(suite.T(), model.PriorityLow, byPriority[2].Priority)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCountByUser() {
+	seed := []struct {
+		status   model.TaskStatus
+		priority model.TaskPriority
+	}{
+		{model.StatusTodo, model.PriorityHigh},
+		{model.StatusTodo, model.PriorityLow},
+		{model.StatusInProgress, model.PriorityHigh},
+		{model.StatusDone, model.PriorityMedium},
+	}
+	for i, s := range seed {
+		task := &model.Task{
+			UserID:   suite.userID,
+			Title:    fmt.Sprintf("Count Task %d", i),
+			Status:   s.status,
+			Priority: s.priority,
+		}
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+
+	// Another user's tasks are never counted
+	_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: uuid.New().String(), Title: "Other"})
+	assert.NoError(suite.T(), err)
+
+	todo := "TODO"
+	high := "HIGH"
+	tests := []struct {
+		name   string
+		filter *repository.TaskFilter
+		want   int64
+	}{
+		{"no filter", &repository.TaskFilter{}, 4},
+		{"status", &repository.TaskFilter{Status: &todo}, 2},
+		{"priority", &repository.TaskFilter{Priority: &high}, 2},
+		{"status and priority", &repository.TaskFilter{Status: &todo, Priority: &high}, 1},
+	}
+	for _, tt := range tests {
+		count, err := suite.repo.CountByUser(suite.ctx, suite.userID, tt.filter)
+		assert.NoError(suite.T(), err, tt.name)
+		assert.Equal(suite.T(), tt.want, count, tt.name)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCountByUser_UpdatedAfter() {
+	_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Seen"})
+	assert.NoError(suite.T(), err)
+	edited, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Edited after seen"})
+	assert.NoError(suite.T(), err)
+
+	lastSeen := time.Now().UTC()
+	time.Sleep(10 * time.Millisecond)
+
+	edited.Title = "Edited after seen (v2)"
+	_, err = suite.repo.Update(suite.ctx, edited, "title")
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Created after seen"})
+	assert.NoError(suite.T(), err)
+
+	count, err := suite.repo.CountByUser(suite.ctx, suite.userID, &repository.TaskFilter{UpdatedAfter: &lastSeen})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), count)
+
+	// Nothing has changed since a later mark
+	later := time.Now().UTC()
+	count, err = suite.repo.CountByUser(suite.ctx, suite.userID, &repository.TaskFilter{UpdatedAfter: &later})
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), count)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUser_SortByUrgency() {
+	now := time.Now().UTC().Truncate(time.Second)
+	weights := repository.DefaultUrgencyWeights()
+	seeded := []*model.Task{
+		{Title: "Urgent", Status: model.StatusTodo, Priority: model.PriorityUrgent, UpdatedAt: now},
+		{Title: "Overdue", Status: model.StatusTodo, Priority: model.PriorityLow, DueDate: timePtr(now.Add(-time.Hour)), UpdatedAt: now},
+		{Title: "Due midweek", Status: model.StatusInProgress, Priority: model.PriorityMedium, DueDate: timePtr(now.Add(84 * time.Hour)), UpdatedAt: now},
+		{Title: "Stale high", Status: model.StatusTodo, Priority: model.PriorityHigh, UpdatedAt: now.AddDate(0, 0, -30)},
+		{Title: "Done", Status: model.StatusDone, Priority: model.PriorityUrgent, DueDate: timePtr(now.Add(-time.Hour)), UpdatedAt: now},
+	}
+	for _, task := range seeded {
+		task.UserID = suite.userID
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{
+		SortBy:    "urgency",
+		SortDesc:  true,
+		Urgency:   &weights,
+		UrgencyAt: now,
+	}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), total)
+
+	titles := make([]string, len(tasks))
+	for i, task := range tasks {
+		titles[i] = task.Title
+		// The SQL ordering must agree with the score reported to clients
+		if i > 0 {
+			assert.GreaterOrEqual(suite.T(), weights.Score(tasks[i-1], now), weights.Score(task, now))
+		}
+	}
+	assert.Equal(suite.T(), []string{"Overdue", "Due midweek", "Stale high", "Urgent", "Done"}, titles)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestDeleteCompletedByUser() {
+	ids := make(map[model.TaskStatus]string)
+	for _, taskStatus := range []model.TaskStatus{model.StatusTodo, model.StatusInProgress, model.StatusDone, model.StatusArchived} {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: string(taskStatus), Status: taskStatus})
+		assert.NoError(suite.T(), err)
+		ids[taskStatus] = task.ID
+	}
+	otherUser := uuid.New().String()
+	othersDone, err := suite.repo.Create(suite.ctx, &model.Task{UserID: otherUser, Title: "Someone else's", Status: model.StatusDone})
+	assert.NoError(suite.T(), err)
+
+	deleted, err := suite.repo.DeleteCompletedByUser(suite.ctx, suite.userID)
+	assert.NoError(suite.T(), err)
+
+	deletedIDs := make([]string, len(deleted))
+	for i, task := range deleted {
+		deletedIDs[i] = task.ID
+	}
+	assert.ElementsMatch(suite.T(), []string{ids[model.StatusDone], ids[model.StatusArchived]}, deletedIDs)
+
+	remaining, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, nil, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	for _, task := range remaining {
+		assert.Contains(suite.T(), []model.TaskStatus{model.StatusTodo, model.StatusInProgress}, task.Status)
+	}
+
+	// Deleted tasks land in the trash rather than disappearing
+	_, err = suite.repo.FindByIDAndUserIncludingDeleted(suite.ctx, ids[model.StatusDone], suite.userID)
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.repo.FindByID(suite.ctx, othersDone.ID)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListHidesArchivedByDefault() {
+	for _, taskStatus := range []model.TaskStatus{model.StatusTodo, model.StatusDone, model.StatusArchived} {
+		_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: string(taskStatus), Status: taskStatus})
+		assert.NoError(suite.T(), err)
+	}
+
+	titles := func(tasks []*model.Task) []string {
+		var titles []string
+		for _, task := range tasks {
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.ElementsMatch(suite.T(), []string{"todo", "done"}, titles(tasks))
+
+	tasks, total, err = suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{IncludeArchived: true}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+	assert.ElementsMatch(suite.T(), []string{"todo", "done", "archived"}, titles(tasks))
+
+	// Asking for the archived status is asking for archived tasks
+	archived := "ARCHIVED"
+	tasks, _, err = suite.repo.List(suite.ctx, &repository.TaskFilter{UserID: &suite.userID, Status: &archived}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"archived"}, titles(tasks))
+
+	tasks, _, err = suite.repo.List(suite.ctx, &repository.TaskFilter{UserID: &suite.userID}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.ElementsMatch(suite.T(), []string{"todo", "done"}, titles(tasks))
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCountByUserMatchesListTotal() {
+	for _, taskStatus := range []model.TaskStatus{model.StatusTodo, model.StatusDone, model.StatusArchived} {
+		_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: string(taskStatus), Status: taskStatus})
+		assert.NoError(suite.T(), err)
+	}
+
+	for _, filter := range []*repository.TaskFilter{nil, {}, {IncludeArchived: true}} {
+		_, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, filter, 1, 10)
+		assert.NoError(suite.T(), err)
+		count, err := suite.repo.CountByUser(suite.ctx, suite.userID, filter)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), total, count)
+	}
+
+	count, err := suite.repo.CountByUser(suite.ctx, suite.userID, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), count)
+	count, err = suite.repo.CountByUser(suite.ctx, suite.userID, &repository.TaskFilter{IncludeArchived: true})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), count)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUserCompletionWindow() {
+	now := time.Now().UTC().Truncate(time.Second)
+	completedAt := map[string]*time.Time{
+		"Last month": timePtr(now.AddDate(0, -1, 0)),
+		"Yesterday":  timePtr(now.AddDate(0, 0, -1)),
+		"Not done":   nil,
+	}
+	for title, at := range completedAt {
+		taskStatus := model.StatusTodo
+		if at != nil {
+			taskStatus = model.StatusDone
+		}
+		_, err := suite.repo.Create(suite.ctx, &model.Task{
+			UserID:      suite.userID,
+			Title:       title,
+			Status:      taskStatus,
+			CompletedAt: at,
+		})
+		assert.NoError(suite.T(), err)
+	}
+
+	after := now.AddDate(0, 0, -7)
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{CompletedAfter: &after}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), tasks, 1) {
+		assert.Equal(suite.T(), "Yesterday", tasks[0].Title)
+	}
+
+	tasks, _, err = suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{CompletedBefore: &after}, 1, 10)
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), tasks, 1) {
+		assert.Equal(suite.T(), "Last month", tasks[0].Title)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUserDueWindow() {
+	now := time.Now().UTC().Truncate(time.Second)
+	dueDates := map[string]*time.Time{
+		"Overdue":     timePtr(now.AddDate(0, 0, -3)),
+		"This week":   timePtr(now.AddDate(0, 0, 2)),
+		"Next month":  timePtr(now.AddDate(0, 1, 0)),
+		"No due date": nil,
+	}
+	for title, dueDate := range dueDates {
+		_, err := suite.repo.Create(suite.ctx, &model.Task{
+			UserID:  suite.userID,
+			Title:   title,
+			DueDate: dueDate,
+		})
+		assert.NoError(suite.T(), err)
+	}
+
+	after := now
+	before := now.AddDate(0, 0, 7)
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{DueAfter: &after, DueBefore: &before}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), tasks, 1) {
+		assert.Equal(suite.T(), "This week", tasks[0].Title)
+	}
+
+	// A single bound still excludes tasks without a due date
+	tasks, total, err = suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{DueBefore: &before}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Len(suite.T(), tasks, 2)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCreateBatch() {
+	tasks := []*model.Task{
+		{UserID: suite.userID, Title: "First"},
+		{UserID: suite.userID, Title: "Second"},
+	}
+	err := suite.repo.CreateBatch(suite.ctx, tasks)
+	assert.NoError(suite.T(), err)
+	for _, task := range tasks {
+		assert.NotEmpty(suite.T(), task.ID)
+	}
+
+	// A failing item rolls back the items inserted before it
+	failing := []*model.Task{
+		{UserID: suite.userID, Title: "Third"},
+		{UserID: suite.userID, Title: strings.Repeat("x", 300)},
+	}
+	err = suite.repo.CreateBatch(suite.ctx, failing)
+	var batchErr *repository.BatchError
+	if assert.ErrorAs(suite.T(), err, &batchErr) {
+		assert.Equal(suite.T(), 1, batchErr.Index)
+	}
+
+	count, err := suite.repo.CountByUser(suite.ctx, suite.userID, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), count)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestSoftDeleteAndRestore() {
+	kept, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Kept"})
+	assert.NoError(suite.T(), err)
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Trashed"})
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+
+	// Hidden from lookups and normal lists
+	found, err := suite.repo.FindByID(suite.ctx, trashed.ID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), found)
+
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, nil, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), tasks, 1) {
+		assert.Equal(suite.T(), kept.ID, tasks[0].ID)
+	}
+
+	// Visible with the flag, marked as deleted
+	tasks, total, err = suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{IncludeDeleted: true}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	for _, task := range tasks {
+		assert.Equal(suite.T(), task.ID == trashed.ID, task.DeletedAt.Valid)
+	}
+
+	// Restoring someone else's task is a no-op
+	restored, err := suite.repo.Restore(suite.ctx, trashed.ID, uuid.New().String())
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), restored)
+
+	restored, err = suite.repo.Restore(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	if assert.NotNil(suite.T(), restored) {
+		assert.False(suite.T(), restored.DeletedAt.Valid)
+	}
+
+	_, total, err = suite.repo.ListByUser(suite.ctx, suite.userID, nil, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestRestoreAllByUser() {
+	otherUserID := uuid.New().String()
+
+	kept, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Kept", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+	var trashed []string
+	for _, title := range []string{"First", "Second", "Third"} {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: model.StatusTodo})
+		assert.NoError(suite.T(), err)
+		_, err = suite.repo.DeleteByUser(suite.ctx, task.ID, suite.userID)
+		assert.NoError(suite.T(), err)
+		trashed = append(trashed, task.ID)
+	}
+	other, err := suite.repo.Create(suite.ctx, &model.Task{UserID: otherUserID, Title: "Other"})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, other.ID, otherUserID)
+	assert.NoError(suite.T(), err)
+
+	// A window that ends before the deletions restores nothing
+	future := time.Now().Add(time.Hour)
+	restored, err := suite.repo.RestoreAllByUser(suite.ctx, suite.userID, &future)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), restored)
+
+	restored, err = suite.repo.RestoreAllByUser(suite.ctx, suite.userID, nil)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), restored, 3)
+
+	// They reappear after the task that stayed, in their old order
+	todo := "TODO"
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Status: &todo, SortBy: "position"}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(4), total)
+	var ids []string
+	for i, task := range tasks {
+		assert.Equal(suite.T(), i, task.Position, task.Title)
+		ids = append(ids, task.ID)
+	}
+	assert.Equal(suite.T(), append([]string{kept.ID}, trashed...), ids)
+
+	// Other users' trash is left alone
+	found, err := suite.repo.FindByID(suite.ctx, other.ID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), found)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestPurge() {
+	live, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Live"})
+	assert.NoError(suite.T(), err)
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Trashed"})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+
+	// Live tasks and other users' trash are rejected
+	purged, err := suite.repo.Purge(suite.ctx, live.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), purged)
+	purged, err = suite.repo.Purge(suite.ctx, trashed.ID, uuid.New().String())
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), purged)
+
+	purged, err = suite.repo.Purge(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	if assert.NotNil(suite.T(), purged) {
+		assert.Equal(suite.T(), trashed.ID, purged.ID)
+	}
+
+	// Gone for good, and the live task is untouched
+	found, err := suite.repo.FindByIDAndUserIncludingDeleted(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), found)
+	found, err = suite.repo.FindByID(suite.ctx, live.ID)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), found)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestPurgeByUser() {
+	otherUserID := uuid.New().String()
+
+	_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Active"})
+	assert.NoError(suite.T(), err)
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Trashed"})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: otherUserID, Title: "Someone else's"})
+	assert.NoError(suite.T(), err)
+
+	webhookRepo := repository.NewWebhookRepository(suite.db)
+	hook, err := webhookRepo.Create(suite.ctx, &model.Webhook{UserID: suite.userID, URL: "https://example.com/hook", Secret: "secret"})
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), webhookRepo.CreateDeadLetter(suite.ctx, &model.WebhookDeadLetter{WebhookID: hook.ID, EventType: "task.created", Payload: "{}"}))
+
+	result, err := suite.repo.PurgeByUser(suite.ctx, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Tasks, 2)
+	for _, task := range result.Tasks {
+		assert.Equal(suite.T(), task.ID == trashed.ID, task.DeletedAt.Valid)
+	}
+	assert.Equal(suite.T(), int64(1), result.WebhooksDeleted)
+
+	// Nothing of the user is left, not even in the trash
+	var remaining int64
+	suite.db.Unscoped().Model(&model.Task{}).Where("user_id = ?", suite.userID).Count(&remaining)
+	assert.Zero(suite.T(), remaining)
+	suite.db.Model(&model.Webhook{}).Where("user_id = ?", suite.userID).Count(&remaining)
+	assert.Zero(suite.T(), remaining)
+	suite.db.Model(&model.WebhookDeadLetter{}).Where("webhook_id = ?", hook.ID).Count(&remaining)
+	assert.Zero(suite.T(), remaining)
+
+	// Other users are untouched
+	count, err := suite.repo.CountByUser(suite.ctx, otherUserID, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), count)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUserTags() {
+	tagged := map[string]model.Tags{
+		"Both":      {"work", "urgent-review"},
+		"Work only": {"work"},
+		"Untagged":  nil,
+	}
+	for title, tags := range tagged {
+		_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Tags: tags})
+		assert.NoError(suite.T(), err)
+	}
+
+	// Tasks must carry every requested tag
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Tags: []string{"work", "Urgent-Review"}}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), tasks, 1) {
+		assert.Equal(suite.T(), "Both", tasks[0].Title)
+		assert.ElementsMatch(suite.T(), []string{"work", "urgent-review"}, tasks[0].Tags)
+	}
+
+	tasks, total, err = suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Tags: []string{"work"}}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Len(suite.T(), tasks, 2)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUserTags_CaseSensitive() {
+	for title, tags := range map[string]model.Tags{"Upper": {"Work"}, "Lower": {"work"}} {
+		_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Tags: tags})
+		assert.NoError(suite.T(), err)
+	}
+
+	tasks, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Tags: []string{" Work "}, CaseSensitiveTags: true}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), tasks, 1) {
+		assert.Equal(suite.T(), "Upper", tasks[0].Title)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestBulkSetDueDate() {
+	var ids []string
+	for i := 0; i < 3; i++ {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: fmt.Sprintf("Planned %d", i)})
+		assert.NoError(suite.T(), err)
+		ids = append(ids, task.ID)
+	}
+	otherUserID := uuid.New().String()
+	other, err := suite.repo.Create(suite.ctx, &model.Task{UserID: otherUserID, Title: "Not mine"})
+	assert.NoError(suite.T(), err)
+	defer suite.db.Unscoped().Where("user_id = ?", otherUserID).Delete(&model.Task{})
+
+	dueDate := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+	updated, err := suite.repo.BulkSetDueDate(suite.ctx, suite.userID, append(ids, other.ID), &dueDate)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), updated, 3)
+
+	for _, id := range ids {
+		task, err := suite.repo.FindByID(suite.ctx, id)
+		assert.NoError(suite.T(), err)
+		if assert.NotNil(suite.T(), task.DueDate) {
+			assert.True(suite.T(), dueDate.Equal(*task.DueDate))
+		}
+	}
+
+	// Another user's task is left alone
+	untouched, err := suite.repo.FindByID(suite.ctx, other.ID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), untouched.DueDate)
+
+	// A nil due date clears it
+	cleared, err := suite.repo.BulkSetDueDate(suite.ctx, suite.userID, ids[:2], nil)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), cleared, 2)
+
+	first, err := suite.repo.FindByID(suite.ctx, ids[0])
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), first.DueDate)
+	last, err := suite.repo.FindByID(suite.ctx, ids[2])
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), last.DueDate)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestFindByIDs() {
+	first, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "First"})
+	assert.NoError(suite.T(), err)
+	second, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Second"})
+	assert.NoError(suite.T(), err)
+
+	tasks, err := suite.repo.FindByIDs(suite.ctx, []string{second.ID, uuid.New().String(), first.ID})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), tasks, 2)
+
+	titles := []string{tasks[0].Title, tasks[1].Title}
+	assert.ElementsMatch(suite.T(), []string{"First", "Second"}, titles)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUpdatedBy() {
+	admin := uuid.New().String()
+	owner := suite.userID
+
+	edited, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Edited by admin", UpdatedBy: &owner})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Untouched", UpdatedBy: &owner})
+	assert.NoError(suite.T(), err)
+	restored, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Restored by owner", UpdatedBy: &admin})
+	assert.NoError(suite.T(), err)
+
+	edited.Title = "Edited by admin (v2)"
+	edited.UpdatedBy = &admin
+	_, err = suite.repo.Update(suite.ctx, edited, "title", "updated_by")
+	assert.NoError(suite.T(), err)
+
+	// The owner takes the last word on this one
+	_, err = suite.repo.Delete(suite.ctx, restored.ID)
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Restore(suite.ctx, restored.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+
+	found, total, err := suite.repo.ListByUpdatedBy(suite.ctx, admin, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), found, 1) {
+		assert.Equal(suite.T(), edited.ID, found[0].ID)
+	}
+
+	found, total, err = suite.repo.ListByUpdatedBy(suite.ctx, owner, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Len(suite.T(), found, 2)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListOverdue() {
+	now := time.Now()
+
+	create := func(title string, status model.TaskStatus, dueDate *time.Time) {
+		_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: status, DueDate: dueDate})
+		assert.NoError(suite.T(), err)
+	}
+	create("Slightly late", model.StatusInProgress, timePtr(now.Add(-time.Hour)))
+	create("Very late", model.StatusTodo, timePtr(now.Add(-72*time.Hour)))
+	create("Due tomorrow", model.StatusTodo, timePtr(now.Add(24*time.Hour)))
+	create("No due date", model.StatusTodo, nil)
+	create("Done late", model.StatusDone, timePtr(now.Add(-48*time.Hour)))
+	create("Archived late", model.StatusArchived, timePtr(now.Add(-48*time.Hour)))
+
+	found, total, err := suite.repo.ListOverdue(suite.ctx, suite.userID, now, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+
+	var titles []string
+	for _, task := range found {
+		titles = append(titles, task.Title)
+	}
+	assert.Equal(suite.T(), []string{"Very late", "Slightly late"}, titles)
+
+	// The count covers every page
+	found, total, err = suite.repo.ListOverdue(suite.ctx, suite.userID, now, 2, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	if assert.Len(suite.T(), found, 1) {
+		assert.Equal(suite.T(), "Slightly late", found[0].Title)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListDeleted() {
+	otherUserID := uuid.New().String()
+
+	_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Live"})
+	assert.NoError(suite.T(), err)
+	for _, title := range []string{"Deleted first", "Deleted last"} {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title})
+		assert.NoError(suite.T(), err)
+		_, err = suite.repo.DeleteByUser(suite.ctx, task.ID, suite.userID)
+		assert.NoError(suite.T(), err)
+	}
+	other, err := suite.repo.Create(suite.ctx, &model.Task{UserID: otherUserID, Title: "Other deleted"})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, other.ID, otherUserID)
+	assert.NoError(suite.T(), err)
+
+	// Only the user's trash, most recently deleted first
+	found, total, err := suite.repo.ListDeleted(suite.ctx, suite.userID, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	var titles []string
+	for _, task := range found {
+		assert.True(suite.T(), task.DeletedAt.Valid, task.Title)
+		titles = append(titles, task.Title)
+	}
+	assert.Equal(suite.T(), []string{"Deleted last", "Deleted first"}, titles)
+
+	// The count covers every page
+	found, total, err = suite.repo.ListDeleted(suite.ctx, suite.userID, 2, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	if assert.Len(suite.T(), found, 1) {
+		assert.Equal(suite.T(), "Deleted first", found[0].Title)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestBulkAddAndRemoveTags() {
+	create := func(userID, title string, tags model.Tags) *model.Task {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: userID, Title: title, Tags: tags})
+		assert.NoError(suite.T(), err)
+		return task
+	}
+	tagged := create(suite.userID, "Tagged", model.Tags{"work"})
+	plain := create(suite.userID, "Plain", nil)
+	skipped := create(suite.userID, "Not listed", model.Tags{"home"})
+	other := create(uuid.New().String(), "Not mine", model.Tags{"work"})
+
+	// Only the listed tasks of the user change, and only where a tag is new
+	updated, err := suite.repo.BulkAddTags(suite.ctx, suite.userID, []string{tagged.ID, plain.ID, other.ID}, model.Tags{"work", "urgent"}, 20)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), updated, 2)
+	suite.assertTags(tagged.ID, model.Tags{"work", "urgent"})
+	suite.assertTags(plain.ID, model.Tags{"work", "urgent"})
+	suite.assertTags(skipped.ID, model.Tags{"home"})
+	suite.assertTags(other.ID, model.Tags{"work"})
+
+	// Going over the limit changes nothing
+	_, err = suite.repo.BulkAddTags(suite.ctx, suite.userID, []string{tagged.ID, skipped.ID}, model.Tags{"extra"}, 2)
+	assert.ErrorIs(suite.T(), err, repository.ErrTooManyTags)
+	suite.assertTags(skipped.ID, model.Tags{"home"})
+
+	updated, err = suite.repo.BulkRemoveTags(suite.ctx, suite.userID, []string{tagged.ID, skipped.ID, other.ID}, model.Tags{"work"})
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), updated, 1) {
+		assert.Equal(suite.T(), tagged.ID, updated[0].ID)
+	}
+	suite.assertTags(tagged.ID, model.Tags{"urgent"})
+	suite.assertTags(plain.ID, model.Tags{"work", "urgent"})
+	suite.assertTags(other.ID, model.Tags{"work"})
+}
+
+func (suite *RepositoryIntegrationTestSuite) assertTags(id string, want model.Tags) {
+	var task model.Task
+	assert.NoError(suite.T(), suite.db.Where("id = ?", id).Take(&task).Error)
+	assert.Equal(suite.T(), want, task.Tags, task.Title)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestSnoozeOverdue() {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	lateDue := now.Add(-2 * time.Hour)
+	futureDue := now.Add(24 * time.Hour)
+
+	create := func(title string, status model.TaskStatus, dueDate *time.Time) *model.Task {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: status, DueDate: dueDate})
+		assert.NoError(suite.T(), err)
+		return task
+	}
+	late := create("Late", model.StatusTodo, timePtr(lateDue))
+	future := create("Due tomorrow", model.StatusTodo, timePtr(futureDue))
+	undated := create("No due date", model.StatusTodo, nil)
+	done := create("Done late", model.StatusDone, timePtr(lateDue))
+
+	snoozed, err := suite.repo.SnoozeOverdue(suite.ctx, suite.userID, now, 72*time.Hour)
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), snoozed, 1) {
+		assert.Equal(suite.T(), late.ID, snoozed[0].ID)
+	}
+
+	dueDate := func(id string) *time.Time {
+		task, err := suite.repo.FindByID(suite.ctx, id)
+		assert.NoError(suite.T(), err)
+		return task.DueDate
+	}
+	assert.True(suite.T(), lateDue.Add(72*time.Hour).Equal(*dueDate(late.ID)))
+	assert.True(suite.T(), futureDue.Equal(*dueDate(future.ID)))
+	assert.Nil(suite.T(), dueDate(undated.ID))
+	assert.True(suite.T(), lateDue.Equal(*dueDate(done.ID)))
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestExistingIDs() {
+	task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Still here"})
+	assert.NoError(suite.T(), err)
+
+	existing, err := suite.repo.ExistingIDs(suite.ctx, []string{task.ID, uuid.New().String()})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{task.ID}, existing)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListByUserSearch() {
+	tasks := []*model.Task{
+		{UserID: suite.userID, Title: "Write quarterly report"},
+		{UserID: suite.userID, Title: "Book flights", Description: "Find a cheap REPORT on fares"},
+		{UserID: suite.userID, Title: "Water plants", Description: "Balcony and kitchen"},
+		{UserID: suite.userID, Title: "100% coverage"},
+		{UserID: "other-user", Title: "Another report"},
+	}
+	for _, task := range tasks {
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+
+	// Matches title or description, case-insensitively, for this user only
+	search := "report"
+	found, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Search: &search}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	titles := make([]string, 0, len(found))
+	for _, task := range found {
+		titles = append(titles, task.Title)
+	}
+	assert.ElementsMatch(suite.T(), []string{"Write quarterly report", "Book flights"}, titles)
+
+	// LIKE wildcards in the term match literally
+	search = "0%"
+	found, total, err = suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Search: &search}, 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	if assert.Len(suite.T(), found, 1) {
+		assert.Equal(suite.T(), "100% coverage", found[0].Title)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestSearchRanksTitleMatchesFirst() {
+	tasks := []*model.Task{
+		{UserID: suite.userID, Title: "Write quarterly report"},
+		{UserID: suite.userID, Title: "Book flights", Description: "Attach the REPORT"},
+		{UserID: suite.userID, Title: "Pack bags", Tags: model.Tags{"travel-report"}},
+		{UserID: suite.userID, Title: "Water plants"},
+		{UserID: "other-user", Title: "Another report"},
+	}
+	for _, task := range tasks {
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+
+	found, total, err := suite.repo.Search(suite.ctx, suite.userID, "report", 1, 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+	titles := make([]string, 0, len(found))
+	for _, task := range found {
+		titles = append(titles, task.Title)
+	}
+	// Title match, then description match, then tag match, even though
+	// newest-first would put the tag match on top
+	assert.Equal(suite.T(), []string{"Write quarterly report", "Book flights", "Pack bags"}, titles)
+
+	// Pages follow the ranking
+	found, total, err = suite.repo.Search(suite.ctx, suite.userID, "report", 2, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+	if assert.Len(suite.T(), found, 1) {
+		assert.Equal(suite.T(), "Book flights", found[0].Title)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListTotalsReflectFilters() {
+	now := time.Now()
+	tasks := []*model.Task{
+		{UserID: suite.userID, Title: "Draft report", Status: model.StatusTodo, Priority: model.PriorityHigh, DueDate: timePtr(now.Add(24 * time.Hour)), Tags: model.Tags{"work"}},
+		{UserID: suite.userID, Title: "Review report", Status: model.StatusInProgress, Priority: model.PriorityHigh, DueDate: timePtr(now.Add(72 * time.Hour)), Tags: model.Tags{"work", "review"}},
+		{UserID: suite.userID, Title: "Buy milk", Description: "Skimmed", Status: model.StatusTodo, Priority: model.PriorityLow},
+		{UserID: suite.userID, Title: "Call plumber", Status: model.StatusDone, Priority: model.PriorityMedium, DueDate: timePtr(now.Add(-24 * time.Hour))},
+		{UserID: "other-user", Title: "Other report", Status: model.StatusTodo, Priority: model.PriorityHigh, Tags: model.Tags{"work"}},
+	}
+	for _, task := range tasks {
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Old report", Status: model.StatusTodo, Priority: model.PriorityHigh, Tags: model.Tags{"work"}})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+
+	status := "TODO"
+	priority := "HIGH"
+	search := "report"
+	after := now
+	before := now.Add(48 * time.Hour)
+
+	cases := []struct {
+		name   string
+		filter repository.TaskFilter
+		want   int64
+	}{
+		{"no filter", repository.TaskFilter{}, 4},
+		{"status", repository.TaskFilter{Status: &status}, 2},
+		{"priority", repository.TaskFilter{Priority: &priority}, 2},
+		{"status and priority", repository.TaskFilter{Status: &status, Priority: &priority}, 1},
+		{"search", repository.TaskFilter{Search: &search}, 2},
+		{"due window", repository.TaskFilter{DueAfter: &after, DueBefore: &before}, 1},
+		{"tags", repository.TaskFilter{Tags: []string{"work"}}, 2},
+		{"include deleted", repository.TaskFilter{IncludeDeleted: true}, 5},
+		{"tags including deleted", repository.TaskFilter{Tags: []string{"work"}, IncludeDeleted: true}, 3},
+	}
+	for _, tc := range cases {
+		// A page size of 1 keeps the page shorter than every expected total
+		filter := tc.filter
+		page, total, err := suite.repo.ListByUser(suite.ctx, suite.userID, &filter, 1, 1)
+		assert.NoError(suite.T(), err, tc.name)
+		assert.Equal(suite.T(), tc.want, total, "ListByUser: %s", tc.name)
+		assert.Len(suite.T(), page, 1, tc.name)
+
+		filter.UserID = &suite.userID
+		page, total, err = suite.repo.List(suite.ctx, &filter, 1, 1)
+		assert.NoError(suite.T(), err, tc.name)
+		assert.Equal(suite.T(), tc.want, total, "List: %s", tc.name)
+		assert.Len(suite.T(), page, 1, tc.name)
+	}
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCountByStatusAndPriority() {
+	seeded := []struct {
+		status   model.TaskStatus
+		priority model.TaskPriority
+		count    int
+	}{
+		{model.StatusTodo, model.PriorityHigh, 3},
+		{model.StatusTodo, model.PriorityLow, 1},
+		{model.StatusInProgress, model.PriorityHigh, 2},
+		{model.StatusDone, model.PriorityUrgent, 1},
+	}
+	for _, cell := range seeded {
+		for i := 0; i < cell.count; i++ {
+			_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Seeded", Status: cell.status, Priority: cell.priority})
+			assert.NoError(suite.T(), err)
+		}
+	}
+	// Neither deleted tasks nor other users' tasks are counted
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Trashed", Status: model.StatusTodo, Priority: model.PriorityHigh})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: uuid.New().String(), Title: "Someone else's", Status: model.StatusTodo, Priority: model.PriorityHigh})
+	assert.NoError(suite.T(), err)
+
+	matrix, err := suite.repo.CountByStatusAndPriority(suite.ctx, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[model.TaskStatus]map[model.TaskPriority]int64{
+		model.StatusTodo:       {model.PriorityHigh: 3, model.PriorityLow: 1},
+		model.StatusInProgress: {model.PriorityHigh: 2},
+		model.StatusDone:       {model.PriorityUrgent: 1},
+	}, matrix)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCountByStatus() {
+	for status, count := range map[model.TaskStatus]int{model.StatusTodo: 5, model.StatusInProgress: 2, model.StatusDone: 10} {
+		for i := 0; i < count; i++ {
+			_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Seeded", Status: status})
+			assert.NoError(suite.T(), err)
+		}
+	}
+	// Neither deleted tasks nor other users' tasks are counted
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Trashed", Status: model.StatusArchived})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: uuid.New().String(), Title: "Someone else's", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+
+	counts, err := suite.repo.CountByStatus(suite.ctx, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[model.TaskStatus]int64{
+		model.StatusTodo:       5,
+		model.StatusInProgress: 2,
+		model.StatusDone:       10,
+	}, counts)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestMetricsByUser() {
+	now := time.Now()
+	past := now.Add(-48 * time.Hour)
+	future := now.Add(48 * time.Hour)
+
+	seeds := []*model.Task{
+		{Title: "Overdue todo", Status: model.StatusTodo, DueDate: &past},
+		{Title: "Overdue in progress", Status: model.StatusInProgress, DueDate: &past},
+		{Title: "Done late", Status: model.StatusDone, DueDate: &past},
+		{Title: "Archived late", Status: model.StatusArchived, DueDate: &past},
+		{Title: "Done", Status: model.StatusDone},
+		{Title: "Upcoming", Status: model.StatusTodo, DueDate: &future},
+	}
+	var lastActivity time.Time
+	for _, task := range seeds {
+		task.UserID = suite.userID
+		created, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+		if created.UpdatedAt.After(lastActivity) {
+			lastActivity = created.UpdatedAt
+		}
+	}
+	// Neither deleted tasks nor other users' tasks are counted
+	trashed, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Trashed", Status: model.StatusTodo, DueDate: &past})
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.DeleteByUser(suite.ctx, trashed.ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: uuid.New().String(), Title: "Someone else's", Status: model.StatusDone})
+	assert.NoError(suite.T(), err)
+
+	metrics, err := suite.repo.MetricsByUser(suite.ctx, suite.userID, now)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(6), metrics.Total)
+	assert.Equal(suite.T(), int64(2), metrics.Completed)
+	assert.Equal(suite.T(), int64(2), metrics.Overdue)
+	if assert.NotNil(suite.T(), metrics.LastActivityAt) {
+		assert.WithinDuration(suite.T(), lastActivity, *metrics.LastActivityAt, time.Millisecond)
+	}
+
+	// A user without tasks has no activity
+	empty, err := suite.repo.MetricsByUser(suite.ctx, uuid.New().String(), now)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &model.TaskMetrics{}, empty)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCountCompletedByPeriod() {
+	at := func(day, hour int) *time.Time {
+		t := time.Date(2025, 3, day, hour, 0, 0, 0, time.UTC)
+		return &t
+	}
+	seeds := []*model.Task{
+		{Title: "Early on the 3rd", Status: model.StatusDone, CompletedAt: at(3, 1)},
+		{Title: "Late on the 3rd", Status: model.StatusDone, CompletedAt: at(3, 23)},
+		{Title: "On the 5th", Status: model.StatusDone, CompletedAt: at(5, 12)},
+		{Title: "On the 11th", Status: model.StatusDone, CompletedAt: at(11, 9)},
+		{Title: "Before the range", Status: model.StatusDone, CompletedAt: at(2, 23)},
+		{Title: "After the range", Status: model.StatusDone, CompletedAt: at(17, 0)},
+		{Title: "Never done", Status: model.StatusTodo},
+	}
+	for _, task := range seeds {
+		task.UserID = suite.userID
+		_, err := suite.repo.Create(suite.ctx, task)
+		assert.NoError(suite.T(), err)
+	}
+	_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: uuid.New().String(), Title: "Someone else's", Status: model.StatusDone, CompletedAt: at(3, 12)})
+	assert.NoError(suite.T(), err)
+
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
+
+	days, err := suite.repo.CountCompletedByPeriod(suite.ctx, suite.userID, "day", from, to)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[time.Time]int64{
+		time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC):  2,
+		time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC):  1,
+		time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC): 1,
+	}, days)
+
+	// Weeks start on Monday the 3rd and 10th
+	weeks, err := suite.repo.CountCompletedByPeriod(suite.ctx, suite.userID, "week", from, to)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[time.Time]int64{
+		time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC):  3,
+		time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC): 1,
+	}, weeks)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestListAttentionCandidates() {
+	now := time.Now()
+	dueBefore := now.Add(48 * time.Hour)
+	staleBefore := now.Add(-72 * time.Hour)
+
+	create := func(title string, status model.TaskStatus, priority model.TaskPriority, dueDate *time.Time) *model.Task {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: status, Priority: priority, DueDate: dueDate})
+		assert.NoError(suite.T(), err)
+		return task
+	}
+	create("Overdue", model.StatusTodo, model.PriorityLow, timePtr(now.Add(-time.Hour)))
+	create("Due soon", model.StatusInProgress, model.PriorityLow, timePtr(now.Add(24*time.Hour)))
+	neglected := create("Neglected", model.StatusTodo, model.PriorityUrgent, nil)
+	create("Fresh urgent", model.StatusTodo, model.PriorityUrgent, nil)
+	create("Due later", model.StatusTodo, model.PriorityLow, timePtr(now.Add(7*24*time.Hour)))
+	create("Done late", model.StatusDone, model.PriorityLow, timePtr(now.Add(-time.Hour)))
+	assert.NoError(suite.T(), suite.db.Model(&model.Task{}).Where("id = ?", neglected.ID).
+		UpdateColumn("updated_at", now.Add(-5*24*time.Hour)).Error)
+
+	tasks, err := suite.repo.ListAttentionCandidates(suite.ctx, suite.userID, dueBefore, staleBefore)
+	assert.NoError(suite.T(), err)
+
+	var titles []string
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+	}
+	assert.ElementsMatch(suite.T(), []string{"Overdue", "Due soon", "Neglected"}, titles)
+}
+
+// setPosition stores position as is, bypassing the repository, to seed the
+// gaps and ties that rows written before positions were kept contiguous have.
+func (suite *RepositoryIntegrationTestSuite) setPosition(task *model.Task, position int) {
+	err := suite.db.Model(&model.Task{}).Where("id = ?", task.ID).UpdateColumn("position", position).Error
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestCreateAndUpdate_KeepColumnsContiguous() {
+	create := func(title string, status model.TaskStatus) *model.Task {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: status})
+		assert.NoError(suite.T(), err)
+		return task
+	}
+	position := func(task *model.Task) int {
+		stored, err := suite.repo.FindByID(suite.ctx, task.ID)
+		assert.NoError(suite.T(), err)
+		return stored.Position
+	}
+
+	// Each column is numbered on its own, and other users' tasks don't count
+	todoA := create("Todo A", model.StatusTodo)
+	todoB := create("Todo B", model.StatusTodo)
+	doingA := create("Doing A", model.StatusInProgress)
+	_, err := suite.repo.Create(suite.ctx, &model.Task{UserID: uuid.New().String(), Title: "Other", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []int{0, 1, 0}, []int{todoA.Position, todoB.Position, doingA.Position})
+
+	batch := []*model.Task{
+		{UserID: suite.userID, Title: "Todo C", Status: model.StatusTodo},
+		{UserID: suite.userID, Title: "Todo D", Status: model.StatusTodo},
+		{UserID: suite.userID, Title: "Doing B", Status: model.StatusInProgress},
+	}
+	assert.NoError(suite.T(), suite.repo.CreateBatch(suite.ctx, batch))
+	assert.Equal(suite.T(), []int{2, 3, 1}, []int{batch[0].Position, batch[1].Position, batch[2].Position})
+
+	// A status change closes the old column and goes last in the new one
+	todoB.Status = model.StatusInProgress
+	updated, err := suite.repo.Update(suite.ctx, todoB, "status")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, updated.Position)
+	assert.Equal(suite.T(), []int{0, 1, 2}, []int{position(todoA), position(batch[0]), position(batch[1])})
+
+	// Updates that keep the status keep the position
+	todoA.Title = "Todo A (v2)"
+	updated, err = suite.repo.Update(suite.ctx, todoA)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, updated.Position)
+
+	// The next instance of a recurring task goes last too
+	doingA.Status = model.StatusDone
+	next := &model.Task{UserID: suite.userID, Title: "Todo E", Status: model.StatusTodo}
+	_, err = suite.repo.UpdateWithNext(suite.ctx, doingA, next, "status")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, next.Position)
+	assert.Equal(suite.T(), 0, position(doingA))
+	assert.Equal(suite.T(), []int{0, 1}, []int{position(batch[2]), position(todoB)})
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestReorder_KeepsPositionsContiguous() {
+	create := func(title string, status model.TaskStatus, position int) *model.Task {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: status})
+		assert.NoError(suite.T(), err)
+		suite.setPosition(task, position)
+		return task
+	}
+	// Gaps and ties are closed up by the first reorder
+	create("A", model.StatusTodo, 0)
+	moving := create("B", model.StatusTodo, 3)
+	create("C", model.StatusTodo, 3)
+	create("D", model.StatusTodo, 7)
+	doing := create("Doing", model.StatusInProgress, 5)
+
+	column := func() []string {
+		todo := "TODO"
+		tasks, _, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Status: &todo, SortBy: "position"}, 1, 10)
+		assert.NoError(suite.T(), err)
+		var titles []string
+		for i, task := range tasks {
+			assert.Equal(suite.T(), i, task.Position, task.Title)
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+
+	task, err := suite.repo.Reorder(suite.ctx, moving.ID, suite.userID, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, task.Position)
+	assert.Equal(suite.T(), []string{"B", "A", "C", "D"}, column())
+
+	task, err = suite.repo.Reorder(suite.ctx, moving.ID, suite.userID, 2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, task.Position)
+	assert.Equal(suite.T(), []string{"A", "C", "B", "D"}, column())
+
+	// Past the end puts it last
+	task, err = suite.repo.Reorder(suite.ctx, moving.ID, suite.userID, 99)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, task.Position)
+	assert.Equal(suite.T(), []string{"A", "C", "D", "B"}, column())
+
+	// New tasks go last, and reordering around them stays contiguous
+	created, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "E", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, created.Position)
+	assert.Equal(suite.T(), []string{"A", "C", "D", "B", "E"}, column())
+
+	_, err = suite.repo.Reorder(suite.ctx, created.ID, suite.userID, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"A", "E", "C", "D", "B"}, column())
+
+	_, err = suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "F", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"A", "E", "C", "D", "B", "F"}, column())
+
+	// Other columns are left alone
+	stored, err := suite.repo.FindByID(suite.ctx, doing.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5, stored.Position)
+
+	task, err = suite.repo.Reorder(suite.ctx, moving.ID, uuid.New().String(), 0)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), task)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestMoveOnBoard() {
+	create := func(title string, status model.TaskStatus, position int) *model.Task {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: status})
+		assert.NoError(suite.T(), err)
+		suite.setPosition(task, position)
+		return task
+	}
+	// Both columns start out with ties, as rows from before positions did
+	create("Todo A", model.StatusTodo, 0)
+	moving := create("Moving", model.StatusTodo, 0)
+	create("Todo C", model.StatusTodo, 0)
+	create("Doing A", model.StatusInProgress, 0)
+	create("Doing B", model.StatusInProgress, 0)
+	create("Doing C", model.StatusInProgress, 0)
+
+	column := func(status string) []string {
+		tasks, _, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Status: &status, SortBy: "position"}, 1, 10)
+		assert.NoError(suite.T(), err)
+		var titles []string
+		for i, task := range tasks {
+			assert.Equal(suite.T(), i, task.Position, task.Title)
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+
+	moved, err := suite.repo.MoveOnBoard(suite.ctx, moving.ID, suite.userID, model.StatusInProgress, 1, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusInProgress, moved.Status)
+	assert.Equal(suite.T(), 1, moved.Position)
+
+	// The old column closes up and the new one makes room
+	assert.Equal(suite.T(), []string{"Todo A", "Todo C"}, column("TODO"))
+	assert.Equal(suite.T(), []string{"Doing A", "Moving", "Doing B", "Doing C"}, column("IN_PROGRESS"))
+
+	// Moving within the column keeps it contiguous
+	moved, err = suite.repo.MoveOnBoard(suite.ctx, moving.ID, suite.userID, model.StatusInProgress, 3, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, moved.Position)
+	assert.Equal(suite.T(), []string{"Doing A", "Doing B", "Doing C", "Moving"}, column("IN_PROGRESS"))
+
+	// Positions past the end put the task last
+	moved, err = suite.repo.MoveOnBoard(suite.ctx, moving.ID, suite.userID, model.StatusTodo, 99, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, moved.Position)
+	assert.Equal(suite.T(), []string{"Todo A", "Todo C", "Moving"}, column("TODO"))
+	assert.Equal(suite.T(), []string{"Doing A", "Doing B", "Doing C"}, column("IN_PROGRESS"))
+
+	// Other users' tasks cannot be moved
+	moved, err = suite.repo.MoveOnBoard(suite.ctx, moving.ID, uuid.New().String(), model.StatusDone, 0, nil)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), moved)
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestDeleteAndRestore_KeepColumnContiguous() {
+	var tasks []*model.Task
+	for _, title := range []string{"A", "B", "C"} {
+		task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: title, Status: model.StatusTodo})
+		assert.NoError(suite.T(), err)
+		tasks = append(tasks, task)
+	}
+
+	column := func() []string {
+		todo := "TODO"
+		listed, _, err := suite.repo.ListByUser(suite.ctx, suite.userID, &repository.TaskFilter{Status: &todo, SortBy: "position"}, 1, 10)
+		assert.NoError(suite.T(), err)
+		var titles []string
+		for i, task := range listed {
+			assert.Equal(suite.T(), i, task.Position, task.Title)
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+
+	// Deleting closes the gap
+	_, err := suite.repo.DeleteByUser(suite.ctx, tasks[0].ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"B", "C"}, column())
+
+	// Restoring puts the task last
+	restored, err := suite.repo.Restore(suite.ctx, tasks[0].ID, suite.userID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, restored.Position)
+	assert.Equal(suite.T(), []string{"B", "C", "A"}, column())
+}
+
+func (suite *RepositoryIntegrationTestSuite) TestMoveOnBoard_StampsCompletedAt() {
+	task, err := suite.repo.Create(suite.ctx, &model.Task{UserID: suite.userID, Title: "Finish me", Status: model.StatusTodo})
+	assert.NoError(suite.T(), err)
+
+	done, err := suite.repo.MoveOnBoard(suite.ctx, task.ID, suite.userID, model.StatusDone, 0, nil)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), done.CompletedAt)
+
+	reopened, err := suite.repo.MoveOnBoard(suite.ctx, task.ID, suite.userID, model.StatusTodo, 0, nil)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), reopened.CompletedAt)
+
+	stored, err := suite.repo.FindByID(suite.ctx, task.ID)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), stored.CompletedAt)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
 func TestRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(RepositoryIntegrationTestSuite))
 }
\ No newline at end of file
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/interceptor"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const healthCheckMethod = "/grpc.health.v1.Health/Check"
+
+func TestAuthInterceptor(t *testing.T) {
+	authInterceptor := interceptor.NewAuthInterceptor("gateway-secret", []string{healthCheckMethod})
+	unary := authInterceptor.Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/todo.TodoService/GetTask"}
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		info     *grpc.UnaryServerInfo
+		wantCode codes.Code
+	}{
+		{
+			name:     "missing metadata",
+			ctx:      context.Background(),
+			info:     info,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "missing token",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "value")),
+			info:     info,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "wrong token",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptor.GatewayTokenMetadataKey, "wrong")),
+			info:     info,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "valid token",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptor.GatewayTokenMetadataKey, "gateway-secret")),
+			info:     info,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "exempt method",
+			ctx:      context.Background(),
+			info:     &grpc.UnaryServerInfo{FullMethod: healthCheckMethod},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := unary(tt.ctx, nil, tt.info, handler)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "ok", resp)
+			} else {
+				assert.Nil(t, resp)
+			}
+		})
+	}
+}
+
+func TestBuildChain(t *testing.T) {
+	var calls []string
+	named := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+
+	available := map[string]grpc.UnaryServerInterceptor{
+		"recovery": named("recovery"),
+		"logging":  named("logging"),
+		"auth":     nil, // disabled
+	}
+
+	chain, err := interceptor.BuildChain([]string{"logging", "auth", "recovery"}, available)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 2)
+
+	for _, unary := range chain {
+		_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			return nil, nil
+		})
+	}
+	assert.Equal(t, []string{"logging", "recovery"}, calls)
+
+	_, err = interceptor.BuildChain([]string{"unknown"}, available)
+	assert.Error(t, err)
+}
+
+func TestBuildChain_RejectsEnabledInterceptorMissingFromOrder(t *testing.T) {
+	noop := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	available := map[string]grpc.UnaryServerInterceptor{
+		"recovery": noop,
+		"logging":  noop,
+		"auth":     noop,
+	}
+
+	_, err := interceptor.BuildChain([]string{"recovery", "logging"}, available)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "auth")
+	}
+
+	// Switched off interceptors may be left out
+	available["auth"] = nil
+	chain, err := interceptor.BuildChain([]string{"recovery", "logging"}, available)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 2)
+}
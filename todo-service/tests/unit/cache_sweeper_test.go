@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCacheSweeper_RemovesDeletedTasks(t *testing.T) {
+	repo := new(MockTaskRepository)
+	taskCache := new(MockTaskCache)
+	ctx := context.Background()
+
+	taskCache.On("CachedTaskIDs", ctx).
+		Return([]string{"live-task", "deleted-task"}, nil).
+		Once()
+	repo.On("ExistingIDs", ctx, []string{"live-task", "deleted-task"}).
+		Return([]string{"live-task"}, nil).
+		Once()
+	taskCache.On("DeleteTask", ctx, "deleted-task").
+		Return(nil).
+		Once()
+
+	removed, err := service.NewCacheSweeper(repo, taskCache, 0).Sweep(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	taskCache.AssertNotCalled(t, "DeleteTask", mock.Anything, "live-task")
+	repo.AssertExpectations(t)
+	taskCache.AssertExpectations(t)
+}
+
+func TestCacheSweeper_EmptyCache(t *testing.T) {
+	repo := new(MockTaskRepository)
+	taskCache := new(MockTaskCache)
+	ctx := context.Background()
+
+	taskCache.On("CachedTaskIDs", ctx).
+		Return([]string{}, nil).
+		Once()
+
+	removed, err := service.NewCacheSweeper(repo, taskCache, 0).Sweep(ctx)
+
+	assert.NoError(t, err)
+	assert.Zero(t, removed)
+	repo.AssertNotCalled(t, "ExistingIDs", mock.Anything, mock.Anything)
+}
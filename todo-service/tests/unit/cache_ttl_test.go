@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMiniRedisClient(t *testing.T, cfg redis.Config) (*miniredis.Miniredis, *redis.RedisClient) {
+	mr := miniredis.RunT(t)
+	port, err := strconv.Atoi(mr.Port())
+	require.NoError(t, err)
+
+	cfg.Host = mr.Host()
+	cfg.Port = port
+	client, err := redis.NewRedisClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	return mr, client
+}
+
+func TestTaskCache_TasksAndListsUseTheirOwnTTL(t *testing.T) {
+	mr, client := newMiniRedisClient(t, redis.Config{
+		CacheTTL:     5 * time.Minute,
+		TaskCacheTTL: 10 * time.Minute,
+		ListCacheTTL: time.Minute,
+	})
+	taskCache := cache.NewTaskCache(client)
+	ctx := context.Background()
+	task := &model.Task{ID: "task-1", UserID: "user-1", Title: "Cached"}
+
+	require.NoError(t, taskCache.SetTask(ctx, task))
+	require.NoError(t, taskCache.SetTasksList(ctx, "tasks:user:user-1:list", []*model.Task{task}, 1))
+	require.NoError(t, taskCache.SetBoard(ctx, "tasks:user:user-1:board", map[string][]*model.Task{"todo": {task}}))
+
+	assert.Equal(t, 10*time.Minute, mr.TTL("task:task-1"))
+	assert.Equal(t, time.Minute, mr.TTL("tasks:user:user-1:list"))
+	assert.Equal(t, time.Minute, mr.TTL("tasks:user:user-1:board"))
+}
+
+func TestTaskCache_TTLsDefaultToCacheTTL(t *testing.T) {
+	mr, client := newMiniRedisClient(t, redis.Config{CacheTTL: 5 * time.Minute})
+	taskCache := cache.NewTaskCache(client)
+	ctx := context.Background()
+	task := &model.Task{ID: "task-1", UserID: "user-1", Title: "Cached"}
+
+	require.NoError(t, taskCache.SetTask(ctx, task))
+	require.NoError(t, taskCache.SetTasksList(ctx, "tasks:user:user-1:list", []*model.Task{task}, 1))
+
+	assert.Equal(t, 5*time.Minute, mr.TTL("task:task-1"))
+	assert.Equal(t, 5*time.Minute, mr.TTL("tasks:user:user-1:list"))
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskCache_InvalidationBumpsUserVersion(t *testing.T) {
+	mr, client := newMiniRedisClient(t, redis.Config{CacheTTL: time.Minute})
+	taskCache := cache.NewTaskCache(client)
+	ctx := context.Background()
+	tasks := []*model.Task{{ID: "task-1", UserID: "user-1", Title: "Cached"}}
+
+	version, err := taskCache.UserCacheVersion(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+
+	oldKey := cache.UserNamespace("user-1", version) + ":list:page:1:size:10"
+	otherKey := cache.UserNamespace("user-2", 0) + ":list:page:1:size:10"
+	require.NoError(t, taskCache.SetTasksList(ctx, oldKey, tasks, 1))
+	require.NoError(t, taskCache.SetTasksList(ctx, otherKey, tasks, 1))
+
+	require.NoError(t, taskCache.InvalidateUserTasks(ctx, "user-1"))
+
+	version, err = taskCache.UserCacheVersion(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), version)
+
+	// The new namespace starts empty
+	cached, _, err := taskCache.GetTasksList(ctx, cache.UserNamespace("user-1", version)+":list:page:1:size:10")
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+
+	// Old entries are left to expire rather than scanned for and deleted
+	assert.True(t, mr.Exists(oldKey))
+	assert.Equal(t, time.Minute, mr.TTL(oldKey))
+
+	// Other users are untouched
+	version, err = taskCache.UserCacheVersion(ctx, "user-2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+	cached, _, err = taskCache.GetTasksList(ctx, otherKey)
+	require.NoError(t, err)
+	assert.Len(t, cached, 1)
+}
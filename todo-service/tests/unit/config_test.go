@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigRedacted(t *testing.T) {
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Host: "postgres", User: "taskmanager", Password: "db-secret"},
+		Redis:    config.RedisConfig{Host: "redis", Password: "redis-secret", DialTimeout: 5 * time.Second},
+		Auth:     config.AuthConfig{Enabled: true},
+	}
+
+	redacted := cfg.Redacted()
+
+	assert.Equal(t, config.RedactedValue, redacted.Database.Password)
+	assert.Equal(t, config.RedactedValue, redacted.Redis.Password)
+	// Unset secrets stay empty
+	assert.Empty(t, redacted.Auth.GatewayToken)
+
+	// Untagged fields pass through
+	assert.Equal(t, "postgres", redacted.Database.Host)
+	assert.Equal(t, "taskmanager", redacted.Database.User)
+	assert.Equal(t, 5*time.Second, redacted.Redis.DialTimeout)
+	assert.True(t, redacted.Auth.Enabled)
+
+	// The original is untouched
+	assert.Equal(t, "db-secret", cfg.Database.Password)
+	assert.Equal(t, "redis-secret", cfg.Redis.Password)
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry_SucceedsAfterFailedAttempt(t *testing.T) {
+	calls := 0
+	err := db.Retry(context.Background(), db.RetryConfig{Attempts: 3, Interval: time.Millisecond}, func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetry_GivesUpAfterAttempts(t *testing.T) {
+	calls := 0
+	err := db.Retry(context.Background(), db.RetryConfig{Attempts: 3, Interval: time.Millisecond}, func(ctx context.Context) error {
+		calls++
+		return errors.New("connection refused")
+	})
+
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetry_GivesUpAfterMaxWait(t *testing.T) {
+	calls := 0
+	start := time.Now()
+	err := db.Retry(context.Background(), db.RetryConfig{Attempts: 100, Interval: 20 * time.Millisecond, MaxWait: 50 * time.Millisecond}, func(ctx context.Context) error {
+		calls++
+		return errors.New("connection refused")
+	})
+
+	assert.Error(t, err)
+	assert.Less(t, calls, 100)
+	assert.Less(t, time.Since(start), time.Second)
+}
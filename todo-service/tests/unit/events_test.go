@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/events"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type recordingPublisher struct {
+	events []events.Event
+	err    error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event events.Event) error {
+	p.events = append(p.events, event)
+	return p.err
+}
+
+type TaskEventsTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repo      *MockTaskRepository
+	cache     *MockTaskCache
+	publisher *recordingPublisher
+	service   service.TaskService
+	userID    string
+	taskID    string
+}
+
+func (suite *TaskEventsTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repo = new(MockTaskRepository)
+	suite.cache = new(MockTaskCache)
+	suite.publisher = &recordingPublisher{}
+	suite.userID = "test-user-123"
+	suite.taskID = "test-task-456"
+
+	metricsCollector := service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	)
+	suite.service = service.NewTaskServiceWithConfig(suite.repo, suite.cache, metricsCollector, suite.publisher, service.DefaultConfig())
+
+	// Cache bookkeeping is not under test here
+	suite.cache.On("InvalidateUserTasks", mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.cache.On("SetTask", mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.cache.On("DeleteTask", mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
+func (suite *TaskEventsTestSuite) TearDownTest() {
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskEventsTestSuite) existingTask(status model.TaskStatus) *model.Task {
+	return &model.Task{
+		ID:       suite.taskID,
+		UserID:   suite.userID,
+		Title:    "Original",
+		Status:   status,
+		Priority: model.PriorityMedium,
+	}
+}
+
+func (suite *TaskEventsTestSuite) TestCreatePublishesCreatedEvent() {
+	suite.repo.On("Create", mock.Anything, mock.AnythingOfType("*model.Task")).
+		Return(&model.Task{ID: suite.taskID, UserID: suite.userID, Title: "New task"}, nil).
+		Once()
+
+	_, err := suite.service.CreateTask(suite.ctx, &service.CreateTaskRequest{
+		UserID: suite.userID,
+		Title:  "New task",
+	})
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), suite.publisher.events, 1) {
+		event := suite.publisher.events[0]
+		assert.Equal(suite.T(), events.TaskCreated, event.Type)
+		assert.Equal(suite.T(), suite.taskID, event.TaskID)
+		assert.Equal(suite.T(), suite.userID, event.UserID)
+		assert.False(suite.T(), event.Timestamp.IsZero())
+	}
+}
+
+func (suite *TaskEventsTestSuite) TestUpdatePublishesChanges() {
+	suite.repo.On("FindByIDAndUser", mock.Anything, suite.taskID, suite.userID).
+		Return(suite.existingTask(model.StatusTodo), nil).
+		Once()
+	updated := suite.existingTask(model.StatusTodo)
+	updated.Title = "Renamed"
+	updated.Priority = model.PriorityHigh
+	suite.repo.On("Update", mock.Anything, mock.AnythingOfType("*model.Task"), mock.Anything).
+		Return(updated, nil).
+		Once()
+
+	_, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:       suite.taskID,
+		UserID:   suite.userID,
+		Title:    stringPtr("Renamed"),
+		Priority: stringPtr("HIGH"),
+	})
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), suite.publisher.events, 1) {
+		event := suite.publisher.events[0]
+		assert.Equal(suite.T(), events.TaskUpdated, event.Type)
+		assert.Equal(suite.T(), map[string]events.Change{
+			"title":    {Old: "Original", New: "Renamed"},
+			"priority": {Old: model.PriorityMedium, New: model.PriorityHigh},
+		}, event.Changes)
+	}
+}
+
+func (suite *TaskEventsTestSuite) TestUpdateToDonePublishesCompletedEvent() {
+	suite.repo.On("FindByIDAndUser", mock.Anything, suite.taskID, suite.userID).
+		Return(suite.existingTask(model.StatusInProgress), nil).
+		Once()
+	suite.repo.On("Update", mock.Anything, mock.AnythingOfType("*model.Task"), mock.Anything).
+		Return(suite.existingTask(model.StatusDone), nil).
+		Once()
+
+	_, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:     suite.taskID,
+		UserID: suite.userID,
+		Status: stringPtr("DONE"),
+	})
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), suite.publisher.events, 1) {
+		event := suite.publisher.events[0]
+		assert.Equal(suite.T(), events.TaskCompleted, event.Type)
+		assert.Equal(suite.T(), events.Change{Old: model.StatusInProgress, New: model.StatusDone}, event.Changes["status"])
+	}
+}
+
+func (suite *TaskEventsTestSuite) TestDeletePublishesDeletedEvent() {
+	suite.repo.On("DeleteByUser", mock.Anything, suite.taskID, suite.userID).
+		Return(suite.existingTask(model.StatusTodo), nil).
+		Once()
+
+	err := suite.service.DeleteTaskByUser(suite.ctx, suite.taskID, suite.userID)
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), suite.publisher.events, 1) {
+		assert.Equal(suite.T(), events.TaskDeleted, suite.publisher.events[0].Type)
+		assert.Equal(suite.T(), suite.taskID, suite.publisher.events[0].TaskID)
+	}
+}
+
+func (suite *TaskEventsTestSuite) TestPublishFailureDoesNotFailOperation() {
+	suite.publisher.err = assert.AnError
+
+	suite.repo.On("Delete", mock.Anything, suite.taskID).
+		Return(suite.existingTask(model.StatusTodo), nil).
+		Once()
+
+	err := suite.service.DeleteTask(suite.ctx, suite.taskID)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), suite.publisher.events, 1)
+}
+
+func TestRedisPublisher_CreatePublishesToChannel(t *testing.T) {
+	mr, client := newMiniRedisClient(t, redis.Config{})
+	subscriber := mr.NewSubscriber()
+	defer subscriber.Close()
+	subscriber.Subscribe("task-events")
+	// miniredis delivers synchronously, so someone must be reading
+	received := make(chan miniredis.PubsubMessage, 1)
+	go func() { received <- <-subscriber.Messages() }()
+
+	repo := new(MockTaskRepository)
+	taskCache := new(MockTaskCache)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*model.Task")).
+		Return(&model.Task{ID: "test-task-456", UserID: "test-user-123", Title: "New task", Status: model.StatusTodo}, nil).
+		Once()
+	taskCache.On("InvalidateUserTasks", mock.Anything, mock.Anything).Return(nil).Maybe()
+	taskCache.On("SetTask", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	taskService := service.NewTaskServiceWithConfig(repo, taskCache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewRedisPublisher(client, "task-events"), service.DefaultConfig())
+
+	_, err := taskService.CreateTask(context.Background(), &service.CreateTaskRequest{
+		UserID: "test-user-123",
+		Title:  "New task",
+	})
+	require.NoError(t, err)
+
+	select {
+	case message := <-received:
+		var event events.Event
+		require.NoError(t, json.Unmarshal([]byte(message.Message), &event))
+		assert.Equal(t, events.TaskCreated, event.Type)
+		assert.Equal(t, "test-task-456", event.TaskID)
+		assert.Equal(t, "test-user-123", event.UserID)
+		assert.Equal(t, model.StatusTodo, event.Status)
+		assert.False(t, event.Timestamp.IsZero())
+	case <-time.After(time.Second):
+		t.Fatal("no event published on task-events")
+	}
+}
+
+func TestTaskEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(TaskEventsTestSuite))
+}
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -33,6 +34,76 @@ func (m *MockTaskService) CreateTask(ctx context.Context, req *service.CreateTas
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
+func (m *MockTaskService) CreateTasksBatch(ctx context.Context, reqs []*service.CreateTaskRequest) ([]*model.Task, error) {
+	args := m.Called(ctx, reqs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) RestoreTask(ctx context.Context, id, userID string) (*model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) PurgeTask(ctx context.Context, id, userID string) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskService) RestoreAllTasks(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskService) CompleteTask(ctx context.Context, id, userID string) (*model.Task, *model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	var completed, next *model.Task
+	if args.Get(0) != nil {
+		completed = args.Get(0).(*model.Task)
+	}
+	if args.Get(1) != nil {
+		next = args.Get(1).(*model.Task)
+	}
+	return completed, next, args.Error(2)
+}
+
+func (m *MockTaskService) ArchiveTask(ctx context.Context, id, userID string) (*model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) UnarchiveTask(ctx context.Context, id, userID string) (*model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) ReorderTask(ctx context.Context, id, userID string, newPosition int) (*model.Task, error) {
+	args := m.Called(ctx, id, userID, newPosition)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) PurgeUserData(ctx context.Context, userID string) (*repository.PurgeResult, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PurgeResult), args.Error(1)
+}
+
 func (m *MockTaskService) GetTask(ctx context.Context, id string) (*model.Task, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -41,14 +112,27 @@ func (m *MockTaskService) GetTask(ctx context.Context, id string) (*model.Task,
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
-func (m *MockTaskService) GetTaskByUser(ctx context.Context, id, userID string) (*model.Task, error) {
-	args := m.Called(ctx, id, userID)
+func (m *MockTaskService) GetTaskByUser(ctx context.Context, id, userID string, includeDeleted bool) (*model.Task, error) {
+	args := m.Called(ctx, id, userID, includeDeleted)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
+func (m *MockTaskService) BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) (int, error) {
+	args := m.Called(ctx, userID, ids, dueDate)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskService) GetTasksByIDs(ctx context.Context, ids []string) ([]*model.Task, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
 func (m *MockTaskService) UpdateTask(ctx context.Context, req *service.UpdateTaskRequest) (*model.Task, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
@@ -67,6 +151,11 @@ func (m *MockTaskService) DeleteTaskByUser(ctx context.Context, id, userID strin
 	return args.Error(0)
 }
 
+func (m *MockTaskService) ListTasksCursor(ctx context.Context, filter *repository.TaskFilter, cursor string, pageSize int) ([]*model.Task, string, error) {
+	args := m.Called(ctx, filter, cursor, pageSize)
+	return args.Get(0).([]*model.Task), args.String(1), args.Error(2)
+}
+
 func (m *MockTaskService) ListTasks(ctx context.Context, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
 	args := m.Called(ctx, filter, page, pageSize)
 	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
@@ -77,6 +166,141 @@ func (m *MockTaskService) ListTasksByUser(ctx context.Context, userID string, fi
 	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockTaskService) GetBoard(ctx context.Context, userID string, perColumn int) (map[string][]*model.Task, error) {
+	args := m.Called(ctx, userID, perColumn)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) MarkAllSeen(ctx context.Context, userID string) (time.Time, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockTaskService) GetLastSeen(ctx context.Context, userID string) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *MockTaskService) GetUnreadCount(ctx context.Context, userID string) (int64, *time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil, args.Error(2)
+	}
+	return args.Get(0).(int64), args.Get(1).(*time.Time), args.Error(2)
+}
+
+func (m *MockTaskService) CountTasks(ctx context.Context, userID string, filter *repository.TaskFilter) (int64, error) {
+	args := m.Called(ctx, userID, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskService) GetTaskMatrix(ctx context.Context, userID string) (map[string]map[string]int64, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]map[string]int64), args.Error(1)
+}
+
+func (m *MockTaskService) GetTaskStats(ctx context.Context, userID string) (map[string]int64, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *MockTaskService) GetUserMetrics(ctx context.Context, userID string) (*model.TaskMetrics, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TaskMetrics), args.Error(1)
+}
+
+func (m *MockTaskService) GetCompletionTrends(ctx context.Context, userID, granularity string, from, to time.Time) ([]service.TrendPoint, error) {
+	args := m.Called(ctx, userID, granularity, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]service.TrendPoint), args.Error(1)
+}
+
+func (m *MockTaskService) RefreshMetrics(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTaskService) ListTasksNeedingAttention(ctx context.Context, userID string, limit int) ([]service.AttentionTask, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]service.AttentionTask), args.Error(1)
+}
+
+func (m *MockTaskService) MoveTaskOnBoard(ctx context.Context, id, userID, newStatus string, newPosition int) (*model.Task, error) {
+	args := m.Called(ctx, id, userID, newStatus, newPosition)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskService) ListOverdueTasks(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskService) ListDeletedTasks(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskService) BulkAddTags(ctx context.Context, userID string, ids, tags []string) (int, error) {
+	args := m.Called(ctx, userID, ids, tags)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskService) BulkRemoveTags(ctx context.Context, userID string, ids, tags []string) (int, error) {
+	args := m.Called(ctx, userID, ids, tags)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskService) SnoozeOverdue(ctx context.Context, userID string, by time.Duration) (int, error) {
+	args := m.Called(ctx, userID, by)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskService) DeleteCompletedTasks(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskService) ListTasksModifiedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskService) SearchTasks(ctx context.Context, userID, query string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, query, page, pageSize)
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskService) PreviewRecurrence(ctx context.Context, rule string, from time.Time, count int) ([]time.Time, error) {
+	args := m.Called(ctx, rule, from, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]time.Time), args.Error(1)
+}
+
 // ==================== TEST SUITE ====================
 
 type TaskHandlerTestSuite struct {
@@ -149,6 +373,61 @@ func (suite *TaskHandlerTestSuite) TestCreateTask_Success() {
 	assert.Equal(suite.T(), pb.TaskPriority_MEDIUM, resp.Task.Priority)
 }
 
+func (suite *TaskHandlerTestSuite) TestCreateTasksBatch_Success() {
+	req := &pb.CreateTasksBatchRequest{
+		Tasks: []*pb.CreateTaskRequest{
+			{UserId: suite.userID, Title: "First", Priority: pb.TaskPriority_HIGH},
+			{UserId: suite.userID, Title: "Second"},
+		},
+	}
+
+	createdTasks := []*model.Task{
+		{ID: "task-1", UserID: suite.userID, Title: "First", Status: model.StatusTodo, Priority: model.PriorityHigh},
+		{ID: "task-2", UserID: suite.userID, Title: "Second", Status: model.StatusTodo, Priority: model.PriorityMedium},
+	}
+
+	suite.service.On("CreateTasksBatch", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(reqs []*service.CreateTaskRequest) bool {
+		return len(reqs) == 2 && reqs[0].Title == "First" && reqs[0].Priority == "HIGH"
+	})).
+		Return(createdTasks, nil).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.CreateTasksBatch(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), resp.Errors)
+	if assert.Len(suite.T(), resp.Tasks, 2) {
+		assert.Equal(suite.T(), "task-1", resp.Tasks[0].Id)
+		assert.Equal(suite.T(), pb.TaskPriority_HIGH, resp.Tasks[0].Priority)
+	}
+}
+
+func (suite *TaskHandlerTestSuite) TestCreateTasksBatch_RejectedItems() {
+	req := &pb.CreateTasksBatchRequest{
+		Tasks: []*pb.CreateTaskRequest{
+			{UserId: suite.userID, Title: "Valid"},
+			{UserId: suite.userID, Title: ""},
+		},
+	}
+
+	suite.service.On("CreateTasksBatch", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("[]*service.CreateTaskRequest")).
+		Return(nil, &service.BatchCreateError{Items: []service.BatchItemError{{Index: 1, Message: "title is required"}}}).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.CreateTasksBatch(suite.ctx, req)
+
+	// Verify rejects come back in the response rather than as an RPC error
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), resp.Tasks)
+	if assert.Len(suite.T(), resp.Errors, 1) {
+		assert.Equal(suite.T(), int32(1), resp.Errors[0].Index)
+		assert.Equal(suite.T(), "title is required", resp.Errors[0].Message)
+	}
+}
+
 func (suite *TaskHandlerTestSuite) TestGetTask_Success() {
 	req := &pb.GetTaskRequest{
 		Id: suite.taskID,
@@ -204,6 +483,64 @@ func (suite *TaskHandlerTestSuite) TestGetTask_NotFound() {
 	assert.Contains(suite.T(), st.Message(), "task not found")
 }
 
+func (suite *TaskHandlerTestSuite) TestGetTaskByUser_OtherUsersTask() {
+	req := &pb.GetTaskByUserRequest{
+		Id:     suite.taskID,
+		UserId: "other-user",
+	}
+
+	suite.service.On("GetTaskByUser", mock.AnythingOfType("*context.valueCtx"), suite.taskID, "other-user", false).
+		Return(nil, status.Error(codes.NotFound, "task not found")).
+		Once()
+
+	resp, err := suite.handler.GetTaskByUser(suite.ctx, req)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
+
+func (suite *TaskHandlerTestSuite) TestGetTasksByIDs_Success() {
+	req := &pb.GetTasksByIDsRequest{Ids: []string{"task-2", "task-1"}}
+	tasks := []*model.Task{
+		{ID: "task-2", UserID: suite.userID, Title: "Task 2", Status: model.StatusTodo, Priority: model.PriorityLow},
+		{ID: "task-1", UserID: suite.userID, Title: "Task 1", Status: model.StatusDone, Priority: model.PriorityHigh},
+	}
+
+	// Setup expectations
+	suite.service.On("GetTasksByIDs", mock.AnythingOfType("*context.valueCtx"), req.Ids).
+		Return(tasks, nil).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.GetTasksByIDs(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resp.Tasks, 2)
+	assert.Equal(suite.T(), "task-2", resp.Tasks[0].Id)
+	assert.Equal(suite.T(), "task-1", resp.Tasks[1].Id)
+	assert.Equal(suite.T(), pb.TaskStatus_DONE, resp.Tasks[1].Status)
+}
+
+func (suite *TaskHandlerTestSuite) TestPreviewRecurrence_Success() {
+	from := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	req := &pb.PreviewRecurrenceRequest{Rule: "WEEKLY", From: timestamppb.New(from), Count: 2}
+	occurrences := []time.Time{from.AddDate(0, 0, 7), from.AddDate(0, 0, 14)}
+
+	// Setup expectations
+	suite.service.On("PreviewRecurrence", mock.AnythingOfType("*context.valueCtx"), "WEEKLY", from, 2).
+		Return(occurrences, nil).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.PreviewRecurrence(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resp.Occurrences, 2)
+	assert.Equal(suite.T(), occurrences[1], resp.Occurrences[1].AsTime())
+}
+
 func (suite *TaskHandlerTestSuite) TestUpdateTask_Success() {
 	title := "Updated Title"
 	status := pb.TaskStatus_IN_PROGRESS
@@ -213,9 +550,9 @@ func (suite *TaskHandlerTestSuite) TestUpdateTask_Success() {
 		Id:          suite.taskID,
 		UserId:      suite.userID,
 		Title:       title,
-		Description: "Updated Description",
-		Status:      status,
-		Priority:    priority,
+		Description: stringPtr("Updated Description"),
+		Status:      &status,
+		Priority:    &priority,
 	}
 
 	updatedTask := &model.Task{
@@ -246,6 +583,136 @@ func (suite *TaskHandlerTestSuite) TestUpdateTask_Success() {
 	assert.Equal(suite.T(), pb.TaskPriority_HIGH, resp.Task.Priority)
 }
 
+func (suite *TaskHandlerTestSuite) TestUpdateTask_ZeroValueEnums() {
+	req := &pb.UpdateTaskRequest{
+		Id:       suite.taskID,
+		UserId:   suite.userID,
+		Status:   pb.TaskStatus_TODO.Enum(),
+		Priority: pb.TaskPriority_LOW.Enum(),
+	}
+
+	updatedTask := &model.Task{
+		ID:       suite.taskID,
+		UserID:   suite.userID,
+		Title:    "Task",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityLow,
+	}
+
+	// TODO and LOW are enum value 0 but were explicitly set
+	suite.service.On("UpdateTask", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(r *service.UpdateTaskRequest) bool {
+		return r.Status != nil && *r.Status == "TODO" &&
+			r.Priority != nil && *r.Priority == "LOW"
+	})).
+		Return(updatedTask, nil).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pb.TaskStatus_TODO, resp.Task.Status)
+	assert.Equal(suite.T(), pb.TaskPriority_LOW, resp.Task.Priority)
+}
+
+
+func (suite *TaskHandlerTestSuite) TestUpdateTask_UpdateMask() {
+	req := &pb.UpdateTaskRequest{
+		Id:         suite.taskID,
+		UserId:     suite.userID,
+		Title:      "Not in the mask",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "due_date", "tags"}},
+	}
+
+	// Masked fields are set to their empty values; the title is ignored
+	suite.service.On("UpdateTask", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(r *service.UpdateTaskRequest) bool {
+		return r.Title == nil &&
+			r.Description != nil && *r.Description == "" &&
+			r.DueDate == nil && r.ClearDueDate &&
+			r.Tags != nil && len(*r.Tags) == 0 &&
+			r.Status == nil && r.Priority == nil && r.RecurrenceRule == nil
+	})).
+		Return(&model.Task{ID: suite.taskID, UserID: suite.userID, Title: "Task"}, nil).
+		Once()
+
+	resp, err := suite.handler.UpdateTask(suite.ctx, req)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), resp.Task.DueDate)
+}
+
+func (suite *TaskHandlerTestSuite) TestUpdateTask_UnknownUpdateMaskPath() {
+	req := &pb.UpdateTaskRequest{
+		Id:         suite.taskID,
+		UserId:     suite.userID,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+	}
+
+	resp, err := suite.handler.UpdateTask(suite.ctx, req)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.service.AssertNotCalled(suite.T(), "UpdateTask", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskHandlerTestSuite) TestUpdateTask_ClearDescription() {
+	req := &pb.UpdateTaskRequest{
+		Id:          suite.taskID,
+		UserId:      suite.userID,
+		Description: stringPtr(""),
+	}
+
+	updatedTask := &model.Task{
+		ID:       suite.taskID,
+		UserID:   suite.userID,
+		Title:    "Task",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityMedium,
+	}
+
+	// An explicitly empty description is passed on, not dropped
+	suite.service.On("UpdateTask", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(r *service.UpdateTaskRequest) bool {
+		return r.Description != nil && *r.Description == ""
+	})).
+		Return(updatedTask, nil).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), resp.Task.Description)
+}
+
+func (suite *TaskHandlerTestSuite) TestUpdateTask_Tags() {
+	updatedTask := &model.Task{
+		ID:       suite.taskID,
+		UserID:   suite.userID,
+		Title:    "Task",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityMedium,
+	}
+
+	// No tags leaves them alone, clear_tags empties them
+	suite.service.On("UpdateTask", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(r *service.UpdateTaskRequest) bool {
+		return r.Tags == nil
+	})).
+		Return(updatedTask, nil).
+		Once()
+	suite.service.On("UpdateTask", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(r *service.UpdateTaskRequest) bool {
+		return r.Tags != nil && len(*r.Tags) == 0
+	})).
+		Return(updatedTask, nil).
+		Once()
+
+	_, err := suite.handler.UpdateTask(suite.ctx, &pb.UpdateTaskRequest{Id: suite.taskID, UserId: suite.userID, Title: "Task"})
+	assert.NoError(suite.T(), err)
+	_, err = suite.handler.UpdateTask(suite.ctx, &pb.UpdateTaskRequest{Id: suite.taskID, UserId: suite.userID, ClearTags: true})
+	assert.NoError(suite.T(), err)
+}
+
 func (suite *TaskHandlerTestSuite) TestUpdateTask_PartialUpdate() {
 	title := "Updated Title Only"
 	
@@ -302,6 +769,23 @@ func (suite *TaskHandlerTestSuite) TestDeleteTask_Success() {
 	assert.True(suite.T(), resp.Success)
 }
 
+func (suite *TaskHandlerTestSuite) TestDeleteTaskByUser_Success() {
+	req := &pb.DeleteTaskByUserRequest{
+		Id:     suite.taskID,
+		UserId: suite.userID,
+	}
+
+	suite.service.On("DeleteTaskByUser", mock.AnythingOfType("*context.valueCtx"), suite.taskID, suite.userID).
+		Return(nil).
+		Once()
+
+	resp, err := suite.handler.DeleteTaskByUser(suite.ctx, req)
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), resp.Success)
+	suite.service.AssertNotCalled(suite.T(), "DeleteTask")
+}
+
 func (suite *TaskHandlerTestSuite) TestListTasks_Success() {
 	req := &pb.ListTasksRequest{
 		Page:            1,
@@ -356,6 +840,33 @@ func (suite *TaskHandlerTestSuite) TestListTasks_Success() {
 	assert.Equal(suite.T(), "Task 2", resp.Tasks[1].Title)
 }
 
+func (suite *TaskHandlerTestSuite) TestListTasks_Cursor() {
+	cursor := ""
+	req := &pb.ListTasksRequest{
+		PageSize: 1,
+		Cursor:   &cursor,
+	}
+	tasks := []*model.Task{
+		{ID: "task-1", UserID: suite.userID, Title: "Task 1", Status: model.StatusTodo, Priority: model.PriorityMedium},
+	}
+
+	// Setup expectations
+	suite.service.On("ListTasksCursor", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), "", 1).
+		Return(tasks, "next-page", nil).
+		Once()
+
+	// Execute
+	resp, err := suite.handler.ListTasks(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resp.Tasks, 1)
+	assert.Equal(suite.T(), "next-page", resp.NextCursor)
+	assert.True(suite.T(), resp.HasNext)
+	assert.Zero(suite.T(), resp.Total)
+	suite.service.AssertNotCalled(suite.T(), "ListTasks", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *TaskHandlerTestSuite) TestListTasksByUser_Success() {
 	req := &pb.ListTasksByUserRequest{
 		UserId:           suite.userID,
@@ -386,6 +897,9 @@ func (suite *TaskHandlerTestSuite) TestListTasksByUser_Success() {
 	suite.service.On("ListTasksByUser", mock.AnythingOfType("*context.valueCtx"), suite.userID, mock.AnythingOfType("*repository.TaskFilter"), 1, 10).
 		Return(tasks, total, nil).
 		Once()
+	suite.service.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.userID).
+		Return(nil, nil).
+		Once()
 
 	// Execute
 	resp, err := suite.handler.ListTasksByUser(suite.ctx, req)
@@ -399,6 +913,140 @@ func (suite *TaskHandlerTestSuite) TestListTasksByUser_Success() {
 	assert.Equal(suite.T(), int32(10), resp.PageSize)
 	assert.Equal(suite.T(), "Task 1", resp.Tasks[0].Title)
 	assert.Equal(suite.T(), suite.userID, resp.Tasks[0].UserId)
+	assert.True(suite.T(), resp.Tasks[0].IsNew)
+}
+
+func (suite *TaskHandlerTestSuite) TestListTasksByUser_TruncatesTagsButGetDoesNot() {
+	suite.handler.SetListTagsLimit(2)
+	task := &model.Task{
+		ID:       suite.taskID,
+		UserID:   suite.userID,
+		Title:    "Tagged",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityMedium,
+		Tags:     model.Tags{"a", "b", "c"},
+	}
+
+	suite.service.On("ListTasksByUser", mock.AnythingOfType("*context.valueCtx"), suite.userID, mock.AnythingOfType("*repository.TaskFilter"), 1, 10).
+		Return([]*model.Task{task}, int64(1), nil).
+		Once()
+	suite.service.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.userID).
+		Return(nil, nil).
+		Once()
+	suite.service.On("GetTaskByUser", mock.AnythingOfType("*context.valueCtx"), suite.taskID, suite.userID, false).
+		Return(task, nil).
+		Once()
+
+	listResp, err := suite.handler.ListTasksByUser(suite.ctx, &pb.ListTasksByUserRequest{UserId: suite.userID, Page: 1, PageSize: 10})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"a", "b"}, listResp.Tasks[0].Tags)
+	assert.True(suite.T(), listResp.Tasks[0].TagsTruncated)
+
+	getResp, err := suite.handler.GetTaskByUser(suite.ctx, &pb.GetTaskByUserRequest{Id: suite.taskID, UserId: suite.userID})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"a", "b", "c"}, getResp.Task.Tags)
+	assert.False(suite.T(), getResp.Task.TagsTruncated)
+	// The list mapping must not cut the model's own slice.
+	assert.Len(suite.T(), task.Tags, 3)
+}
+
+func (suite *TaskHandlerTestSuite) TestListTasksByUser_PassesIncludeArchived() {
+	for _, includeArchived := range []bool{false, true} {
+		suite.service.On("ListTasksByUser", mock.AnythingOfType("*context.valueCtx"), suite.userID, mock.MatchedBy(func(filter *repository.TaskFilter) bool {
+			return filter.IncludeArchived == includeArchived
+		}), 1, 10).
+			Return([]*model.Task{}, int64(0), nil).
+			Once()
+		suite.service.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.userID).
+			Return(nil, nil).
+			Once()
+
+		_, err := suite.handler.ListTasksByUser(suite.ctx, &pb.ListTasksByUserRequest{
+			UserId:          suite.userID,
+			Page:            1,
+			PageSize:        10,
+			IncludeArchived: includeArchived,
+		})
+		assert.NoError(suite.T(), err)
+	}
+	suite.service.AssertExpectations(suite.T())
+}
+
+func (suite *TaskHandlerTestSuite) TestListTasksByUser_PassesCompletionWindow() {
+	after := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	suite.service.On("ListTasksByUser", mock.AnythingOfType("*context.valueCtx"), suite.userID, mock.MatchedBy(func(filter *repository.TaskFilter) bool {
+		return filter.CompletedAfter != nil && filter.CompletedAfter.Equal(after) &&
+			filter.CompletedBefore != nil && filter.CompletedBefore.Equal(before)
+	}), 1, 10).
+		Return([]*model.Task{}, int64(0), nil).
+		Once()
+	suite.service.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.userID).
+		Return(nil, nil).
+		Once()
+
+	_, err := suite.handler.ListTasksByUser(suite.ctx, &pb.ListTasksByUserRequest{
+		UserId:          suite.userID,
+		Page:            1,
+		PageSize:        10,
+		CompletedAfter:  timestamppb.New(after),
+		CompletedBefore: timestamppb.New(before),
+	})
+
+	assert.NoError(suite.T(), err)
+	suite.service.AssertExpectations(suite.T())
+}
+
+func (suite *TaskHandlerTestSuite) TestListTasksByUser_FlagsTasksUpdatedAfterMarkAllSeen() {
+	seenAt := time.Now().Add(-time.Hour)
+
+	suite.service.On("MarkAllSeen", mock.AnythingOfType("*context.valueCtx"), suite.userID).
+		Return(seenAt, nil).
+		Once()
+
+	markResp, err := suite.handler.MarkAllSeen(suite.ctx, &pb.MarkAllSeenRequest{UserId: suite.userID})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), markResp.SeenAt.AsTime().Equal(seenAt))
+
+	tasks := []*model.Task{
+		{
+			ID:        "task-updated",
+			UserID:    suite.userID,
+			Title:     "Updated after seen",
+			Status:    model.StatusTodo,
+			Priority:  model.PriorityMedium,
+			CreatedAt: seenAt.Add(-time.Hour),
+			UpdatedAt: seenAt.Add(time.Minute),
+		},
+		{
+			ID:        "task-stale",
+			UserID:    suite.userID,
+			Title:     "Untouched since seen",
+			Status:    model.StatusTodo,
+			Priority:  model.PriorityMedium,
+			CreatedAt: seenAt.Add(-time.Hour),
+			UpdatedAt: seenAt.Add(-time.Minute),
+		},
+	}
+
+	suite.service.On("ListTasksByUser", mock.AnythingOfType("*context.valueCtx"), suite.userID, mock.AnythingOfType("*repository.TaskFilter"), 1, 10).
+		Return(tasks, int64(len(tasks)), nil).
+		Once()
+	suite.service.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.userID).
+		Return(&seenAt, nil).
+		Once()
+
+	resp, err := suite.handler.ListTasksByUser(suite.ctx, &pb.ListTasksByUserRequest{
+		UserId:   suite.userID,
+		Page:     1,
+		PageSize: 10,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resp.Tasks, 2)
+	assert.True(suite.T(), resp.Tasks[0].IsNew)
+	assert.False(suite.T(), resp.Tasks[1].IsNew)
 }
 
 func (suite *TaskHandlerTestSuite) TestListTasks_EmptyResult() {
@@ -425,6 +1073,37 @@ func (suite *TaskHandlerTestSuite) TestListTasks_EmptyResult() {
 	assert.Equal(suite.T(), int32(0), resp.Total)
 }
 
+func (suite *TaskHandlerTestSuite) TestListTasks_PageInfo() {
+	tests := []struct {
+		name       string
+		total      int64
+		page       int32
+		pageSize   int32
+		totalPages int32
+		hasNext    bool
+	}{
+		{name: "exact multiple, first page", total: 20, page: 1, pageSize: 10, totalPages: 2, hasNext: true},
+		{name: "exact multiple, last page", total: 20, page: 2, pageSize: 10, totalPages: 2, hasNext: false},
+		{name: "remainder, middle page", total: 21, page: 2, pageSize: 10, totalPages: 3, hasNext: true},
+		{name: "remainder, last page", total: 21, page: 3, pageSize: 10, totalPages: 3, hasNext: false},
+		{name: "no results", total: 0, page: 1, pageSize: 10, totalPages: 0, hasNext: false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.service.On("ListTasks", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), int(tt.page), int(tt.pageSize)).
+				Return([]*model.Task{}, tt.total, nil).
+				Once()
+
+			resp, err := suite.handler.ListTasks(suite.ctx, &pb.ListTasksRequest{Page: tt.page, PageSize: tt.pageSize})
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.totalPages, resp.TotalPages)
+			assert.Equal(suite.T(), tt.hasNext, resp.HasNext)
+		})
+	}
+}
+
 func (suite *TaskHandlerTestSuite) TestErrorPropagation() {
 	req := &pb.GetTaskRequest{
 		Id: "non-existent-task",
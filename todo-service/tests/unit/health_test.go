@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/handler"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestWatchServingStatus_FollowsDependencies(t *testing.T) {
+	var databaseDown atomic.Bool
+	databaseDown.Store(true)
+
+	h := handler.NewTaskHandler(nil)
+	h.SetHealthChecks(map[string]handler.HealthCheck{
+		"database": func(ctx context.Context) error {
+			if databaseDown.Load() {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+		"redis": nil,
+	})
+
+	server := health.NewServer()
+	server.SetServingStatus("todo-service", grpc_health_v1.HealthCheckResponse_SERVING)
+	servingStatus := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "todo-service"})
+		if err != nil {
+			return grpc_health_v1.HealthCheckResponse_UNKNOWN
+		}
+		return resp.Status
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.WatchServingStatus(ctx, server, "todo-service", 10*time.Millisecond)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return servingStatus() == grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 5*time.Millisecond)
+
+	// Disabled dependencies do not count against the service
+	databaseDown.Store(false)
+	assert.Eventually(t, func() bool {
+		return servingStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMemoryTaskCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	memory := cache.NewMemoryTaskCache(2, time.Minute)
+
+	memory.Set(&model.Task{ID: "task-1"})
+	memory.Set(&model.Task{ID: "task-2"})
+	// Reading task-1 makes task-2 the least recently used
+	assert.NotNil(t, memory.Get("task-1"))
+	memory.Set(&model.Task{ID: "task-3"})
+
+	assert.Equal(t, 2, memory.Len())
+	assert.NotNil(t, memory.Get("task-1"))
+	assert.Nil(t, memory.Get("task-2"))
+	assert.NotNil(t, memory.Get("task-3"))
+}
+
+func TestMemoryTaskCache_Expires(t *testing.T) {
+	memory := cache.NewMemoryTaskCache(10, time.Millisecond)
+
+	memory.Set(&model.Task{ID: "task-1"})
+	time.Sleep(5 * time.Millisecond)
+
+	assert.Nil(t, memory.Get("task-1"))
+	assert.Zero(t, memory.Len())
+}
+
+func TestMemoryTaskCache_ReturnsCopies(t *testing.T) {
+	memory := cache.NewMemoryTaskCache(10, time.Minute)
+
+	task := &model.Task{ID: "task-1", Title: "Original"}
+	memory.Set(task)
+	task.Title = "Changed after caching"
+	memory.Get("task-1").Title = "Changed after reading"
+
+	assert.Equal(t, "Original", memory.Get("task-1").Title)
+}
+
+// serveTwice reads the same task twice through a service backed by
+// taskCache and returns the repository so callers can count database hits.
+func serveTwice(t *testing.T, taskCache cache.TaskCache) *MockTaskRepository {
+	repo := new(MockTaskRepository)
+	repo.On("FindByID", mock.Anything, "task-1").
+		Return(&model.Task{ID: "task-1", UserID: "user-1", Title: "Served"}, nil)
+
+	taskService := service.NewTaskService(repo, taskCache, service.NewMetricsCollector(nil, nil, nil, nil, nil, nil, nil, nil))
+	for i := 0; i < 2; i++ {
+		task, err := taskService.GetTask(context.Background(), "task-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Served", task.Title)
+	}
+	return repo
+}
+
+func TestTaskService_ServesReadsWithoutRedis(t *testing.T) {
+	repo := serveTwice(t, cache.NewNoopTaskCache())
+
+	// Nothing is cached, so both reads hit the database
+	repo.AssertNumberOfCalls(t, "FindByID", 2)
+}
+
+func TestTaskService_MarkAllSeenUnavailableWithoutRedis(t *testing.T) {
+	taskService := service.NewTaskService(new(MockTaskRepository), cache.NewNoopTaskCache(), service.NewMetricsCollector(nil, nil, nil, nil, nil, nil, nil, nil))
+
+	// The timestamp would be dropped, so the call must not report success
+	_, err := taskService.MarkAllSeen(context.Background(), "user-1")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestTaskService_ServesReadsWhenRedisFails(t *testing.T) {
+	// Retries would only slow the test down
+	mr, client := newMiniRedisClient(t, redis.Config{CacheTTL: time.Minute, MaxRetries: -1})
+	mr.Close()
+
+	memory := cache.NewMemoryTaskCache(10, time.Minute)
+	repo := serveTwice(t, cache.NewTieredTaskCache(memory, cache.NewTaskCache(client)))
+
+	// The first read falls through to the database, the second is served
+	// from memory
+	repo.AssertNumberOfCalls(t, "FindByID", 1)
+}
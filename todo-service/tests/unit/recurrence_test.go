@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestPreviewRecurrence(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		from time.Time
+		want []time.Time
+	}{
+		{
+			name: "daily",
+			rule: "DAILY",
+			from: date(2025, time.February, 27),
+			want: []time.Time{date(2025, time.February, 28), date(2025, time.March, 1)},
+		},
+		{
+			name: "weekly",
+			rule: "WEEKLY",
+			from: date(2025, time.March, 3),
+			want: []time.Time{date(2025, time.March, 10), date(2025, time.March, 17), date(2025, time.March, 24)},
+		},
+		{
+			name: "every other week",
+			rule: "weekly;interval=2",
+			from: date(2025, time.March, 3),
+			want: []time.Time{date(2025, time.March, 17), date(2025, time.March, 31)},
+		},
+		{
+			name: "monthly",
+			rule: "MONTHLY",
+			from: date(2025, time.January, 15),
+			want: []time.Time{date(2025, time.February, 15), date(2025, time.March, 15)},
+		},
+		{
+			name: "monthly from the 31st clamps to shorter months",
+			rule: "MONTHLY",
+			from: date(2024, time.January, 31),
+			want: []time.Time{date(2024, time.February, 29), date(2024, time.March, 31), date(2024, time.April, 30)},
+		},
+		{
+			name: "quarterly",
+			rule: "MONTHLY;INTERVAL=3",
+			from: date(2025, time.November, 30),
+			want: []time.Time{date(2026, time.February, 28), date(2026, time.May, 30)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.PreviewRecurrence(tt.rule, tt.from, len(tt.want))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPreviewRecurrence_InvalidRule(t *testing.T) {
+	for _, rule := range []string{"", "HOURLY", "WEEKLY;INTERVAL=0", "WEEKLY;INTERVAL=x", "WEEKLY;BYDAY=MO"} {
+		_, err := service.PreviewRecurrence(rule, date(2025, time.March, 3), 3)
+		assert.Error(t, err, rule)
+	}
+}
+
+func TestTaskServicePreviewRecurrence_Limits(t *testing.T) {
+	taskService := service.NewTaskServiceWithConfig(nil, nil, nil, nil, service.Config{MaxRecurrencePreview: 5})
+
+	_, err := taskService.PreviewRecurrence(context.Background(), "DAILY", date(2025, time.March, 3), 6)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = taskService.PreviewRecurrence(context.Background(), "YEARLY", date(2025, time.March, 3), 5)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	got, err := taskService.PreviewRecurrence(context.Background(), "DAILY", date(2025, time.March, 3), 5)
+	require.NoError(t, err)
+	assert.Len(t, got, 5)
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
@@ -16,6 +17,10 @@ func (t *testRepositoryImpl) Create(ctx context.Context, task *model.Task) (*mod
 	return nil, nil
 }
 
+func (t *testRepositoryImpl) CreateBatch(ctx context.Context, tasks []*model.Task) error {
+	return nil
+}
+
 func (t *testRepositoryImpl) FindByID(ctx context.Context, id string) (*model.Task, error) {
 	return nil, nil
 }
@@ -24,16 +29,32 @@ func (t *testRepositoryImpl) FindByIDAndUser(ctx context.Context, id, userID str
 	return nil, nil
 }
 
-func (t *testRepositoryImpl) Update(ctx context.Context, task *model.Task) (*model.Task, error) {
+func (t *testRepositoryImpl) BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) ([]*model.Task, error) {
 	return nil, nil
 }
 
-func (t *testRepositoryImpl) Delete(ctx context.Context, id string) error {
-	return nil
+func (t *testRepositoryImpl) FindByIDs(ctx context.Context, ids []string) ([]*model.Task, error) {
+	return nil, nil
 }
 
-func (t *testRepositoryImpl) DeleteByUser(ctx context.Context, id, userID string) error {
-	return nil
+func (t *testRepositoryImpl) Update(ctx context.Context, task *model.Task, fields ...string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) UpdateWithNext(ctx context.Context, task, next *model.Task, fields ...string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) Delete(ctx context.Context, id string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) DeleteByUser(ctx context.Context, id, userID string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) ListAfter(ctx context.Context, filter *repository.TaskFilter, after *repository.Cursor, limit int) ([]*model.Task, error) {
+	return nil, nil
 }
 
 func (t *testRepositoryImpl) List(ctx context.Context, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
@@ -44,6 +65,102 @@ func (t *testRepositoryImpl) ListByUser(ctx context.Context, userID string, filt
 	return nil, 0, nil
 }
 
+func (t *testRepositoryImpl) ListByUpdatedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	return nil, 0, nil
+}
+
+func (t *testRepositoryImpl) Search(ctx context.Context, userID, term string, page, pageSize int) ([]*model.Task, int64, error) {
+	return nil, 0, nil
+}
+
+func (t *testRepositoryImpl) ListTopByUserAndStatus(ctx context.Context, userID string, status model.TaskStatus, limit int, order repository.ColumnOrder) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) CountByUser(ctx context.Context, userID string, filter *repository.TaskFilter) (int64, error) {
+	return 0, nil
+}
+
+func (t *testRepositoryImpl) CountByStatusAndPriority(ctx context.Context, userID string) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) CountByStatus(ctx context.Context, userID string) (map[model.TaskStatus]int64, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) MetricsByUser(ctx context.Context, userID string, now time.Time) (*model.TaskMetrics, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) CountCompletedByPeriod(ctx context.Context, userID, granularity string, from, to time.Time) (map[time.Time]int64, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) CountAllByStatusAndPriority(ctx context.Context) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) FindByIDAndUserIncludingDeleted(ctx context.Context, id, userID string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) ListAttentionCandidates(ctx context.Context, userID string, dueBefore, staleBefore time.Time) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) MoveOnBoard(ctx context.Context, id, userID string, status model.TaskStatus, position int, next *model.Task) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) Reorder(ctx context.Context, id, userID string, position int) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) ListOverdue(ctx context.Context, userID string, now time.Time, page, pageSize int) ([]*model.Task, int64, error) {
+	return nil, 0, nil
+}
+
+func (t *testRepositoryImpl) ListDeleted(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	return nil, 0, nil
+}
+
+func (t *testRepositoryImpl) BulkAddTags(ctx context.Context, userID string, ids []string, tags model.Tags, maxTags int) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) BulkRemoveTags(ctx context.Context, userID string, ids []string, tags model.Tags) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) SnoozeOverdue(ctx context.Context, userID string, now time.Time, by time.Duration) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) DeleteCompletedByUser(ctx context.Context, userID string) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) Restore(ctx context.Context, id, userID string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) Purge(ctx context.Context, id, userID string) (*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) RestoreAllByUser(ctx context.Context, userID string, deletedAfter *time.Time) ([]*model.Task, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) PurgeByUser(ctx context.Context, userID string) (*repository.PurgeResult, error) {
+	return nil, nil
+}
+
+func (t *testRepositoryImpl) ExistingIDs(ctx context.Context, ids []string) ([]string, error) {
+	return nil, nil
+}
+
 func TestRepositoryInterface(t *testing.T) {
 	// Create an instance of our test implementation
 	var repo repository.TaskRepository = &testRepositoryImpl{}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/interceptor"
+	"github.com/amirhasanpour/task-manager/todo-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// callWithRequestIDChain runs a handler that logs through the context
+// logger behind the request ID and logging interceptors, and returns what
+// was logged.
+func callWithRequestIDChain(t *testing.T, ctx context.Context) *observer.ObservedLogs {
+	t.Helper()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	restore := zap.ReplaceGlobals(zap.New(core))
+	t.Cleanup(restore)
+
+	requestID := interceptor.NewRequestIDInterceptor().Unary()
+	logging := interceptor.NewLoggingInterceptor().Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/todo.TodoService/GetTask"}
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		logger.FromContext(ctx).Info("handled")
+		return "ok", nil
+	}
+
+	_, err := requestID(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		return logging(ctx, req, info, handler)
+	})
+	require.NoError(t, err)
+	return logs
+}
+
+func TestRequestIDInterceptor_TagsLogs(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptor.RequestIDMetadataKey, "req-123"))
+	logs := callWithRequestIDChain(t, ctx)
+
+	for _, message := range []string{"handled", "GRPC request completed"} {
+		entries := logs.FilterMessage(message).All()
+		if assert.Len(t, entries, 1, message) {
+			assert.Equal(t, "req-123", entries[0].ContextMap()["request_id"], message)
+		}
+	}
+}
+
+func TestRequestIDInterceptor_WithoutRequestID(t *testing.T) {
+	for name, ctx := range map[string]context.Context{
+		"no metadata": context.Background(),
+		"too long":    metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptor.RequestIDMetadataKey, strings.Repeat("a", 129))),
+	} {
+		logs := callWithRequestIDChain(t, ctx)
+
+		entries := logs.FilterMessage("handled").All()
+		if assert.Len(t, entries, 1, name) {
+			assert.NotContains(t, entries[0].ContextMap(), "request_id", name)
+		}
+		assert.Equal(t, 0, logs.FilterFieldKey("request_id").Len(), name)
+	}
+}
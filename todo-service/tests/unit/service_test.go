@@ -2,9 +2,12 @@ package tests
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/amirhasanpour/task-manager/todo-service/internal/events"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
 	"github.com/amirhasanpour/task-manager/todo-service/internal/service"
@@ -13,6 +16,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 // ==================== MOCKS ====================
@@ -29,6 +33,11 @@ func (m *MockTaskRepository) Create(ctx context.Context, task *model.Task) (*mod
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
+func (m *MockTaskRepository) CreateBatch(ctx context.Context, tasks []*model.Task) error {
+	args := m.Called(ctx, tasks)
+	return args.Error(0)
+}
+
 func (m *MockTaskRepository) FindByID(ctx context.Context, id string) (*model.Task, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -45,22 +54,59 @@ func (m *MockTaskRepository) FindByIDAndUser(ctx context.Context, id, userID str
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
-func (m *MockTaskRepository) Update(ctx context.Context, task *model.Task) (*model.Task, error) {
-	args := m.Called(ctx, task)
+func (m *MockTaskRepository) BulkSetDueDate(ctx context.Context, userID string, ids []string, dueDate *time.Time) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, ids, dueDate)
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindByIDAndUserIncludingDeleted(ctx context.Context, id, userID string) (*model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.Task, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Update(ctx context.Context, task *model.Task, fields ...string) (*model.Task, error) {
+	args := m.Called(ctx, task, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) UpdateWithNext(ctx context.Context, task, next *model.Task, fields ...string) (*model.Task, error) {
+	args := m.Called(ctx, task, next, fields)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
-func (m *MockTaskRepository) Delete(ctx context.Context, id string) error {
+func (m *MockTaskRepository) Delete(ctx context.Context, id string) (*model.Task, error) {
 	args := m.Called(ctx, id)
-	return args.Error(0)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
 }
 
-func (m *MockTaskRepository) DeleteByUser(ctx context.Context, id, userID string) error {
+func (m *MockTaskRepository) DeleteByUser(ctx context.Context, id, userID string) (*model.Task, error) {
 	args := m.Called(ctx, id, userID)
-	return args.Error(0)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) ListAfter(ctx context.Context, filter *repository.TaskFilter, after *repository.Cursor, limit int) ([]*model.Task, error) {
+	args := m.Called(ctx, filter, after, limit)
+	return args.Get(0).([]*model.Task), args.Error(1)
 }
 
 func (m *MockTaskRepository) List(ctx context.Context, filter *repository.TaskFilter, page, pageSize int) ([]*model.Task, int64, error) {
@@ -73,6 +119,178 @@ func (m *MockTaskRepository) ListByUser(ctx context.Context, userID string, filt
 	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockTaskRepository) ListByUpdatedBy(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskRepository) Search(ctx context.Context, userID, term string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, term, page, pageSize)
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskRepository) ListTopByUserAndStatus(ctx context.Context, userID string, status model.TaskStatus, limit int, order repository.ColumnOrder) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, status, limit, order)
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) CountByUser(ctx context.Context, userID string, filter *repository.TaskFilter) (int64, error) {
+	args := m.Called(ctx, userID, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) CountByStatusAndPriority(ctx context.Context, userID string) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[model.TaskStatus]map[model.TaskPriority]int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) CountByStatus(ctx context.Context, userID string) (map[model.TaskStatus]int64, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[model.TaskStatus]int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) MetricsByUser(ctx context.Context, userID string, now time.Time) (*model.TaskMetrics, error) {
+	args := m.Called(ctx, userID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TaskMetrics), args.Error(1)
+}
+
+func (m *MockTaskRepository) CountCompletedByPeriod(ctx context.Context, userID, granularity string, from, to time.Time) (map[time.Time]int64, error) {
+	args := m.Called(ctx, userID, granularity, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[time.Time]int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) CountAllByStatusAndPriority(ctx context.Context) (map[model.TaskStatus]map[model.TaskPriority]int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[model.TaskStatus]map[model.TaskPriority]int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) ListAttentionCandidates(ctx context.Context, userID string, dueBefore, staleBefore time.Time) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, dueBefore, staleBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) MoveOnBoard(ctx context.Context, id, userID string, status model.TaskStatus, position int, next *model.Task) (*model.Task, error) {
+	args := m.Called(ctx, id, userID, status, position, next)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Reorder(ctx context.Context, id, userID string, position int) (*model.Task, error) {
+	args := m.Called(ctx, id, userID, position)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) ListOverdue(ctx context.Context, userID string, now time.Time, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, now, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskRepository) ListDeleted(ctx context.Context, userID string, page, pageSize int) ([]*model.Task, int64, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*model.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskRepository) BulkAddTags(ctx context.Context, userID string, ids []string, tags model.Tags, maxTags int) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, ids, tags, maxTags)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) BulkRemoveTags(ctx context.Context, userID string, ids []string, tags model.Tags) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, ids, tags)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) SnoozeOverdue(ctx context.Context, userID string, now time.Time, by time.Duration) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, now, by)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) DeleteCompletedByUser(ctx context.Context, userID string) ([]*model.Task, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Restore(ctx context.Context, id, userID string) (*model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Purge(ctx context.Context, id, userID string) (*model.Task, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) RestoreAllByUser(ctx context.Context, userID string, deletedAfter *time.Time) ([]*model.Task, error) {
+	args := m.Called(ctx, userID, deletedAfter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) PurgeByUser(ctx context.Context, userID string) (*repository.PurgeResult, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PurgeResult), args.Error(1)
+}
+
+func (m *MockTaskRepository) ExistingIDs(ctx context.Context, ids []string) ([]string, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 type MockTaskCache struct {
 	mock.Mock
 }
@@ -85,6 +303,14 @@ func (m *MockTaskCache) GetTask(ctx context.Context, id string) (*model.Task, er
 	return args.Get(0).(*model.Task), args.Error(1)
 }
 
+func (m *MockTaskCache) GetTasks(ctx context.Context, ids []string) (map[string]*model.Task, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]*model.Task), args.Error(1)
+}
+
 func (m *MockTaskCache) SetTask(ctx context.Context, task *model.Task) error {
 	args := m.Called(ctx, task)
 	return args.Error(0)
@@ -105,6 +331,19 @@ func (m *MockTaskCache) SetTasksList(ctx context.Context, key string, tasks []*m
 	return args.Error(0)
 }
 
+func (m *MockTaskCache) DeleteLastSeen(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskCache) CachedTaskIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *MockTaskCache) DeleteTasksList(ctx context.Context, pattern string) error {
 	args := m.Called(ctx, pattern)
 	return args.Error(0)
@@ -115,6 +354,89 @@ func (m *MockTaskCache) InvalidateUserTasks(ctx context.Context, userID string)
 	return args.Error(0)
 }
 
+func (m *MockTaskCache) UserCacheVersion(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskCache) GetBoard(ctx context.Context, key string) (map[string][]*model.Task, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]*model.Task), args.Error(1)
+}
+
+func (m *MockTaskCache) SetBoard(ctx context.Context, key string, board map[string][]*model.Task) error {
+	args := m.Called(ctx, key, board)
+	return args.Error(0)
+}
+
+func (m *MockTaskCache) GetLastSeen(ctx context.Context, userID string) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *MockTaskCache) SetLastSeen(ctx context.Context, userID string, seenAt time.Time) error {
+	args := m.Called(ctx, userID, seenAt)
+	return args.Error(0)
+}
+
+func (m *MockTaskCache) GetCount(ctx context.Context, key string) (*int64, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*int64), args.Error(1)
+}
+
+func (m *MockTaskCache) SetCount(ctx context.Context, key string, count int64) error {
+	args := m.Called(ctx, key, count)
+	return args.Error(0)
+}
+
+func (m *MockTaskCache) GetMatrix(ctx context.Context, key string) (map[string]map[string]int64, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]map[string]int64), args.Error(1)
+}
+
+func (m *MockTaskCache) SetMatrix(ctx context.Context, key string, matrix map[string]map[string]int64) error {
+	args := m.Called(ctx, key, matrix)
+	return args.Error(0)
+}
+
+func (m *MockTaskCache) GetStats(ctx context.Context, key string) (map[string]int64, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *MockTaskCache) SetStats(ctx context.Context, key string, stats map[string]int64) error {
+	args := m.Called(ctx, key, stats)
+	return args.Error(0)
+}
+
+func (m *MockTaskCache) GetMetrics(ctx context.Context, key string) (*model.TaskMetrics, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TaskMetrics), args.Error(1)
+}
+
+func (m *MockTaskCache) SetMetrics(ctx context.Context, key string, metrics *model.TaskMetrics) error {
+	args := m.Called(ctx, key, metrics)
+	return args.Error(0)
+}
+
 // ==================== TEST SUITE ====================
 
 type TaskServiceTestSuite struct {
@@ -198,6 +520,9 @@ func (suite *TaskServiceTestSuite) SetupTest() {
 	suite.service = service.NewTaskService(suite.repo, suite.cache, metricsCollector)
 	suite.testUserID = "test-user-123"
 	suite.testTaskID = "test-task-456"
+
+	// Users start at cache version 0
+	suite.cache.On("UserCacheVersion", mock.Anything, mock.Anything).Return(int64(0), nil).Maybe()
 }
 
 func (suite *TaskServiceTestSuite) TearDownTest() {
@@ -274,40 +599,137 @@ func (suite *TaskServiceTestSuite) TestCreateTask_ValidationError_EmptyTitle() {
 	assert.Equal(suite.T(), 1, suite.metricsCalls.validationErrors)
 }
 
-func (suite *TaskServiceTestSuite) TestCreateTask_ValidationError_InvalidStatus() {
-	req := &service.CreateTaskRequest{
-		UserID: suite.testUserID,
-		Title:  "Test Task",
-		Status: "INVALID_STATUS", // Invalid status
+func (suite *TaskServiceTestSuite) TestCreateTasksBatch_Success() {
+	otherUserID := "other-user-id"
+	reqs := []*service.CreateTaskRequest{
+		{UserID: suite.testUserID, Title: "First"},
+		{UserID: suite.testUserID, Title: "Second", Priority: "HIGH"},
+		{UserID: otherUserID, Title: "Third"},
 	}
 
+	suite.repo.On("CreateBatch", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("[]*model.Task")).
+		Return(nil).
+		Once()
+
+	// Each affected user's lists are invalidated exactly once
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), otherUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Times(3)
+
 	// Execute
-	task, err := suite.service.CreateTask(suite.ctx, req)
+	tasks, err := suite.service.CreateTasksBatch(suite.ctx, reqs)
 
 	// Verify
-	assert.Error(suite.T(), err)
-	assert.Nil(suite.T(), task)
-	assert.Contains(suite.T(), err.Error(), "status must be one of")
-	
-	// Verify validation error metric was incremented
-	assert.Equal(suite.T(), 1, suite.metricsCalls.validationErrors)
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), tasks, 3) {
+		assert.Equal(suite.T(), "First", tasks[0].Title)
+		assert.Equal(suite.T(), model.StatusTodo, tasks[0].Status)
+		assert.Equal(suite.T(), model.PriorityHigh, tasks[1].Priority)
+		assert.Equal(suite.T(), otherUserID, tasks[2].UserID)
+	}
 }
 
-func (suite *TaskServiceTestSuite) TestGetTask_CacheHit() {
-	expectedTask := &model.Task{
-		ID:     suite.testTaskID,
-		UserID: suite.testUserID,
-		Title:  "Cached Task",
+func (suite *TaskServiceTestSuite) TestCreateTasksBatch_ValidationErrorAbortsBatch() {
+	reqs := []*service.CreateTaskRequest{
+		{UserID: suite.testUserID, Title: "Valid"},
+		{UserID: suite.testUserID, Title: ""},
+		{UserID: suite.testUserID, Title: "Also valid"},
 	}
 
-	// Setup expectations - cache hit
-	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(expectedTask, nil).
-		Once()
-
 	// Execute
-	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
-
+	tasks, err := suite.service.CreateTasksBatch(suite.ctx, reqs)
+
+	// Verify nothing was written and the failed index is reported
+	assert.Nil(suite.T(), tasks)
+	var batchErr *service.BatchCreateError
+	if assert.ErrorAs(suite.T(), err, &batchErr) && assert.Len(suite.T(), batchErr.Items, 1) {
+		assert.Equal(suite.T(), 1, batchErr.Items[0].Index)
+		assert.Contains(suite.T(), batchErr.Items[0].Message, "title is required")
+	}
+	suite.repo.AssertNotCalled(suite.T(), "CreateBatch", mock.Anything, mock.Anything)
+	suite.cache.AssertNotCalled(suite.T(), "InvalidateUserTasks", mock.Anything, mock.Anything)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.validationErrors)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTasksBatch_RepositoryErrorReportsIndex() {
+	reqs := []*service.CreateTaskRequest{
+		{UserID: suite.testUserID, Title: "First"},
+		{UserID: suite.testUserID, Title: "Second"},
+	}
+
+	suite.repo.On("CreateBatch", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("[]*model.Task")).
+		Return(&repository.BatchError{Index: 1, Err: errors.New("duplicate key")}).
+		Once()
+
+	// Execute
+	tasks, err := suite.service.CreateTasksBatch(suite.ctx, reqs)
+
+	// Verify
+	assert.Nil(suite.T(), tasks)
+	var batchErr *service.BatchCreateError
+	if assert.ErrorAs(suite.T(), err, &batchErr) && assert.Len(suite.T(), batchErr.Items, 1) {
+		assert.Equal(suite.T(), 1, batchErr.Items[0].Index)
+		assert.NotContains(suite.T(), batchErr.Items[0].Message, "duplicate key")
+	}
+	suite.cache.AssertNotCalled(suite.T(), "InvalidateUserTasks", mock.Anything, mock.Anything)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.databaseErrors)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTasksBatch_TooLarge() {
+	reqs := make([]*service.CreateTaskRequest, service.DefaultConfig().MaxBatchCreate+1)
+	for i := range reqs {
+		reqs[i] = &service.CreateTaskRequest{UserID: suite.testUserID, Title: "Task"}
+	}
+
+	// Execute
+	tasks, err := suite.service.CreateTasksBatch(suite.ctx, reqs)
+
+	// Verify
+	assert.Nil(suite.T(), tasks)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "CreateBatch", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_ValidationError_InvalidStatus() {
+	req := &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Test Task",
+		Status: "INVALID_STATUS", // Invalid status
+	}
+
+	// Execute
+	task, err := suite.service.CreateTask(suite.ctx, req)
+
+	// Verify
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), task)
+	assert.Contains(suite.T(), err.Error(), "status must be one of")
+	
+	// Verify validation error metric was incremented
+	assert.Equal(suite.T(), 1, suite.metricsCalls.validationErrors)
+}
+
+func (suite *TaskServiceTestSuite) TestGetTask_CacheHit() {
+	expectedTask := &model.Task{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  "Cached Task",
+	}
+
+	// Setup expectations - cache hit
+	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(expectedTask, nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
+
 	// Verify
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), task)
@@ -362,6 +784,10 @@ func (suite *TaskServiceTestSuite) TestGetTask_NotFound() {
 		Return(nil, nil). // Not found
 		Once()
 
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil).
+		Once()
+
 	// Execute
 	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
 
@@ -374,6 +800,142 @@ func (suite *TaskServiceTestSuite) TestGetTask_NotFound() {
 	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
 }
 
+func (suite *TaskServiceTestSuite) TestGetTask_NotFoundDropsStaleCacheEntry() {
+	// The cached entry for a deleted task cannot be decoded
+	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil, errors.New("invalid character")).
+		Once()
+
+	suite.repo.On("FindByID", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil, nil).
+		Once()
+
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
+
+	// Verify the stale entry is removed along with the not-found response
+	assert.Nil(suite.T(), task)
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+	suite.cache.AssertCalled(suite.T(), "DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID)
+}
+
+func (suite *TaskServiceTestSuite) TestRestoreTask_Success() {
+	restoredTask := &model.Task{
+		ID:       suite.testTaskID,
+		UserID:   suite.testUserID,
+		Title:    "Restored",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityMedium,
+	}
+
+	suite.repo.On("Restore", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(restoredTask, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), restoredTask).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.RestoreTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify the restored task is re-cached
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), restoredTask, task)
+	suite.cache.AssertCalled(suite.T(), "SetTask", mock.AnythingOfType("*context.valueCtx"), restoredTask)
+}
+
+func (suite *TaskServiceTestSuite) TestRestoreTask_NotInTrash() {
+	suite.repo.On("Restore", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.RestoreTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify
+	assert.Nil(suite.T(), task)
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+	suite.cache.AssertNotCalled(suite.T(), "SetTask", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestPurgeTask_RemovesCacheEntry() {
+	purged := &model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityMedium,
+		DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}}
+	suite.repo.On("Purge", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(purged, nil).
+		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := suite.service.PurgeTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify the trashed task is gone from the cache and the gauges are left alone
+	assert.NoError(suite.T(), err)
+	suite.cache.AssertCalled(suite.T(), "DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID)
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByStatus)
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByPriority)
+}
+
+func (suite *TaskServiceTestSuite) TestPurgeTask_NotInTrash() {
+	suite.repo.On("Purge", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	err := suite.service.PurgeTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify live or missing tasks are rejected
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+	suite.cache.AssertNotCalled(suite.T(), "DeleteTask", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestPurgeUserData_ClearsCacheAndGauges() {
+	live := &model.Task{ID: "task-1", Status: model.StatusTodo, Priority: model.PriorityHigh}
+	trashed := &model.Task{ID: "task-2", Status: model.StatusDone, Priority: model.PriorityLow,
+		DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}}
+	suite.repo.On("PurgeByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(&repository.PurgeResult{Tasks: []*model.Task{live, trashed}, WebhooksDeleted: 1}, nil).
+		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), "task-1").
+		Return(nil).
+		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), "task-2").
+		Return(nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("DeleteLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	result, err := suite.service.PurgeUserData(suite.ctx, suite.testUserID)
+
+	// Verify every cached entry for the user was dropped
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Tasks, 2)
+	suite.cache.AssertExpectations(suite.T())
+
+	// Only the live task was still counted; the trashed one left on delete
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+	assert.NotContains(suite.T(), suite.metricsCalls.updateTasksCountByStatus, "DONE")
+	assert.NotContains(suite.T(), suite.metricsCalls.updateTasksCountByPriority, "LOW")
+}
+
 func (suite *TaskServiceTestSuite) TestGetTaskByUser_Success() {
 	expectedTask := &model.Task{
 		ID:     suite.testTaskID,
@@ -387,7 +949,7 @@ func (suite *TaskServiceTestSuite) TestGetTaskByUser_Success() {
 		Once()
 
 	// Execute
-	task, err := suite.service.GetTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID)
+	task, err := suite.service.GetTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID, false)
 
 	// Verify
 	assert.NoError(suite.T(), err)
@@ -399,6 +961,37 @@ func (suite *TaskServiceTestSuite) TestGetTaskByUser_Success() {
 	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
 }
 
+func (suite *TaskServiceTestSuite) TestGetTaskByUser_IncludeDeleted() {
+	deletedTask := &model.Task{
+		ID:        suite.testTaskID,
+		UserID:    suite.testUserID,
+		Title:     "Deleted Task",
+		DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true},
+	}
+
+	// Deleted tasks are never cached, so both lookups miss
+	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil, nil).
+		Twice()
+	suite.repo.On("FindByIDAndUserIncludingDeleted", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(deletedTask, nil).
+		Once()
+	suite.repo.On("FindByIDAndUserIncludingDeleted", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, "other-user").
+		Return(nil, nil).
+		Once()
+
+	// The owner sees their deleted task
+	task, err := suite.service.GetTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID, true)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), task.DeletedAt.Valid)
+	suite.cache.AssertNotCalled(suite.T(), "SetTask", mock.Anything, mock.Anything)
+
+	// Anyone else still gets NotFound
+	task, err = suite.service.GetTaskByUser(suite.ctx, suite.testTaskID, "other-user", true)
+	assert.Nil(suite.T(), task)
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
+
 func (suite *TaskServiceTestSuite) TestGetTaskByUser_WrongUser() {
 	expectedTask := &model.Task{
 		ID:     suite.testTaskID,
@@ -413,16 +1006,16 @@ func (suite *TaskServiceTestSuite) TestGetTaskByUser_WrongUser() {
 		Once()
 
 	// Execute
-	task, err := suite.service.GetTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID)
+	task, err := suite.service.GetTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID, false)
 
 	// Verify
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), task)
 	
-	// Check for PermissionDenied error
+	// Another user's task is reported as missing, as on a cache miss
 	st, ok := status.FromError(err)
 	assert.True(suite.T(), ok)
-	assert.Equal(suite.T(), codes.PermissionDenied, st.Code())
+	assert.Equal(suite.T(), codes.NotFound, st.Code())
 	assert.Contains(suite.T(), st.Message(), "task not found")
 	
 	// IMPORTANT: Verify cache hit metric was NOT incremented
@@ -436,106 +1029,315 @@ func (suite *TaskServiceTestSuite) TestGetTaskByUser_WrongUser() {
 	assert.Equal(suite.T(), 0, suite.metricsCalls.cacheHits)
 }
 
-func (suite *TaskServiceTestSuite) TestUpdateTask_Success() {
-	title := "Updated Title"
-	status := "IN_PROGRESS"
-	priority := "HIGH"
-	
-	req := &service.UpdateTaskRequest{
-		ID:          suite.testTaskID,
-		UserID:      suite.testUserID,
-		Title:       &title,
-		Status:      &status,
-		Priority:    &priority,
-	}
+func (suite *TaskServiceTestSuite) TestGetTasksByIDs_MixedCacheHitsAndMisses() {
+	const (
+		idA       = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601"
+		idB       = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f602"
+		idC       = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f603"
+		idMissing = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f604"
+	)
+	taskA := &model.Task{ID: idA, UserID: suite.testUserID, Title: "A"}
+	taskB := &model.Task{ID: idB, UserID: suite.testUserID, Title: "B"}
+	taskC := &model.Task{ID: idC, UserID: suite.testUserID, Title: "C"}
 
-	existingTask := &model.Task{
-		ID:          suite.testTaskID,
-		UserID:      suite.testUserID,
-		Title:       "Original Title",
-		Description: "Original Description",
-		Status:      model.StatusTodo,
-		Priority:    model.PriorityMedium,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
+	// Duplicates and malformed ids never reach cache or database
+	suite.cache.On("GetTasks", mock.AnythingOfType("*context.valueCtx"), []string{idA, idB, idC, idMissing}).
+		Return(map[string]*model.Task{idB: taskB}, nil).
+		Once()
+	suite.repo.On("FindByIDs", mock.AnythingOfType("*context.valueCtx"), []string{idA, idC, idMissing}).
+		Return([]*model.Task{taskC, taskA}, nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), taskA).Return(nil).Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), taskC).Return(nil).Once()
 
-	updatedTask := &model.Task{
-		ID:          suite.testTaskID,
-		UserID:      suite.testUserID,
-		Title:       "Updated Title",
-		Description: "Original Description",
-		Status:      model.StatusInProgress,
-		Priority:    model.PriorityHigh,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
+	// Execute
+	tasks, err := suite.service.GetTasksByIDs(suite.ctx, []string{idA, idB, "not-a-uuid", idC, idMissing, idA})
 
-	// Setup expectations
-	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
-		Return(existingTask, nil).
-		Once()
-	
-	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
-		Return(updatedTask, nil).
+	// Verify: request order, missing ids omitted
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*model.Task{taskA, taskB, taskC}, tasks)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
+	assert.Equal(suite.T(), 3, suite.metricsCalls.cacheMisses)
+	suite.cache.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestGetTasksByIDs_AllCached() {
+	const idA, idB = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601", "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f602"
+	taskA := &model.Task{ID: idA, UserID: suite.testUserID}
+	taskB := &model.Task{ID: idB, UserID: suite.testUserID}
+
+	suite.cache.On("GetTasks", mock.AnythingOfType("*context.valueCtx"), []string{idB, idA}).
+		Return(map[string]*model.Task{idA: taskA, idB: taskB}, nil).
 		Once()
-	
-	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), updatedTask).
-		Return(nil).
+
+	// Execute
+	tasks, err := suite.service.GetTasksByIDs(suite.ctx, []string{idB, idA})
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*model.Task{taskB, taskA}, tasks)
+	suite.repo.AssertNotCalled(suite.T(), "FindByIDs", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestGetTasksByIDs_CacheErrorFallsBackToDatabase() {
+	const idA = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601"
+	taskA := &model.Task{ID: idA, UserID: suite.testUserID}
+
+	suite.cache.On("GetTasks", mock.AnythingOfType("*context.valueCtx"), []string{idA}).
+		Return(nil, errors.New("redis down")).
 		Once()
-	
-	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
-		Return(nil).
+	suite.repo.On("FindByIDs", mock.AnythingOfType("*context.valueCtx"), []string{idA}).
+		Return([]*model.Task{taskA}, nil).
 		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), taskA).Return(nil).Once()
 
 	// Execute
-	task, err := suite.service.UpdateTask(suite.ctx, req)
+	tasks, err := suite.service.GetTasksByIDs(suite.ctx, []string{idA})
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	assert.NotNil(suite.T(), task)
-	assert.Equal(suite.T(), "Updated Title", task.Title)
-	assert.Equal(suite.T(), model.StatusInProgress, task.Status)
-	assert.Equal(suite.T(), model.PriorityHigh, task.Priority)
-	
-	// Verify metrics were updated for status and priority changes
-	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["TODO"])
-	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByStatus["IN_PROGRESS"])
-	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["MEDIUM"])
-	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+	assert.Equal(suite.T(), []*model.Task{taskA}, tasks)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheErrors)
 }
 
-func (suite *TaskServiceTestSuite) TestUpdateTask_TaskNotFound() {
-	title := "Updated Title"
-	
-	req := &service.UpdateTaskRequest{
-		ID:     suite.testTaskID,
-		UserID: suite.testUserID,
-		Title:  &title,
-	}
+func (suite *TaskServiceTestSuite) TestGetTasksByIDs_TooMany() {
+	ids := make([]string, service.DefaultConfig().MaxBatchGet+1)
 
-	// Setup expectations
-	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
-		Return(nil, nil). // Task not found
+	// Execute
+	tasks, err := suite.service.GetTasksByIDs(suite.ctx, ids)
+
+	// Verify
+	assert.Nil(suite.T(), tasks)
+	st, ok := status.FromError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), codes.InvalidArgument, st.Code())
+	suite.cache.AssertNotCalled(suite.T(), "GetTasks", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestBulkSetDueDate_InvalidatesCache() {
+	const idA, idB = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601", "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f602"
+	dueDate := time.Now().Add(72 * time.Hour).UTC()
+
+	// idB belongs to someone else, so only idA comes back
+	suite.repo.On("BulkSetDueDate", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, []string{idA, idB}, &dueDate).
+		Return([]*model.Task{{ID: idA, UserID: suite.testUserID, DueDate: &dueDate}}, nil).
 		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), idA).Return(nil).Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil).Once()
 
 	// Execute
-	task, err := suite.service.UpdateTask(suite.ctx, req)
+	updated, err := suite.service.BulkSetDueDate(suite.ctx, suite.testUserID, []string{idA, idB}, &dueDate)
 
 	// Verify
-	assert.Error(suite.T(), err)
-	assert.Nil(suite.T(), task)
-	assert.Contains(suite.T(), err.Error(), "not found")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, updated)
+	suite.cache.AssertExpectations(suite.T())
+	suite.cache.AssertNotCalled(suite.T(), "DeleteTask", mock.Anything, idB)
 }
 
-func (suite *TaskServiceTestSuite) TestUpdateTask_PartialUpdate() {
-	title := "Updated Title Only"
+func (suite *TaskServiceTestSuite) TestBulkSetDueDate_ValidationError() {
+	for _, ids := range [][]string{nil, {"not-a-uuid"}} {
+		_, err := suite.service.BulkSetDueDate(suite.ctx, suite.testUserID, ids, nil)
+
+		st, ok := status.FromError(err)
+		assert.True(suite.T(), ok)
+		assert.Equal(suite.T(), codes.InvalidArgument, st.Code())
+	}
+	suite.repo.AssertNotCalled(suite.T(), "BulkSetDueDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestBulkAddTags_NormalizesAndInvalidatesCache() {
+	const idA, idB = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601", "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f602"
+
+	// idB already has the tag, so only idA comes back
+	suite.repo.On("BulkAddTags", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, []string{idA, idB}, model.Tags{"urgent"}, 20).
+		Return([]*model.Task{{ID: idA, UserID: suite.testUserID, Tags: model.Tags{"urgent"}}}, nil).
+		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), idA).Return(nil).Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil).Once()
+
+	updated, err := suite.service.BulkAddTags(suite.ctx, suite.testUserID, []string{idA, idB}, []string{" Urgent", "urgent"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, updated)
+	suite.cache.AssertExpectations(suite.T())
+	suite.cache.AssertNotCalled(suite.T(), "DeleteTask", mock.Anything, idB)
+}
+
+func (suite *TaskServiceTestSuite) TestBulkAddTags_TooManyTags() {
+	const id = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601"
+
+	suite.repo.On("BulkAddTags", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, []string{id}, model.Tags{"extra"}, 20).
+		Return(nil, repository.ErrTooManyTags).
+		Once()
+
+	_, err := suite.service.BulkAddTags(suite.ctx, suite.testUserID, []string{id}, []string{"extra"})
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.cache.AssertNotCalled(suite.T(), "InvalidateUserTasks", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestBulkRemoveTags_IgnoresAllowlist() {
+	const id = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601"
+	governed := suite.newAllowlistService("work")
+
+	suite.repo.On("BulkRemoveTags", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, []string{id}, model.Tags{"legacy"}).
+		Return([]*model.Task{}, nil).
+		Once()
+
+	updated, err := governed.BulkRemoveTags(suite.ctx, suite.testUserID, []string{id}, []string{"Legacy"})
+
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), updated)
+	suite.cache.AssertNotCalled(suite.T(), "InvalidateUserTasks", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestBulkTags_ValidationError() {
+	const id = "5e0f1c2a-6b7d-4e8f-9a01-b2c3d4e5f601"
+
+	for _, tc := range []struct {
+		ids, tags []string
+	}{
+		{nil, []string{"work"}},
+		{[]string{"not-a-uuid"}, []string{"work"}},
+		{[]string{id}, nil},
+		{[]string{id}, []string{" "}},
+	} {
+		_, err := suite.service.BulkAddTags(suite.ctx, suite.testUserID, tc.ids, tc.tags)
+		assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err), "add %v %v", tc.ids, tc.tags)
+
+		_, err = suite.service.BulkRemoveTags(suite.ctx, suite.testUserID, tc.ids, tc.tags)
+		assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err), "remove %v %v", tc.ids, tc.tags)
+	}
+	suite.repo.AssertNotCalled(suite.T(), "BulkAddTags", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "BulkRemoveTags", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestSnoozeOverdue_InvalidatesCache() {
+	suite.repo.On("SnoozeOverdue", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("time.Time"), 48*time.Hour).
+		Return([]*model.Task{{ID: suite.testTaskID, UserID: suite.testUserID}}, nil).
+		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).Return(nil).Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil).Once()
+
+	snoozed, err := suite.service.SnoozeOverdue(suite.ctx, suite.testUserID, 48*time.Hour)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, snoozed)
+	suite.cache.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestSnoozeOverdue_RejectsDuration() {
+	for _, by := range []time.Duration{0, -time.Hour, 31 * 24 * time.Hour} {
+		_, err := suite.service.SnoozeOverdue(suite.ctx, suite.testUserID, by)
+
+		assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err), "duration %s", by)
+	}
+	suite.repo.AssertNotCalled(suite.T(), "SnoozeOverdue", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteCompletedTasks_UpdatesGaugesAndCache() {
+	deleted := []*model.Task{
+		{ID: "done-1", UserID: suite.testUserID, Status: model.StatusDone, Priority: model.PriorityHigh},
+		{ID: "done-2", UserID: suite.testUserID, Status: model.StatusDone, Priority: model.PriorityLow},
+		{ID: "archived-1", UserID: suite.testUserID, Status: model.StatusArchived, Priority: model.PriorityHigh},
+	}
+	suite.repo.On("DeleteCompletedByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(deleted, nil).
+		Once()
+	for _, task := range deleted {
+		suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), task.ID).Return(nil).Once()
+	}
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil).Once()
+
+	count, err := suite.service.DeleteCompletedTasks(suite.ctx, suite.testUserID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), count)
+	assert.Equal(suite.T(), -2, suite.metricsCalls.updateTasksCountByStatus["DONE"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["ARCHIVED"])
+	assert.NotContains(suite.T(), suite.metricsCalls.updateTasksCountByStatus, "TODO")
+	assert.Equal(suite.T(), -2, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["LOW"])
+	suite.cache.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteCompletedTasks_NothingToDelete() {
+	suite.repo.On("DeleteCompletedByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return([]*model.Task{}, nil).
+		Once()
+
+	count, err := suite.service.DeleteCompletedTasks(suite.ctx, suite.testUserID)
+
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), count)
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByStatus)
+	suite.cache.AssertNotCalled(suite.T(), "InvalidateUserTasks", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteCompletedTasks_RequiresUser() {
+	_, err := suite.service.DeleteCompletedTasks(suite.ctx, "")
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "DeleteCompletedByUser", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestRestoreAllTasks_UpdatesGaugesAndCache() {
+	restored := []*model.Task{
+		{ID: "todo-1", UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityHigh},
+		{ID: "todo-2", UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityLow},
+		{ID: "done-1", UserID: suite.testUserID, Status: model.StatusDone, Priority: model.PriorityHigh},
+	}
+	suite.repo.On("RestoreAllByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, (*time.Time)(nil)).
+		Return(restored, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil).Once()
+
+	count, err := suite.service.RestoreAllTasks(suite.ctx, suite.testUserID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), count)
+	assert.Equal(suite.T(), 2, suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByStatus["DONE"])
+	assert.Equal(suite.T(), 2, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByPriority["LOW"])
+	suite.cache.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestRestoreAllTasks_LimitedToWindow() {
+	config := service.DefaultConfig()
+	config.RestoreAllWindow = time.Hour
+	windowed := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewNoopPublisher(), config)
+
+	before := time.Now()
+	suite.repo.On("RestoreAllByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.MatchedBy(func(after *time.Time) bool {
+		return after != nil && !after.Before(before.Add(-time.Hour)) && !after.After(time.Now().Add(-time.Hour))
+	})).
+		Return([]*model.Task{}, nil).
+		Once()
+
+	count, err := windowed.RestoreAllTasks(suite.ctx, suite.testUserID)
+
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), count)
+	suite.repo.AssertExpectations(suite.T())
+	suite.cache.AssertNotCalled(suite.T(), "InvalidateUserTasks", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_Success() {
+	title := "Updated Title"
+	status := "IN_PROGRESS"
+	priority := "HIGH"
 	
 	req := &service.UpdateTaskRequest{
-		ID:     suite.testTaskID,
-		UserID: suite.testUserID,
-		Title:  &title,
-		// Status and Priority not provided - should remain unchanged
+		ID:          suite.testTaskID,
+		UserID:      suite.testUserID,
+		Title:       &title,
+		Status:      &status,
+		Priority:    &priority,
 	}
 
 	existingTask := &model.Task{
@@ -545,15 +1347,19 @@ func (suite *TaskServiceTestSuite) TestUpdateTask_PartialUpdate() {
 		Description: "Original Description",
 		Status:      model.StatusTodo,
 		Priority:    model.PriorityMedium,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 
 	updatedTask := &model.Task{
 		ID:          suite.testTaskID,
 		UserID:      suite.testUserID,
-		Title:       "Updated Title Only",
+		Title:       "Updated Title",
 		Description: "Original Description",
-		Status:      model.StatusTodo,     // Unchanged
-		Priority:    model.PriorityMedium, // Unchanged
+		Status:      model.StatusInProgress,
+		Priority:    model.PriorityHigh,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 
 	// Setup expectations
@@ -561,7 +1367,7 @@ func (suite *TaskServiceTestSuite) TestUpdateTask_PartialUpdate() {
 		Return(existingTask, nil).
 		Once()
 	
-	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task"), []string{"title", "status", "priority", "updated_by"}).
 		Return(updatedTask, nil).
 		Once()
 	
@@ -579,379 +1385,2152 @@ func (suite *TaskServiceTestSuite) TestUpdateTask_PartialUpdate() {
 	// Verify
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), task)
-	assert.Equal(suite.T(), "Updated Title Only", task.Title)
-	assert.Equal(suite.T(), model.StatusTodo, task.Status) // Should remain unchanged
-	assert.Equal(suite.T(), model.PriorityMedium, task.Priority) // Should remain unchanged
+	assert.Equal(suite.T(), "Updated Title", task.Title)
+	assert.Equal(suite.T(), model.StatusInProgress, task.Status)
+	assert.Equal(suite.T(), model.PriorityHigh, task.Priority)
 	
-	// Verify metrics were NOT updated (status and priority didn't change)
-	assert.Equal(suite.T(), 0, suite.metricsCalls.updateTasksCountByStatus["TODO"])
-	assert.Equal(suite.T(), 0, suite.metricsCalls.updateTasksCountByPriority["MEDIUM"])
+	// Verify metrics were updated for status and priority changes
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByStatus["IN_PROGRESS"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["MEDIUM"])
+	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
 }
 
-func (suite *TaskServiceTestSuite) TestDeleteTask_Success() {
-	task := &model.Task{
+func (suite *TaskServiceTestSuite) TestUpdateTask_BackToTodo() {
+	req := &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Status: stringPtr("TODO"),
+	}
+
+	existingTask := &model.Task{
 		ID:       suite.testTaskID,
 		UserID:   suite.testUserID,
-		Title:    "Task to Delete",
-		Status:   model.StatusTodo,
+		Title:    "Original Title",
+		Status:   model.StatusInProgress,
 		Priority: model.PriorityMedium,
 	}
 
 	// Setup expectations
-	suite.repo.On("FindByID", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(task, nil).
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(existingTask, nil).
 		Once()
-	
-	suite.repo.On("Delete", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(nil).
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Status == model.StatusTodo && task.UpdatedBy != nil && *task.UpdatedBy == suite.testUserID
+	}), []string{"status", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo}, nil).
 		Once()
-	
-	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
 		Return(nil).
 		Once()
-	
+
 	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
 		Return(nil).
 		Once()
 
 	// Execute
-	err := suite.service.DeleteTask(suite.ctx, suite.testTaskID)
+	task, err := suite.service.UpdateTask(suite.ctx, req)
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	
-	// Verify metrics were updated
-	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["TODO"])
-	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["MEDIUM"])
+	assert.Equal(suite.T(), model.StatusTodo, task.Status)
 }
 
-func (suite *TaskServiceTestSuite) TestDeleteTaskByUser_Success() {
-	task := &model.Task{
-		ID:       suite.testTaskID,
-		UserID:   suite.testUserID,
-		Title:    "Task to Delete",
-		Status:   model.StatusDone,
-		Priority: model.PriorityHigh,
+func (suite *TaskServiceTestSuite) TestUpdateTask_CompletingWeeklyTaskCreatesNext() {
+	dueDate := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	req := &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Status: stringPtr("DONE"),
+	}
+
+	existingTask := &model.Task{
+		ID:             suite.testTaskID,
+		UserID:         suite.testUserID,
+		Title:          "Water plants",
+		Status:         model.StatusTodo,
+		Priority:       model.PriorityHigh,
+		DueDate:        &dueDate,
+		Tags:           model.Tags{"home"},
+		RecurrenceRule: stringPtr("WEEKLY"),
 	}
 
 	// Setup expectations
 	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
-		Return(task, nil).
+		Return(existingTask, nil).
 		Once()
-	
-	suite.repo.On("DeleteByUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
-		Return(nil).
+
+	var next *model.Task
+	suite.repo.On("UpdateWithNext", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Status == model.StatusDone
+	}), mock.AnythingOfType("*model.Task"), []string{"status", "completed_at", "updated_by"}).
+		Run(func(args mock.Arguments) { next = args.Get(2).(*model.Task) }).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone}, nil).
 		Once()
-	
-	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
 		Return(nil).
-		Once()
-	
+		Twice()
+
 	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
 		Return(nil).
 		Once()
 
 	// Execute
-	err := suite.service.DeleteTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID)
+	task, err := suite.service.UpdateTask(suite.ctx, req)
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	
-	// Verify metrics were updated
-	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["DONE"])
-	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+	assert.Equal(suite.T(), model.StatusDone, task.Status)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+	suite.repo.AssertNumberOfCalls(suite.T(), "UpdateWithNext", 1)
+
+	// Exactly one follow-up, due a week later, linked back to its parent
+	if assert.NotNil(suite.T(), next) {
+		assert.Equal(suite.T(), suite.testUserID, next.UserID)
+		assert.Equal(suite.T(), "Water plants", next.Title)
+		assert.Equal(suite.T(), model.StatusTodo, next.Status)
+		assert.Equal(suite.T(), model.PriorityHigh, next.Priority)
+		assert.Equal(suite.T(), model.Tags{"home"}, next.Tags)
+		assert.Equal(suite.T(), dueDate.AddDate(0, 0, 7), *next.DueDate)
+		assert.Equal(suite.T(), "WEEKLY", *next.RecurrenceRule)
+		assert.Equal(suite.T(), suite.testTaskID, *next.ParentTaskID)
+	}
 }
 
-func (suite *TaskServiceTestSuite) TestListTasks_Success() {
-	filter := &repository.TaskFilter{
-		Status: stringPtr("TODO"),
-		SortBy: "created_at",
-		SortDesc: true,
-	}
-	
-	tasks := []*model.Task{
-		{
-			ID:     "task-1",
-			UserID: suite.testUserID,
-			Title:  "Task 1",
-			Status: model.StatusTodo,
-			Priority: model.PriorityMedium,
-		},
-		{
-			ID:     "task-2",
-			UserID: suite.testUserID,
-			Title:  "Task 2",
-			Status: model.StatusTodo,
-			Priority: model.PriorityHigh,
-		},
+func (suite *TaskServiceTestSuite) TestUpdateTask_RecurringGenerationDisabled() {
+	config := service.DefaultConfig()
+	config.GenerateRecurring = false
+	noRecurrence := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewNoopPublisher(), config)
+
+	req := &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Status: stringPtr("DONE"),
 	}
-	
-	const total int64 = 2
 
 	// Setup expectations
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return([]*model.Task(nil), int64(0), nil). // Cache miss
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, RecurrenceRule: stringPtr("DAILY")}, nil).
 		Once()
-	
-	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), filter, 1, 10).
-		Return(tasks, total, nil).
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task"), []string{"status", "completed_at", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone}, nil).
 		Once()
-	
-	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), tasks, total).
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
 		Return(nil).
 		Once()
 
 	// Execute
-	resultTasks, resultTotal, err := suite.service.ListTasks(suite.ctx, filter, 1, 10)
+	_, err := noRecurrence.UpdateTask(suite.ctx, req)
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), resultTasks, 2)
-	assert.Equal(suite.T(), total, resultTotal)
-	assert.Equal(suite.T(), "Task 1", resultTasks[0].Title)
-	assert.Equal(suite.T(), "Task 2", resultTasks[1].Title)
-	
-	// Verify cache miss metric was incremented
-	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
+	suite.repo.AssertNotCalled(suite.T(), "UpdateWithNext", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
-func (suite *TaskServiceTestSuite) TestListTasks_CacheHit() {
-	tasks := []*model.Task{
-		{
-			ID:     "task-1",
-			UserID: suite.testUserID,
-			Title:  "Cached Task",
-		},
-	}
-	
-	const total int64 = 1
+func (suite *TaskServiceTestSuite) TestCompleteTask_RecurringReturnsNext() {
+	dueDate := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
 
-	// Setup expectations - cache hit
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return(tasks, total, nil). // Cache hit
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{
+			ID:             suite.testTaskID,
+			UserID:         suite.testUserID,
+			Title:          "Water plants",
+			Status:         model.StatusInProgress,
+			Priority:       model.PriorityMedium,
+			DueDate:        &dueDate,
+			RecurrenceRule: stringPtr("DAILY"),
+		}, nil).
+		Once()
+
+	suite.repo.On("UpdateWithNext", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Status == model.StatusDone && task.CompletedAt != nil
+	}), mock.AnythingOfType("*model.Task"), []string{"status", "completed_at", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone}, nil).
+		Once()
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Twice()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
 		Once()
 
 	// Execute
-	resultTasks, resultTotal, err := suite.service.ListTasks(suite.ctx, nil, 1, 10)
+	completed, next, err := suite.service.CompleteTask(suite.ctx, suite.testTaskID, suite.testUserID)
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), resultTasks, 1)
-	assert.Equal(suite.T(), total, resultTotal)
-	assert.Equal(suite.T(), "Cached Task", resultTasks[0].Title)
-	
-	// Verify cache hit metric was incremented
-	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
-	
-	// Repository should NOT be called for cache hit
-	suite.repo.AssertNotCalled(suite.T(), "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(suite.T(), model.StatusDone, completed.Status)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+	if assert.NotNil(suite.T(), next) {
+		assert.Equal(suite.T(), "Water plants", next.Title)
+		assert.Equal(suite.T(), model.StatusTodo, next.Status)
+		assert.Equal(suite.T(), dueDate.AddDate(0, 0, 1), *next.DueDate)
+		assert.Equal(suite.T(), suite.testTaskID, *next.ParentTaskID)
+	}
 }
 
-func (suite *TaskServiceTestSuite) TestListTasksByUser_Success() {
-	filter := &repository.TaskFilter{
+func (suite *TaskServiceTestSuite) TestCompleteTask_NonRecurringReturnsNoNext() {
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo}, nil).
+		Once()
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Status == model.StatusDone
+	}), []string{"status", "completed_at", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone}, nil).
+		Once()
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	completed, next, err := suite.service.CompleteTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusDone, completed.Status)
+	assert.Nil(suite.T(), next)
+	suite.repo.AssertNotCalled(suite.T(), "UpdateWithNext", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestCompleteTask_NotFound() {
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, nil).
+		Once()
+
+	_, _, err := suite.service.CompleteTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
+
+func (suite *TaskServiceTestSuite) TestArchiveTask() {
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Version: 2}, nil).
+		Twice()
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Status == model.StatusArchived
+	}), []string{"status", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusArchived, Version: 3}, nil).
+		Once()
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.ArchiveTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusArchived, task.Status)
+	suite.cache.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestArchiveTask_AlreadyArchived() {
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusArchived}, nil).
+		Once()
+
+	task, err := suite.service.ArchiveTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusArchived, task.Status)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestUnarchiveTask() {
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusArchived}, nil).
+		Twice()
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Status == model.StatusTodo
+	}), []string{"status", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo}, nil).
+		Once()
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.UnarchiveTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusTodo, task.Status)
+}
+
+func (suite *TaskServiceTestSuite) TestUnarchiveTask_LeavesDoneTaskDone() {
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone}, nil).
+		Once()
+
+	task, err := suite.service.UnarchiveTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusDone, task.Status)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestArchiveTask_NotFound() {
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, nil).
+		Once()
+
+	_, err := suite.service.ArchiveTask(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_StampsCompletedAt() {
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Task", Status: model.StatusInProgress}, nil).
+		Once()
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.CompletedAt != nil
+	}), []string{"status", "completed_at", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone}, nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+
+	_, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Status: stringPtr("DONE"),
+	})
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_ReopeningClearsCompletedAt() {
+	completedAt := time.Now().Add(-time.Hour)
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Task", Status: model.StatusDone, CompletedAt: &completedAt}, nil).
+		Once()
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.CompletedAt == nil
+	}), []string{"status", "completed_at", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo}, nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+
+	_, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
 		Status: stringPtr("TODO"),
-		SortBy: "created_at",
-		SortDesc: true,
+	})
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_UnrelatedUpdateKeepsCompletedAt() {
+	completedAt := time.Now().Add(-time.Hour)
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Task", Status: model.StatusDone, CompletedAt: &completedAt}, nil).
+		Once()
+	// Neither a title change nor re-sending DONE writes completed_at
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.CompletedAt != nil && task.CompletedAt.Equal(completedAt)
+	}), []string{"title", "status", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Renamed", Status: model.StatusDone, CompletedAt: &completedAt}, nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+
+	_, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  stringPtr("Renamed"),
+		Status: stringPtr("DONE"),
+	})
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_InvalidRecurrenceRule() {
+	req := &service.UpdateTaskRequest{
+		ID:             suite.testTaskID,
+		UserID:         suite.testUserID,
+		RecurrenceRule: stringPtr("YEARLY"),
 	}
-	
-	tasks := []*model.Task{
-		{
-			ID:     "task-1",
-			UserID: suite.testUserID,
-			Title:  "User Task 1",
-			Status: model.StatusTodo,
-			Priority: model.PriorityMedium,
-		},
-		{
-			ID:     "task-2",
-			UserID: suite.testUserID,
-			Title:  "User Task 2",
-			Status: model.StatusTodo,
-			Priority: model.PriorityHigh,
-		},
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo}, nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.Nil(suite.T(), task)
+	st, ok := status.FromError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), codes.InvalidArgument, st.Code())
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_ClearDescription() {
+	req := &service.UpdateTaskRequest{
+		ID:          suite.testTaskID,
+		UserID:      suite.testUserID,
+		Description: stringPtr(""),
+	}
+
+	existingTask := &model.Task{
+		ID:          suite.testTaskID,
+		UserID:      suite.testUserID,
+		Title:       "Original Title",
+		Description: "Original Description",
+		Status:      model.StatusTodo,
+		Priority:    model.PriorityMedium,
+	}
+
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(existingTask, nil).
+		Once()
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.Description == "" && task.Title == "Original Title"
+	}), []string{"description", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Original Title"}, nil).
+		Once()
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), task.Description)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_ClearDueDate() {
+	dueDate := time.Now().Add(24 * time.Hour)
+	req := &service.UpdateTaskRequest{
+		ID:           suite.testTaskID,
+		UserID:       suite.testUserID,
+		ClearDueDate: true,
+	}
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Task", Status: model.StatusTodo, DueDate: &dueDate}, nil).
+		Once()
+
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return task.DueDate == nil
+	}), []string{"due_date", "updated_by"}).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Task"}, nil).
+		Once()
+
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), task.DueDate)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_EmptyTitle() {
+	req := &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  stringPtr(""),
 	}
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Task", Status: model.StatusTodo}, nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.Nil(suite.T(), task)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_TaskNotFound() {
+	title := "Updated Title"
 	
-	const total int64 = 2
+	req := &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  &title,
+	}
+
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, nil). // Task not found
+		Once()
+
+	// Execute
+	task, err := suite.service.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), task)
+	assert.Contains(suite.T(), err.Error(), "not found")
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_StaleExpectedVersion() {
+	title := "Updated Title"
+	expected := 2
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Changed elsewhere", Version: 3}, nil).
+		Once()
+
+	task, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:              suite.testTaskID,
+		UserID:          suite.testUserID,
+		Title:           &title,
+		ExpectedVersion: &expected,
+	})
+
+	assert.Nil(suite.T(), task)
+	assert.Equal(suite.T(), codes.Aborted, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_ConcurrentWriteWins() {
+	title := "Updated Title"
+
+	// Another update lands between the read and the write
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Original Title", Version: 1}, nil).
+		Once()
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task"), []string{"title", "updated_by"}).
+		Return(nil, repository.ErrVersionConflict).
+		Once()
+
+	task, err := suite.service.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  &title,
+	})
+
+	assert.Nil(suite.T(), task)
+	assert.Equal(suite.T(), codes.Aborted, status.Code(err))
+	assert.Equal(suite.T(), "task was modified", status.Convert(err).Message())
+	suite.cache.AssertNotCalled(suite.T(), "SetTask", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_PartialUpdate() {
+	title := "Updated Title Only"
+	
+	req := &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  &title,
+		// Status and Priority not provided - should remain unchanged
+	}
+
+	existingTask := &model.Task{
+		ID:          suite.testTaskID,
+		UserID:      suite.testUserID,
+		Title:       "Original Title",
+		Description: "Original Description",
+		Status:      model.StatusTodo,
+		Priority:    model.PriorityMedium,
+	}
+
+	updatedTask := &model.Task{
+		ID:          suite.testTaskID,
+		UserID:      suite.testUserID,
+		Title:       "Updated Title Only",
+		Description: "Original Description",
+		Status:      model.StatusTodo,     // Unchanged
+		Priority:    model.PriorityMedium, // Unchanged
+	}
+
+	// Setup expectations
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(existingTask, nil).
+		Once()
+	
+	suite.repo.On("Update", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task"), []string{"title", "updated_by"}).
+		Return(updatedTask, nil).
+		Once()
+	
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), updatedTask).
+		Return(nil).
+		Once()
+	
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	task, err := suite.service.UpdateTask(suite.ctx, req)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), task)
+	assert.Equal(suite.T(), "Updated Title Only", task.Title)
+	assert.Equal(suite.T(), model.StatusTodo, task.Status) // Should remain unchanged
+	assert.Equal(suite.T(), model.PriorityMedium, task.Priority) // Should remain unchanged
+	
+	// Verify metrics were NOT updated (status and priority didn't change)
+	assert.Equal(suite.T(), 0, suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Equal(suite.T(), 0, suite.metricsCalls.updateTasksCountByPriority["MEDIUM"])
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteTask_Success() {
+	task := &model.Task{
+		ID:       suite.testTaskID,
+		UserID:   suite.testUserID,
+		Title:    "Task to Delete",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityMedium,
+	}
+
+	// Setup expectations
+	suite.repo.On("Delete", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(task, nil).
+		Once()
+	
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil).
+		Once()
+	
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := suite.service.DeleteTask(suite.ctx, suite.testTaskID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	
+	// Verify metrics were updated
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["MEDIUM"])
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteTaskByUser_Success() {
+	task := &model.Task{
+		ID:       suite.testTaskID,
+		UserID:   suite.testUserID,
+		Title:    "Task to Delete",
+		Status:   model.StatusDone,
+		Priority: model.PriorityHigh,
+	}
+
+	// Setup expectations
+	suite.repo.On("DeleteByUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(task, nil).
+		Once()
+	
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil).
+		Once()
+	
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := suite.service.DeleteTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	
+	// Verify metrics were updated
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["DONE"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteTask_DecrementsStoredStatusNotCached() {
+	// The cache still holds the task as it was before it was completed
+	suite.cache.On("GetTask", mock.Anything, suite.testTaskID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityLow}, nil).
+		Maybe()
+	suite.repo.On("Delete", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusDone, Priority: model.PriorityHigh}, nil).
+		Once()
+	suite.cache.On("DeleteTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).Return(nil)
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+
+	err := suite.service.DeleteTask(suite.ctx, suite.testTaskID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["DONE"])
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByPriority["HIGH"])
+	assert.Zero(suite.T(), suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Zero(suite.T(), suite.metricsCalls.updateTasksCountByPriority["LOW"])
+	suite.cache.AssertNotCalled(suite.T(), "GetTask", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestDeleteTaskByUser_NotFound() {
+	suite.repo.On("DeleteByUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, gorm.ErrRecordNotFound).
+		Once()
+
+	err := suite.service.DeleteTaskByUser(suite.ctx, suite.testTaskID, suite.testUserID)
+
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByStatus)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasks_Success() {
+	filter := &repository.TaskFilter{
+		Status: stringPtr("TODO"),
+		SortBy: "created_at",
+		SortDesc: true,
+	}
+	
+	tasks := []*model.Task{
+		{
+			ID:     "task-1",
+			UserID: suite.testUserID,
+			Title:  "Task 1",
+			Status: model.StatusTodo,
+			Priority: model.PriorityMedium,
+		},
+		{
+			ID:     "task-2",
+			UserID: suite.testUserID,
+			Title:  "Task 2",
+			Status: model.StatusTodo,
+			Priority: model.PriorityHigh,
+		},
+	}
+	
+	const total int64 = 2
+
+	// Setup expectations
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return([]*model.Task(nil), int64(0), nil). // Cache miss
+		Once()
+	
+	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), filter, 1, 10).
+		Return(tasks, total, nil).
+		Once()
+	
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), tasks, total).
+		Return(nil).
+		Once()
+
+	// Execute
+	resultTasks, resultTotal, err := suite.service.ListTasks(suite.ctx, filter, 1, 10)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resultTasks, 2)
+	assert.Equal(suite.T(), total, resultTotal)
+	assert.Equal(suite.T(), "Task 1", resultTasks[0].Title)
+	assert.Equal(suite.T(), "Task 2", resultTasks[1].Title)
+	
+	// Verify cache miss metric was incremented
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasks_CacheHit() {
+	tasks := []*model.Task{
+		{
+			ID:     "task-1",
+			UserID: suite.testUserID,
+			Title:  "Cached Task",
+		},
+	}
+	
+	const total int64 = 1
+
+	// Setup expectations - cache hit
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return(tasks, total, nil). // Cache hit
+		Once()
+
+	// Execute
+	resultTasks, resultTotal, err := suite.service.ListTasks(suite.ctx, nil, 1, 10)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resultTasks, 1)
+	assert.Equal(suite.T(), total, resultTotal)
+	assert.Equal(suite.T(), "Cached Task", resultTasks[0].Title)
+	
+	// Verify cache hit metric was incremented
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
+	
+	// Repository should NOT be called for cache hit
+	suite.repo.AssertNotCalled(suite.T(), "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksByUser_Success() {
+	filter := &repository.TaskFilter{
+		Status: stringPtr("TODO"),
+		SortBy: "created_at",
+		SortDesc: true,
+	}
+	
+	tasks := []*model.Task{
+		{
+			ID:     "task-1",
+			UserID: suite.testUserID,
+			Title:  "User Task 1",
+			Status: model.StatusTodo,
+			Priority: model.PriorityMedium,
+		},
+		{
+			ID:     "task-2",
+			UserID: suite.testUserID,
+			Title:  "User Task 2",
+			Status: model.StatusTodo,
+			Priority: model.PriorityHigh,
+		},
+	}
+	
+	const total int64 = 2
+
+	// Setup expectations
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return([]*model.Task(nil), int64(0), nil). // Cache miss
+		Once()
+	
+	suite.repo.On("ListByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, filter, 1, 10).
+		Return(tasks, total, nil).
+		Once()
+	
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), tasks, total).
+		Return(nil).
+		Once()
+
+	// Execute
+	resultTasks, resultTotal, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, filter, 1, 10)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resultTasks, 2)
+	assert.Equal(suite.T(), total, resultTotal)
+	assert.Equal(suite.T(), "User Task 1", resultTasks[0].Title)
+	assert.Equal(suite.T(), "User Task 2", resultTasks[1].Title)
+	
+	// Verify cache miss metric was incremented
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksByUser_CacheHit() {
+	tasks := []*model.Task{
+		{
+			ID:     "task-1",
+			UserID: suite.testUserID,
+			Title:  "Cached User Task",
+		},
+	}
+	
+	const total int64 = 1
+
+	// Setup expectations - cache hit
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return(tasks, total, nil). // Cache hit
+		Once()
+
+	// Execute
+	resultTasks, resultTotal, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, nil, 1, 10)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resultTasks, 1)
+	assert.Equal(suite.T(), total, resultTotal)
+	assert.Equal(suite.T(), "Cached User Task", resultTasks[0].Title)
+	
+	// Verify cache hit metric was incremented
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
+	
+	// Repository should NOT be called for cache hit
+	suite.repo.AssertNotCalled(suite.T(), "ListByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksByUser_SortByUrgency() {
+	filter := &repository.TaskFilter{SortBy: "urgency", SortDesc: true}
+	dueDate := time.Now().Add(-time.Hour)
+	tasks := []*model.Task{
+		{ID: "task-1", UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityLow, DueDate: &dueDate, UpdatedAt: time.Now()},
+		{ID: "task-2", UserID: suite.testUserID, Status: model.StatusDone, Priority: model.PriorityUrgent, UpdatedAt: time.Now()},
+	}
+
+	suite.repo.On("ListByUser", mock.Anything, suite.testUserID, mock.MatchedBy(func(f *repository.TaskFilter) bool {
+		return f.Urgency != nil && *f.Urgency == repository.DefaultUrgencyWeights() && !f.UrgencyAt.IsZero()
+	}), 1, 10).Return(tasks, int64(2), nil).Once()
+
+	resultTasks, _, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, filter, 1, 10)
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), resultTasks, 2) && assert.NotNil(suite.T(), resultTasks[0].UrgencyScore) {
+		assert.InDelta(suite.T(), 2, *resultTasks[0].UrgencyScore, 0.01)
+		assert.Equal(suite.T(), 0.0, *resultTasks[1].UrgencyScore)
+	}
+
+	// Scores drift with time, so urgency-sorted lists bypass the cache
+	suite.cache.AssertNotCalled(suite.T(), "GetTasksList", mock.Anything, mock.Anything)
+	suite.cache.AssertNotCalled(suite.T(), "SetTasksList", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestListTasks_Pagination() {
+	tasks := []*model.Task{
+		{
+			ID:     "task-1",
+			UserID: suite.testUserID,
+			Title:  "Task 1",
+		},
+	}
+	
+	const total int64 = 3
+
+	// Setup expectations - page 1
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return([]*model.Task(nil), int64(0), nil). // Cache miss
+		Once()
+	
+	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), 1, 2).
+		Return(tasks, total, nil).
+		Once()
+	
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), tasks, total).
+		Return(nil).
+		Once()
+
+	// Execute - page 1, size 2
+	resultTasks, resultTotal, err := suite.service.ListTasks(suite.ctx, nil, 1, 2)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resultTasks, 1)
+	assert.Equal(suite.T(), total, resultTotal)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksCursor_Pages() {
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 123456000, time.UTC)
+	tasks := []*model.Task{
+		{ID: "8c5b7a0e-2f1d-4c1e-9a47-3b1f0d6c2a01", UserID: suite.testUserID, CreatedAt: createdAt.Add(2 * time.Minute)},
+		{ID: "8c5b7a0e-2f1d-4c1e-9a47-3b1f0d6c2a02", UserID: suite.testUserID, CreatedAt: createdAt},
+		{ID: "8c5b7a0e-2f1d-4c1e-9a47-3b1f0d6c2a03", UserID: suite.testUserID, CreatedAt: createdAt},
+	}
+
+	// First page: one extra row means another page follows
+	suite.repo.On("ListAfter", mock.AnythingOfType("*context.valueCtx"), (*repository.TaskFilter)(nil), (*repository.Cursor)(nil), 3).
+		Return(tasks, nil).
+		Once()
+
+	page, nextCursor, err := suite.service.ListTasksCursor(suite.ctx, nil, "", 2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), tasks[:2], page)
+	assert.NotEmpty(suite.T(), nextCursor)
+
+	// Second page resumes after the last task returned
+	suite.repo.On("ListAfter", mock.AnythingOfType("*context.valueCtx"), (*repository.TaskFilter)(nil),
+		&repository.Cursor{CreatedAt: createdAt, ID: tasks[1].ID}, 3).
+		Return(tasks[2:], nil).
+		Once()
+
+	page, nextCursor, err = suite.service.ListTasksCursor(suite.ctx, nil, nextCursor, 2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), tasks[2:], page)
+	assert.Empty(suite.T(), nextCursor)
+
+	// Keyset pages never touch the list cache
+	suite.cache.AssertNotCalled(suite.T(), "GetTasksList", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksCursor_InvalidCursor() {
+	for _, cursor := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "MjAyNS0wMy0wMVQxMjowMDowMFp8bm90LWEtdXVpZA"} {
+		_, _, err := suite.service.ListTasksCursor(suite.ctx, nil, cursor, 10)
+
+		st, ok := status.FromError(err)
+		assert.True(suite.T(), ok)
+		assert.Equal(suite.T(), codes.InvalidArgument, st.Code(), "cursor %q", cursor)
+	}
+	suite.repo.AssertNotCalled(suite.T(), "ListAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksCursor_RejectsCustomSort() {
+	filter := &repository.TaskFilter{SortBy: "title"}
+
+	_, _, err := suite.service.ListTasksCursor(suite.ctx, filter, "", 10)
+
+	st, ok := status.FromError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), codes.InvalidArgument, st.Code())
+	suite.repo.AssertNotCalled(suite.T(), "ListAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasks_PageValidation() {
+	// Test page < 1 should default to 1
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return([]*model.Task(nil), int64(0), nil).
+		Once()
+	
+	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), 1, 10). // Should use page 1
+		Return([]*model.Task{}, int64(0), nil).
+		Once()
+	
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+		Return(nil).
+		Once()
+
+	_, _, err := suite.service.ListTasks(suite.ctx, nil, 0, 10) // Page 0
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasks_PageSizeValidation() {
+	// Test pageSize > 100 should default to 100
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Return([]*model.Task(nil), int64(0), nil).
+		Once()
+	
+	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), 1, 100). // Should use size 100
+		Return([]*model.Task{}, int64(0), nil).
+		Once()
+	
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+		Return(nil).
+		Once()
+
+	_, _, err := suite.service.ListTasks(suite.ctx, nil, 1, 150) // Size 150
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksByUser_DueWindowCacheKeys() {
+	weekStart := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	weekEnd := weekStart.AddDate(0, 0, 7)
+	nextWeekEnd := weekEnd.AddDate(0, 0, 7)
+
+	var keys []string
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			keys = append(keys, args.String(1))
+		}).
+		Return([]*model.Task(nil), int64(0), nil).
+		Twice()
+	suite.repo.On("ListByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("*repository.TaskFilter"), 1, 10).
+		Return([]*model.Task{}, int64(0), nil).
+		Twice()
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+		Return(nil).
+		Twice()
+
+	_, _, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, &repository.TaskFilter{DueAfter: &weekStart, DueBefore: &weekEnd}, 1, 10)
+	assert.NoError(suite.T(), err)
+	_, _, err = suite.service.ListTasksByUser(suite.ctx, suite.testUserID, &repository.TaskFilter{DueAfter: &weekStart, DueBefore: &nextWeekEnd}, 1, 10)
+	assert.NoError(suite.T(), err)
+
+	// Different windows must not share a cache entry
+	if assert.Len(suite.T(), keys, 2) {
+		assert.NotEqual(suite.T(), keys[0], keys[1])
+		assert.Contains(suite.T(), keys[0], fmt.Sprintf("due_before:%d", weekEnd.Unix()))
+	}
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksByUser_SearchCacheKeys() {
+	var keys []string
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			keys = append(keys, args.String(1))
+		}).
+		Return([]*model.Task(nil), int64(0), nil).
+		Twice()
+	suite.repo.On("ListByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("*repository.TaskFilter"), 1, 10).
+		Return([]*model.Task{}, int64(0), nil).
+		Twice()
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+		Return(nil).
+		Twice()
+
+	report := "Report"
+	plants := "plants"
+	_, _, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, &repository.TaskFilter{Search: &report}, 1, 10)
+	assert.NoError(suite.T(), err)
+	_, _, err = suite.service.ListTasksByUser(suite.ctx, suite.testUserID, &repository.TaskFilter{Search: &plants}, 1, 10)
+	assert.NoError(suite.T(), err)
+
+	// Different search terms must not share a cache entry
+	if assert.Len(suite.T(), keys, 2) {
+		assert.NotEqual(suite.T(), keys[0], keys[1])
+		assert.Contains(suite.T(), keys[0], "search:report")
+	}
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksByUser_TagCacheKeys() {
+	var keys []string
+	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			keys = append(keys, args.String(1))
+		}).
+		Return([]*model.Task(nil), int64(0), nil).
+		Twice()
+	suite.repo.On("ListByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("*repository.TaskFilter"), 1, 10).
+		Return([]*model.Task{}, int64(0), nil).
+		Twice()
+	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+		Return(nil).
+		Twice()
+
+	_, _, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, &repository.TaskFilter{Tags: []string{"work", "Urgent-Review"}}, 1, 10)
+	assert.NoError(suite.T(), err)
+	_, _, err = suite.service.ListTasksByUser(suite.ctx, suite.testUserID, &repository.TaskFilter{Tags: []string{"urgent-review", "work"}}, 1, 10)
+	assert.NoError(suite.T(), err)
+
+	// The same tag set shares one cache entry regardless of order or case
+	if assert.Len(suite.T(), keys, 2) {
+		assert.Equal(suite.T(), keys[0], keys[1])
+		assert.Contains(suite.T(), keys[0], "tags:urgent-review,work")
+	}
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_NormalizesTags() {
+	req := &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{" Work ", "work", "urgent-review"},
+	}
+
+	suite.repo.On("Create", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return assert.ObjectsAreEqual(model.Tags{"work", "urgent-review"}, task.Tags)
+	})).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Tagged"}, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).
+		Return(nil).
+		Once()
+
+	_, err := suite.service.CreateTask(suite.ctx, req)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_DedupesTagsAfterFolding() {
+	req := &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{"urgent", " Urgent", "URGENT ", "Review"},
+	}
+
+	suite.repo.On("Create", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return assert.ObjectsAreEqual(model.Tags{"urgent", "review"}, task.Tags)
+	})).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Tagged"}, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+
+	_, err := suite.service.CreateTask(suite.ctx, req)
+	assert.NoError(suite.T(), err)
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_KeepsTagCaseWhenNotFolding() {
+	config := service.DefaultConfig()
+	config.FoldTagCase = false
+	caseSensitive := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewNoopPublisher(), config)
+
+	req := &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{"Urgent", " Urgent ", "urgent"},
+	}
+
+	// Whitespace variants still collapse; case variants stay apart
+	suite.repo.On("Create", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return assert.ObjectsAreEqual(model.Tags{"Urgent", "urgent"}, task.Tags)
+	})).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Tagged"}, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+
+	_, err := caseSensitive.CreateTask(suite.ctx, req)
+	assert.NoError(suite.T(), err)
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestCountTasks_MatchesTagsExactlyWhenNotFolding() {
+	config := service.DefaultConfig()
+	config.FoldTagCase = false
+	caseSensitive := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewNoopPublisher(), config)
+
+	// The cache key keeps the tag's case too
+	suite.cache.On("GetCount", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:count:tags:Urgent").Return(nil, nil)
+	suite.cache.On("SetCount", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:count:tags:Urgent", int64(1)).Return(nil)
+	suite.repo.On("CountByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.MatchedBy(func(filter *repository.TaskFilter) bool {
+		return filter.CaseSensitiveTags
+	})).
+		Return(int64(1), nil).
+		Once()
+
+	count, err := caseSensitive.CountTasks(suite.ctx, suite.testUserID, &repository.TaskFilter{Tags: []string{"Urgent"}})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), count)
+}
+
+func (suite *TaskServiceTestSuite) newAllowlistService(allowed ...string) service.TaskService {
+	config := service.DefaultConfig()
+	config.TagAllowlist = allowed
+	return service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewNoopPublisher(), config)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_RejectsTagOutsideAllowlist() {
+	governed := suite.newAllowlistService("work", "home")
+
+	_, err := governed.CreateTask(suite.ctx, &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{"work", "misc"},
+	})
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_AcceptsAllowedTagsAfterNormalizing() {
+	governed := suite.newAllowlistService("work", "home")
+
+	suite.repo.On("Create", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return assert.ObjectsAreEqual(model.Tags{"work", "home"}, task.Tags)
+	})).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Tagged"}, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+
+	_, err := governed.CreateTask(suite.ctx, &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{" Work", "HOME"},
+	})
+
+	assert.NoError(suite.T(), err)
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestUpdateTask_RejectsTagOutsideAllowlist() {
+	governed := suite.newAllowlistService("work")
+	tags := []string{"misc"}
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Tagged", Status: model.StatusTodo}, nil).
+		Maybe()
+
+	_, err := governed.UpdateTask(suite.ctx, &service.UpdateTaskRequest{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Tags:   &tags,
+	})
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_AllowsAnyTagWithoutAllowlist() {
+	suite.repo.On("Create", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(task *model.Task) bool {
+		return assert.ObjectsAreEqual(model.Tags{"anything-goes"}, task.Tags)
+	})).
+		Return(&model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Tagged"}, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).Return(nil)
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*model.Task")).Return(nil)
+
+	_, err := suite.service.CreateTask(suite.ctx, &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{"Anything-Goes"},
+	})
+
+	assert.NoError(suite.T(), err)
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestCreateTask_ValidationError_EmptyTag() {
+	req := &service.CreateTaskRequest{
+		UserID: suite.testUserID,
+		Title:  "Tagged",
+		Tags:   []string{"work", "  "},
+	}
+
+	_, err := suite.service.CreateTask(suite.ctx, req)
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestCacheErrorHandling() {
+	// Test that cache errors don't fail the operation
+	expectedTask := &model.Task{
+		ID:     suite.testTaskID,
+		UserID: suite.testUserID,
+		Title:  "Task",
+	}
+
+	// Setup expectations - cache error
+	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil, assert.AnError). // Cache error
+		Once()
+	
+	suite.repo.On("FindByID", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(expectedTask, nil).
+		Once()
+	
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), expectedTask).
+		Return(assert.AnError). // Cache error on set
+		Once()
+
+	// Execute
+	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
+
+	// Verify
+	assert.NoError(suite.T(), err) // Should not fail even with cache errors
+	assert.NotNil(suite.T(), task)
+	
+	// Verify cache error metric was incremented
+	assert.Equal(suite.T(), 2, suite.metricsCalls.cacheErrors) // One for get, one for set
+}
+
+func (suite *TaskServiceTestSuite) TestDatabaseErrorHandling() {
+	// Setup expectations - database error
+	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil, nil). // Cache miss
+		Once()
+	
+	suite.repo.On("FindByID", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
+		Return(nil, assert.AnError). // Database error
+		Once()
+
+	// Execute
+	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
+
+	// Verify
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), task)
+	
+	// Verify database error metric was incremented
+	assert.Equal(suite.T(), 1, suite.metricsCalls.databaseErrors)
+}
+
+func (suite *TaskServiceTestSuite) TestGetBoard_Success() {
+	todoTasks := []*model.Task{
+		{ID: "todo-1", UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityUrgent},
+		{ID: "todo-2", UserID: suite.testUserID, Status: model.StatusTodo, Priority: model.PriorityLow},
+	}
+	inProgressTasks := []*model.Task{
+		{ID: "progress-1", UserID: suite.testUserID, Status: model.StatusInProgress, Priority: model.PriorityHigh},
+	}
+
+	// Setup expectations
+	suite.cache.On("GetBoard", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:board:2").
+		Return(nil, nil). // Cache miss
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusTodo, 2, repository.ColumnOrder{}).
+		Return(todoTasks, nil).
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusInProgress, 2, repository.ColumnOrder{}).
+		Return(inProgressTasks, nil).
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusDone, 2, repository.ColumnOrder{}).
+		Return([]*model.Task{}, nil).
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusArchived, 2, repository.ColumnOrder{}).
+		Return([]*model.Task{}, nil).
+		Once()
+	suite.cache.On("SetBoard", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:board:2", mock.AnythingOfType("map[string][]*model.Task")).
+		Return(nil).
+		Once()
+
+	// Execute
+	board, err := suite.service.GetBoard(suite.ctx, suite.testUserID, 2)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), board, 4)
+	assert.Equal(suite.T(), todoTasks, board["todo"])
+	assert.Equal(suite.T(), inProgressTasks, board["in_progress"])
+	assert.Empty(suite.T(), board["done"])
+	assert.Empty(suite.T(), board["archived"])
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
+}
+
+func (suite *TaskServiceTestSuite) TestGetBoard_AppliesColumnOrder() {
+	boardOrder, err := service.ParseBoardOrder(map[string]string{
+		"todo": "priority desc",
+		"done": "updated_at desc",
+	})
+	assert.NoError(suite.T(), err)
+
+	ordered := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {}, func() {},
+	), events.NewNoopPublisher(), service.Config{
+		MaxBoardPerColumn: 50,
+		BoardOrder:        boardOrder,
+	})
+
+	suite.cache.On("GetBoard", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:board:5").
+		Return(nil, nil).
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusTodo, 5,
+		repository.ColumnOrder{SortBy: "priority", SortDesc: true}).
+		Return([]*model.Task{}, nil).
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusDone, 5,
+		repository.ColumnOrder{SortBy: "updated_at", SortDesc: true}).
+		Return([]*model.Task{}, nil).
+		Once()
+	// Columns without a configured rule keep the default ordering
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusInProgress, 5, repository.ColumnOrder{}).
+		Return([]*model.Task{}, nil).
+		Once()
+	suite.repo.On("ListTopByUserAndStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, model.StatusArchived, 5, repository.ColumnOrder{}).
+		Return([]*model.Task{}, nil).
+		Once()
+	suite.cache.On("SetBoard", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:board:5", mock.AnythingOfType("map[string][]*model.Task")).
+		Return(nil).
+		Once()
+
+	// Execute
+	_, err = ordered.GetBoard(suite.ctx, suite.testUserID, 5)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksModifiedBy() {
+	tasks := []*model.Task{{ID: suite.testTaskID, UserID: "owner", UpdatedBy: &suite.testUserID}}
+
+	// Out-of-range pagination is clamped like ListTasks
+	suite.repo.On("ListByUpdatedBy", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, 1, 100).
+		Return(tasks, int64(1), nil).
+		Once()
+
+	found, total, err := suite.service.ListTasksModifiedBy(suite.ctx, suite.testUserID, 0, 500)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	assert.Equal(suite.T(), tasks, found)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksModifiedBy_RequiresUserID() {
+	_, _, err := suite.service.ListTasksModifiedBy(suite.ctx, "", 1, 10)
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "ListByUpdatedBy")
+}
+
+func (suite *TaskServiceTestSuite) TestListOverdueTasks_SkipsCache() {
+	tasks := []*model.Task{{ID: suite.testTaskID, UserID: suite.testUserID}}
+
+	suite.repo.On("ListOverdue", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("time.Time"), 1, 100).
+		Return(tasks, int64(1), nil).
+		Once()
+
+	found, total, err := suite.service.ListOverdueTasks(suite.ctx, suite.testUserID, 0, 500)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	assert.Equal(suite.T(), tasks, found)
+	suite.cache.AssertNotCalled(suite.T(), "GetTasksList", mock.Anything, mock.Anything)
+	suite.cache.AssertNotCalled(suite.T(), "SetTasksList", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestListOverdueTasks_RequiresUserID() {
+	_, _, err := suite.service.ListOverdueTasks(suite.ctx, "", 1, 10)
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "ListOverdue")
+}
+
+func (suite *TaskServiceTestSuite) TestListDeletedTasks_ClampsPageSize() {
+	tasks := []*model.Task{{ID: suite.testTaskID, UserID: suite.testUserID}}
+
+	suite.repo.On("ListDeleted", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, 1, 100).
+		Return(tasks, int64(1), nil).
+		Once()
+
+	found, total, err := suite.service.ListDeletedTasks(suite.ctx, suite.testUserID, 0, 500)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	assert.Equal(suite.T(), tasks, found)
+}
+
+func (suite *TaskServiceTestSuite) TestSearchTasks() {
+	tasks := []*model.Task{{ID: suite.testTaskID, UserID: suite.testUserID, Title: "Quarterly report"}}
+
+	// The query is trimmed and the page size clamped to the configured max
+	suite.repo.On("Search", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, "report", 1, 50).
+		Return(tasks, int64(1), nil).
+		Once()
+
+	found, total, err := suite.service.SearchTasks(suite.ctx, suite.testUserID, "  report ", 0, 500)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	assert.Equal(suite.T(), tasks, found)
+}
+
+func (suite *TaskServiceTestSuite) TestSearchTasks_RequiresQuery() {
+	_, _, err := suite.service.SearchTasks(suite.ctx, suite.testUserID, "   ", 1, 10)
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Search")
+}
+
+func (suite *TaskServiceTestSuite) TestParseBoardOrder_Invalid() {
+	for _, spec := range []map[string]string{
+		{"backlog": "priority desc"},
+		{"todo": "owner desc"},
+		{"todo": "priority sideways"},
+		{"todo": ""},
+	} {
+		_, err := service.ParseBoardOrder(spec)
+		assert.Error(suite.T(), err, "spec %v", spec)
+	}
+}
+
+func (suite *TaskServiceTestSuite) TestGetBoard_RejectsOversizedColumns() {
+	limited := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+		func(int) {}, func(string, int) {}, func(string, int) {},
+		func() {}, func() {}, func() {}, func() {},
+		func() { suite.metricsCalls.validationErrors++ },
+	), events.NewNoopPublisher(), service.Config{MaxBoardPerColumn: 5})
+
+	// Execute
+	board, err := limited.GetBoard(suite.ctx, suite.testUserID, 6)
+
+	// Verify
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), board)
+	st, ok := status.FromError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), codes.InvalidArgument, st.Code())
+	assert.Equal(suite.T(), 1, suite.metricsCalls.validationErrors)
+
+	// Rejected before touching cache or database
+	suite.cache.AssertNotCalled(suite.T(), "GetBoard", mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "ListTopByUserAndStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestMoveTaskOnBoard_ChangesStatusAndPosition() {
+	existingTask := &model.Task{
+		ID:       suite.testTaskID,
+		UserID:   suite.testUserID,
+		Title:    "Drag me",
+		Status:   model.StatusTodo,
+		Priority: model.PriorityMedium,
+		Position: 4,
+	}
+	movedTask := &model.Task{
+		ID:       suite.testTaskID,
+		UserID:   suite.testUserID,
+		Title:    "Drag me",
+		Status:   model.StatusInProgress,
+		Priority: model.PriorityMedium,
+		Position: 1,
+	}
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(existingTask, nil).
+		Once()
+	suite.repo.On("MoveOnBoard", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID, model.StatusInProgress, 1, (*model.Task)(nil)).
+		Return(movedTask, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), movedTask).
+		Return(nil).
+		Once()
+
+	task, err := suite.service.MoveTaskOnBoard(suite.ctx, suite.testTaskID, suite.testUserID, "IN_PROGRESS", 1)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusInProgress, task.Status)
+	assert.Equal(suite.T(), 1, task.Position)
+	assert.Equal(suite.T(), -1, suite.metricsCalls.updateTasksCountByStatus["TODO"])
+	assert.Equal(suite.T(), 1, suite.metricsCalls.updateTasksCountByStatus["IN_PROGRESS"])
+}
+
+func (suite *TaskServiceTestSuite) TestMoveTaskOnBoard_WithinColumnLeavesGauges() {
+	existingTask := &model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Position: 0}
+	movedTask := &model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Position: 3}
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(existingTask, nil).
+		Once()
+	suite.repo.On("MoveOnBoard", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID, model.StatusTodo, 3, (*model.Task)(nil)).
+		Return(movedTask, nil).
+		Once()
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), movedTask).
+		Return(nil).
+		Once()
+
+	task, err := suite.service.MoveTaskOnBoard(suite.ctx, suite.testTaskID, suite.testUserID, "TODO", 3)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, task.Position)
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByStatus)
+}
+
+func (suite *TaskServiceTestSuite) TestMoveTaskOnBoard_Validation() {
+	_, err := suite.service.MoveTaskOnBoard(suite.ctx, suite.testTaskID, suite.testUserID, "BLOCKED", 0)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.service.MoveTaskOnBoard(suite.ctx, suite.testTaskID, suite.testUserID, "DONE", -1)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
 
-	// Setup expectations
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return([]*model.Task(nil), int64(0), nil). // Cache miss
+	suite.repo.AssertNotCalled(suite.T(), "MoveOnBoard", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestReorderTask() {
+	existingTask := &model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Position: 0}
+	reorderedTask := &model.Task{ID: suite.testTaskID, UserID: suite.testUserID, Status: model.StatusTodo, Position: 2}
+
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(existingTask, nil).
 		Once()
-	
-	suite.repo.On("ListByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, filter, 1, 10).
-		Return(tasks, total, nil).
+	suite.repo.On("Reorder", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID, 2).
+		Return(reorderedTask, nil).
 		Once()
-	
-	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), tasks, total).
+	suite.cache.On("InvalidateUserTasks", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil).
+		Once()
+	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), reorderedTask).
+		Return(nil).
+		Once()
+
+	task, err := suite.service.ReorderTask(suite.ctx, suite.testTaskID, suite.testUserID, 2)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, task.Position)
+	assert.Equal(suite.T(), model.StatusTodo, task.Status)
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByStatus)
+}
+
+func (suite *TaskServiceTestSuite) TestReorderTask_Validation() {
+	_, err := suite.service.ReorderTask(suite.ctx, suite.testTaskID, suite.testUserID, -1)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.service.ReorderTask(suite.ctx, "", suite.testUserID, 0)
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	suite.repo.AssertNotCalled(suite.T(), "Reorder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestReorderTask_NotFound() {
+	suite.repo.On("FindByIDAndUser", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID, suite.testUserID).
+		Return(nil, nil).
+		Once()
+
+	_, err := suite.service.ReorderTask(suite.ctx, suite.testTaskID, suite.testUserID, 0)
+
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Reorder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestMarkAllSeen_Success() {
+	before := time.Now().UTC()
+
+	suite.cache.On("SetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("time.Time")).
 		Return(nil).
 		Once()
 
 	// Execute
-	resultTasks, resultTotal, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, filter, 1, 10)
+	seenAt, err := suite.service.MarkAllSeen(suite.ctx, suite.testUserID)
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), resultTasks, 2)
-	assert.Equal(suite.T(), total, resultTotal)
-	assert.Equal(suite.T(), "User Task 1", resultTasks[0].Title)
-	assert.Equal(suite.T(), "User Task 2", resultTasks[1].Title)
-	
-	// Verify cache miss metric was incremented
-	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
+	assert.False(suite.T(), seenAt.Before(before))
 }
 
-func (suite *TaskServiceTestSuite) TestListTasksByUser_CacheHit() {
-	tasks := []*model.Task{
+func (suite *TaskServiceTestSuite) TestMarkAllSeen_CacheError() {
+	suite.cache.On("SetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("time.Time")).
+		Return(assert.AnError).
+		Once()
+
+	// Execute
+	_, err := suite.service.MarkAllSeen(suite.ctx, suite.testUserID)
+
+	// Verify
+	assert.Error(suite.T(), err)
+	st, ok := status.FromError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), codes.Internal, st.Code())
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheErrors)
+}
+
+func (suite *TaskServiceTestSuite) TestCountTasks_Filters() {
+	tests := []struct {
+		name     string
+		filter   *repository.TaskFilter
+		cacheKey string
+		count    int64
+	}{
 		{
-			ID:     "task-1",
-			UserID: suite.testUserID,
-			Title:  "Cached User Task",
+			name:     "no filter",
+			filter:   &repository.TaskFilter{},
+			cacheKey: "tasks:user:test-user-123:v0:count",
+			count:    7,
+		},
+		{
+			name:     "status",
+			filter:   &repository.TaskFilter{Status: stringPtr("IN_PROGRESS")},
+			cacheKey: "tasks:user:test-user-123:v0:count:status:in_progress",
+			count:    3,
+		},
+		{
+			name:     "status and priority",
+			filter:   &repository.TaskFilter{Status: stringPtr("TODO"), Priority: stringPtr("HIGH")},
+			cacheKey: "tasks:user:test-user-123:v0:count:status:todo:priority:high",
+			count:    1,
 		},
 	}
-	
-	const total int64 = 1
 
-	// Setup expectations - cache hit
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return(tasks, total, nil). // Cache hit
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.cache.On("GetCount", mock.AnythingOfType("*context.valueCtx"), tt.cacheKey).
+				Return(nil, nil).
+				Once()
+			suite.repo.On("CountByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, tt.filter).
+				Return(tt.count, nil).
+				Once()
+			suite.cache.On("SetCount", mock.AnythingOfType("*context.valueCtx"), tt.cacheKey, tt.count).
+				Return(nil).
+				Once()
+
+			count, err := suite.service.CountTasks(suite.ctx, suite.testUserID, tt.filter)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.count, count)
+		})
+	}
+}
+
+func (suite *TaskServiceTestSuite) TestCountTasks_FromCache() {
+	cached := int64(4)
+	suite.cache.On("GetCount", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:count:priority:urgent").
+		Return(&cached, nil).
 		Once()
 
 	// Execute
-	resultTasks, resultTotal, err := suite.service.ListTasksByUser(suite.ctx, suite.testUserID, nil, 1, 10)
+	count, err := suite.service.CountTasks(suite.ctx, suite.testUserID, &repository.TaskFilter{Priority: stringPtr("URGENT")})
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), resultTasks, 1)
-	assert.Equal(suite.T(), total, resultTotal)
-	assert.Equal(suite.T(), "Cached User Task", resultTasks[0].Title)
-	
-	// Verify cache hit metric was incremented
+	assert.Equal(suite.T(), int64(4), count)
 	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
-	
-	// Repository should NOT be called for cache hit
-	suite.repo.AssertNotCalled(suite.T(), "ListByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "CountByUser", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func (suite *TaskServiceTestSuite) TestListTasks_Pagination() {
-	tasks := []*model.Task{
-		{
-			ID:     "task-1",
-			UserID: suite.testUserID,
-			Title:  "Task 1",
-		},
-	}
-	
-	const total int64 = 3
+func (suite *TaskServiceTestSuite) TestGetUnreadCount_CountsTasksUpdatedAfterLastSeen() {
+	lastSeen := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	filter := &repository.TaskFilter{UpdatedAfter: &lastSeen}
+	cacheKey := fmt.Sprintf("tasks:user:test-user-123:v0:count:updated_after:%d", lastSeen.UnixNano())
 
-	// Setup expectations - page 1
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return([]*model.Task(nil), int64(0), nil). // Cache miss
+	suite.cache.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(&lastSeen, nil).
 		Once()
-	
-	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), 1, 2).
-		Return(tasks, total, nil).
+	suite.cache.On("GetCount", mock.AnythingOfType("*context.valueCtx"), cacheKey).
+		Return(nil, nil).
 		Once()
-	
-	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), tasks, total).
+	suite.repo.On("CountByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, filter).
+		Return(int64(2), nil).
+		Once()
+	suite.cache.On("SetCount", mock.AnythingOfType("*context.valueCtx"), cacheKey, int64(2)).
 		Return(nil).
 		Once()
 
-	// Execute - page 1, size 2
-	resultTasks, resultTotal, err := suite.service.ListTasks(suite.ctx, nil, 1, 2)
+	// Execute
+	count, seenAt, err := suite.service.GetUnreadCount(suite.ctx, suite.testUserID)
 
 	// Verify
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), resultTasks, 1)
-	assert.Equal(suite.T(), total, resultTotal)
+	assert.Equal(suite.T(), int64(2), count)
+	assert.Equal(suite.T(), &lastSeen, seenAt)
 }
 
-func (suite *TaskServiceTestSuite) TestListTasks_PageValidation() {
-	// Test page < 1 should default to 1
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return([]*model.Task(nil), int64(0), nil).
+func (suite *TaskServiceTestSuite) TestGetUnreadCount_NeverSeenCountsEverything() {
+	suite.cache.On("GetLastSeen", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(nil, nil).
 		Once()
-	
-	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), 1, 10). // Should use page 1
-		Return([]*model.Task{}, int64(0), nil).
+	suite.cache.On("GetCount", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:count").
+		Return(nil, nil).
 		Once()
-	
-	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+	suite.repo.On("CountByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, &repository.TaskFilter{}).
+		Return(int64(5), nil).
+		Once()
+	suite.cache.On("SetCount", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:count", int64(5)).
 		Return(nil).
 		Once()
 
-	_, _, err := suite.service.ListTasks(suite.ctx, nil, 0, 10) // Page 0
+	// Execute
+	count, seenAt, err := suite.service.GetUnreadCount(suite.ctx, suite.testUserID)
+
+	// Verify
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), count)
+	assert.Nil(suite.T(), seenAt)
 }
 
-func (suite *TaskServiceTestSuite) TestListTasks_PageSizeValidation() {
-	// Test pageSize > 100 should default to 100
-	suite.cache.On("GetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string")).
-		Return([]*model.Task(nil), int64(0), nil).
+func (suite *TaskServiceTestSuite) TestGetTaskMatrix() {
+	// Cached under the user's namespace so writes invalidate it
+	cacheKey := "tasks:user:test-user-123:v0:matrix"
+	suite.cache.On("GetMatrix", mock.AnythingOfType("*context.valueCtx"), cacheKey).
+		Return(nil, nil).
 		Once()
-	
-	suite.repo.On("List", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*repository.TaskFilter"), 1, 100). // Should use size 100
-		Return([]*model.Task{}, int64(0), nil).
+	suite.repo.On("CountByStatusAndPriority", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(map[model.TaskStatus]map[model.TaskPriority]int64{
+			model.StatusTodo:       {model.PriorityHigh: 3, model.PriorityLow: 1},
+			model.StatusInProgress: {model.PriorityUrgent: 2},
+		}, nil).
 		Once()
-	
-	suite.cache.On("SetTasksList", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), []*model.Task{}, int64(0)).
+
+	var cached map[string]map[string]int64
+	suite.cache.On("SetMatrix", mock.AnythingOfType("*context.valueCtx"), cacheKey, mock.Anything).
+		Run(func(args mock.Arguments) { cached = args.Get(2).(map[string]map[string]int64) }).
 		Return(nil).
 		Once()
 
-	_, _, err := suite.service.ListTasks(suite.ctx, nil, 1, 150) // Size 150
+	// Execute
+	matrix, err := suite.service.GetTaskMatrix(suite.ctx, suite.testUserID)
+
+	// Verify
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]map[string]int64{
+		"TODO":        {"LOW": 1, "MEDIUM": 0, "HIGH": 3, "URGENT": 0},
+		"IN_PROGRESS": {"LOW": 0, "MEDIUM": 0, "HIGH": 0, "URGENT": 2},
+		"DONE":        {"LOW": 0, "MEDIUM": 0, "HIGH": 0, "URGENT": 0},
+		"ARCHIVED":    {"LOW": 0, "MEDIUM": 0, "HIGH": 0, "URGENT": 0},
+	}, matrix)
+	assert.Equal(suite.T(), matrix, cached)
 }
 
-func (suite *TaskServiceTestSuite) TestCacheErrorHandling() {
-	// Test that cache errors don't fail the operation
-	expectedTask := &model.Task{
-		ID:     suite.testTaskID,
-		UserID: suite.testUserID,
-		Title:  "Task",
-	}
+func (suite *TaskServiceTestSuite) TestGetTaskMatrix_FromCache() {
+	cached := map[string]map[string]int64{"TODO": {"HIGH": 5}}
+	suite.cache.On("GetMatrix", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:matrix").
+		Return(cached, nil).
+		Once()
 
-	// Setup expectations - cache error
-	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(nil, assert.AnError). // Cache error
+	// Execute
+	matrix, err := suite.service.GetTaskMatrix(suite.ctx, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cached, matrix)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
+	suite.repo.AssertNotCalled(suite.T(), "CountByStatusAndPriority", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestGetTaskStats() {
+	// Cached under the user's namespace so writes invalidate it
+	cacheKey := "tasks:user:test-user-123:v0:stats"
+	suite.cache.On("GetStats", mock.AnythingOfType("*context.valueCtx"), cacheKey).
+		Return(nil, nil).
 		Once()
-	
-	suite.repo.On("FindByID", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(expectedTask, nil).
+	suite.repo.On("CountByStatus", mock.AnythingOfType("*context.valueCtx"), suite.testUserID).
+		Return(map[model.TaskStatus]int64{model.StatusTodo: 5, model.StatusDone: 10}, nil).
 		Once()
-	
-	suite.cache.On("SetTask", mock.AnythingOfType("*context.valueCtx"), expectedTask).
-		Return(assert.AnError). // Cache error on set
+
+	expected := map[string]int64{"TODO": 5, "IN_PROGRESS": 0, "DONE": 10, "ARCHIVED": 0}
+	suite.cache.On("SetStats", mock.AnythingOfType("*context.valueCtx"), cacheKey, expected).
+		Return(nil).
 		Once()
 
 	// Execute
-	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
+	stats, err := suite.service.GetTaskStats(suite.ctx, suite.testUserID)
 
 	// Verify
-	assert.NoError(suite.T(), err) // Should not fail even with cache errors
-	assert.NotNil(suite.T(), task)
-	
-	// Verify cache error metric was incremented
-	assert.Equal(suite.T(), 2, suite.metricsCalls.cacheErrors) // One for get, one for set
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, stats)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
 }
 
-func (suite *TaskServiceTestSuite) TestDatabaseErrorHandling() {
-	// Setup expectations - database error
-	suite.cache.On("GetTask", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(nil, nil). // Cache miss
+func (suite *TaskServiceTestSuite) TestGetTaskStats_FromCache() {
+	cached := map[string]int64{"TODO": 2, "IN_PROGRESS": 1, "DONE": 0, "ARCHIVED": 0}
+	suite.cache.On("GetStats", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:stats").
+		Return(cached, nil).
 		Once()
-	
-	suite.repo.On("FindByID", mock.AnythingOfType("*context.valueCtx"), suite.testTaskID).
-		Return(nil, assert.AnError). // Database error
+
+	// Execute
+	stats, err := suite.service.GetTaskStats(suite.ctx, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cached, stats)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
+	suite.repo.AssertNotCalled(suite.T(), "CountByStatus", mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestGetUserMetrics() {
+	lastActivity := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	metrics := &model.TaskMetrics{Total: 12, Completed: 7, Overdue: 2, LastActivityAt: &lastActivity}
+
+	cacheKey := "tasks:user:test-user-123:v0:metrics"
+	suite.cache.On("GetMetrics", mock.AnythingOfType("*context.valueCtx"), cacheKey).
+		Return(nil, nil).
+		Once()
+	suite.repo.On("MetricsByUser", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, mock.AnythingOfType("time.Time")).
+		Return(metrics, nil).
+		Once()
+	suite.cache.On("SetMetrics", mock.AnythingOfType("*context.valueCtx"), cacheKey, metrics).
+		Return(nil).
 		Once()
 
 	// Execute
-	task, err := suite.service.GetTask(suite.ctx, suite.testTaskID)
+	result, err := suite.service.GetUserMetrics(suite.ctx, suite.testUserID)
 
 	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), metrics, result)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheMisses)
+}
+
+func (suite *TaskServiceTestSuite) TestGetUserMetrics_FromCache() {
+	cached := &model.TaskMetrics{Total: 3, Completed: 1}
+	suite.cache.On("GetMetrics", mock.AnythingOfType("*context.valueCtx"), "tasks:user:test-user-123:v0:metrics").
+		Return(cached, nil).
+		Once()
+
+	// Execute
+	result, err := suite.service.GetUserMetrics(suite.ctx, suite.testUserID)
+
+	// Verify
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cached, result)
+	assert.Equal(suite.T(), 1, suite.metricsCalls.cacheHits)
+	suite.repo.AssertNotCalled(suite.T(), "MetricsByUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestRefreshMetrics() {
+	suite.repo.On("CountAllByStatusAndPriority", mock.AnythingOfType("*context.valueCtx")).
+		Return(map[model.TaskStatus]map[model.TaskPriority]int64{
+			model.StatusTodo:       {model.PriorityLow: 2, model.PriorityHigh: 3},
+			model.StatusInProgress: {model.PriorityUrgent: 1},
+			model.StatusDone:       {model.PriorityLow: 4, model.PriorityMedium: 5},
+		}, nil).
+		Once()
+
+	// Execute
+	err := suite.service.RefreshMetrics(suite.ctx)
+
+	// Verify every gauge is set, zeros included
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 15, suite.metricsCalls.updateTasksCount)
+	assert.Equal(suite.T(), map[string]int{"TODO": 5, "IN_PROGRESS": 1, "DONE": 9, "ARCHIVED": 0}, suite.metricsCalls.updateTasksCountByStatus)
+	assert.Equal(suite.T(), map[string]int{"LOW": 6, "MEDIUM": 5, "HIGH": 3, "URGENT": 1}, suite.metricsCalls.updateTasksCountByPriority)
+}
+
+func (suite *TaskServiceTestSuite) TestRefreshMetrics_DatabaseError() {
+	suite.repo.On("CountAllByStatusAndPriority", mock.AnythingOfType("*context.valueCtx")).
+		Return(nil, errors.New("database error")).
+		Once()
+
+	// Execute
+	err := suite.service.RefreshMetrics(suite.ctx)
+
+	// Verify the gauges are left alone
 	assert.Error(suite.T(), err)
-	assert.Nil(suite.T(), task)
-	
-	// Verify database error metric was incremented
 	assert.Equal(suite.T(), 1, suite.metricsCalls.databaseErrors)
+	assert.Empty(suite.T(), suite.metricsCalls.updateTasksCountByStatus)
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksNeedingAttention_OrderFollowsWeights() {
+	now := time.Now()
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	recent := now.Add(-time.Hour)
+	stale := now.Add(-5 * 24 * time.Hour)
+	candidates := []*model.Task{
+		{ID: "overdue", Priority: model.PriorityLow, DueDate: at(-24 * time.Hour), UpdatedAt: recent},
+		{ID: "due-soon", Priority: model.PriorityLow, DueDate: at(24 * time.Hour), UpdatedAt: recent},
+		{ID: "neglected", Priority: model.PriorityHigh, UpdatedAt: stale},
+		{ID: "due-soon-neglected", Priority: model.PriorityUrgent, DueDate: at(24 * time.Hour), UpdatedAt: stale},
+		{ID: "later", Priority: model.PriorityLow, DueDate: at(10 * 24 * time.Hour), UpdatedAt: recent},
+	}
+	suite.repo.On("ListAttentionCandidates", mock.Anything, suite.testUserID, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+		Return(candidates, nil)
+
+	ids := func(weights func(*service.AttentionConfig)) []string {
+		config := service.DefaultConfig()
+		weights(&config.Attention)
+		ranked, err := service.NewTaskServiceWithConfig(suite.repo, suite.cache, service.NewMetricsCollector(
+			func(int) {}, func(string, int) {}, func(string, int) {},
+			func() {}, func() {}, func() {}, func() {}, func() {},
+		), events.NewNoopPublisher(), config).ListTasksNeedingAttention(suite.ctx, suite.testUserID, 10)
+		assert.NoError(suite.T(), err)
+
+		var ids []string
+		for _, item := range ranked {
+			ids = append(ids, item.Task.ID)
+		}
+		return ids
+	}
+
+	// Deadlines first: the overdue task wins the tie on its earlier due date
+	assert.Equal(suite.T(), []string{"overdue", "due-soon-neglected", "due-soon", "neglected"}, ids(func(c *service.AttentionConfig) {
+		c.OverdueWeight, c.DueSoonWeight, c.HighPriorityUntouchedWeight = 3, 2, 1
+	}))
+	// Neglect first
+	assert.Equal(suite.T(), []string{"due-soon-neglected", "neglected", "overdue", "due-soon"}, ids(func(c *service.AttentionConfig) {
+		c.OverdueWeight, c.DueSoonWeight, c.HighPriorityUntouchedWeight = 1, 1, 5
+	}))
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksNeedingAttention_Reasons() {
+	overdue := time.Now().Add(-time.Hour)
+	suite.repo.On("ListAttentionCandidates", mock.Anything, suite.testUserID, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+		Return([]*model.Task{{
+			ID:        "task-1",
+			Priority:  model.PriorityUrgent,
+			DueDate:   &overdue,
+			UpdatedAt: time.Now().Add(-30 * 24 * time.Hour),
+		}}, nil).
+		Once()
+
+	ranked, err := suite.service.ListTasksNeedingAttention(suite.ctx, suite.testUserID, 0)
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), ranked, 1) {
+		assert.Equal(suite.T(), 4.0, ranked[0].Score)
+		assert.Equal(suite.T(), []string{service.ReasonOverdue, service.ReasonHighPriorityUntouched}, ranked[0].Reasons)
+	}
+}
+
+func (suite *TaskServiceTestSuite) TestListTasksNeedingAttention_RejectsOversizedLimit() {
+	_, err := suite.service.ListTasksNeedingAttention(suite.ctx, suite.testUserID, 51)
+
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "ListAttentionCandidates", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskServiceTestSuite) TestGetCompletionTrends_FillsEmptyDays() {
+	from := time.Date(2025, 3, 1, 15, 30, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	// The range starts at the beginning of from's day
+	suite.repo.On("CountCompletedByPeriod", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, "day", day(1), to).
+		Return(map[time.Time]int64{day(1): 2, day(3): 5}, nil).
+		Once()
+
+	points, err := suite.service.GetCompletionTrends(suite.ctx, suite.testUserID, "day", from, to)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []service.TrendPoint{
+		{Start: day(1), Completed: 2},
+		{Start: day(2), Completed: 0},
+		{Start: day(3), Completed: 5},
+		{Start: day(4), Completed: 0},
+	}, points)
+}
+
+func (suite *TaskServiceTestSuite) TestGetCompletionTrends_WeeksStartOnMonday() {
+	// Wednesday 5 March to Monday 17 March 2025
+	from := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
+	monday := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	suite.repo.On("CountCompletedByPeriod", mock.AnythingOfType("*context.valueCtx"), suite.testUserID, "week", monday(3), to).
+		Return(map[time.Time]int64{monday(10): 4}, nil).
+		Once()
+
+	points, err := suite.service.GetCompletionTrends(suite.ctx, suite.testUserID, "WEEK", from, to)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []service.TrendPoint{
+		{Start: monday(3), Completed: 0},
+		{Start: monday(10), Completed: 4},
+	}, points)
+}
+
+func (suite *TaskServiceTestSuite) TestGetCompletionTrends_RejectsInvalidRange() {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, call := range map[string]func() error{
+		"granularity": func() error {
+			_, err := suite.service.GetCompletionTrends(suite.ctx, suite.testUserID, "hour", from, from.AddDate(0, 0, 1))
+			return err
+		},
+		"reversed": func() error {
+			_, err := suite.service.GetCompletionTrends(suite.ctx, suite.testUserID, "day", from, from)
+			return err
+		},
+		"too many points": func() error {
+			_, err := suite.service.GetCompletionTrends(suite.ctx, suite.testUserID, "day", from, from.AddDate(2, 0, 0))
+			return err
+		},
+	} {
+		assert.Equal(suite.T(), codes.InvalidArgument, status.Code(call()), name)
+	}
+	suite.repo.AssertNotCalled(suite.T(), "CountCompletedByPeriod", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 // Helper function
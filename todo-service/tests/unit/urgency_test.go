@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+// urgencySeed returns tasks whose default urgency scores, as of now, are
+// easy to work out by hand.
+func urgencySeed(now time.Time) []*model.Task {
+	overdue := now.Add(-time.Hour)
+	halfwayDue := now.Add(84 * time.Hour)
+	return []*model.Task{
+		// 1 (priority)
+		{ID: "urgent", Status: model.StatusTodo, Priority: model.PriorityUrgent, UpdatedAt: now},
+		// 2 (due)
+		{ID: "overdue", Status: model.StatusTodo, Priority: model.PriorityLow, DueDate: &overdue, UpdatedAt: now},
+		// 1/3 (priority) + 2 * 1/2 (due)
+		{ID: "due-midweek", Status: model.StatusInProgress, Priority: model.PriorityMedium, DueDate: &halfwayDue, UpdatedAt: now},
+		// 2/3 (priority) + 0.5 (stale)
+		{ID: "stale-high", Status: model.StatusTodo, Priority: model.PriorityHigh, UpdatedAt: now.Add(-30 * 24 * time.Hour)},
+		// Finished tasks are never urgent
+		{ID: "done", Status: model.StatusDone, Priority: model.PriorityUrgent, DueDate: &overdue, UpdatedAt: now},
+	}
+}
+
+func TestUrgencyWeights_Score(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	weights := repository.DefaultUrgencyWeights()
+
+	want := map[string]float64{
+		"urgent":      1,
+		"overdue":     2,
+		"due-midweek": 1.0/3 + 1,
+		"stale-high":  2.0/3 + 0.5,
+		"done":        0,
+	}
+	for _, task := range urgencySeed(now) {
+		assert.InDelta(t, want[task.ID], weights.Score(task, now), 1e-9, task.ID)
+	}
+}
+
+func TestUrgencyWeights_OrderFollowsWeights(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	order := func(weights repository.UrgencyWeights) []string {
+		tasks := urgencySeed(now)
+		sort.SliceStable(tasks, func(i, j int) bool {
+			return weights.Score(tasks[i], now) > weights.Score(tasks[j], now)
+		})
+		ids := make([]string, len(tasks))
+		for i, task := range tasks {
+			ids[i] = task.ID
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"overdue", "due-midweek", "stale-high", "urgent", "done"}, order(repository.DefaultUrgencyWeights()))
+
+	// Weighting priority alone ranks by priority
+	priorityOnly := repository.DefaultUrgencyWeights()
+	priorityOnly.DueDate, priorityOnly.Staleness = 0, 0
+	assert.Equal(t, []string{"urgent", "stale-high", "due-midweek", "overdue", "done"}, order(priorityOnly))
+}
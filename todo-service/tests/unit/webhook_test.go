@@ -0,0 +1,244 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/todo-service/internal/events"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/model"
+	"github.com/amirhasanpour/task-manager/todo-service/internal/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWebhookRepository struct {
+	active      []*model.Webhook
+	deadLetters []*model.WebhookDeadLetter
+}
+
+func (r *fakeWebhookRepository) Create(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+	return w, nil
+}
+
+func (r *fakeWebhookRepository) FindByIDAndUser(ctx context.Context, id, userID string) (*model.Webhook, error) {
+	return nil, nil
+}
+
+func (r *fakeWebhookRepository) Update(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+	return w, nil
+}
+
+func (r *fakeWebhookRepository) DeleteByUser(ctx context.Context, id, userID string) error {
+	return nil
+}
+
+func (r *fakeWebhookRepository) ListByUser(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	return nil, nil
+}
+
+func (r *fakeWebhookRepository) ListActiveByUser(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	return r.active, nil
+}
+
+func (r *fakeWebhookRepository) CreateDeadLetter(ctx context.Context, deadLetter *model.WebhookDeadLetter) error {
+	r.deadLetters = append(r.deadLetters, deadLetter)
+	return nil
+}
+
+func TestWebhookSign(t *testing.T) {
+	// RFC-style HMAC-SHA256 test vector
+	signature := webhook.Sign("key", []byte("The quick brown fox jumps over the lazy dog"))
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signature)
+}
+
+func TestWebhookDeliver_RetriesUntilSuccess(t *testing.T) {
+	payload := []byte(`{"type":"task.created"}`)
+	secret := "test-secret"
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, payload, body)
+		assert.Equal(t, webhook.Sign(secret, body), r.Header.Get(webhook.SignatureHeader))
+		assert.Equal(t, string(events.TaskCreated), r.Header.Get(webhook.EventHeader))
+
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepository{}
+	dispatcher := webhook.NewDispatcher(repo, webhook.Config{
+		MaxRetries:            3,
+		InitialBackoff:        time.Millisecond,
+		Timeout:               time.Second,
+		AllowPrivateAddresses: true, // httptest listens on loopback
+	})
+
+	hook := &model.Webhook{ID: "webhook-1", URL: server.URL, Secret: secret}
+	err := dispatcher.Deliver(context.Background(), hook, events.TaskCreated, payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	assert.Empty(t, repo.deadLetters)
+}
+
+func TestWebhookDeliver_DeadLetterAfterMaxRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepository{}
+	dispatcher := webhook.NewDispatcher(repo, webhook.Config{
+		MaxRetries:            2,
+		InitialBackoff:        time.Millisecond,
+		Timeout:               time.Second,
+		AllowPrivateAddresses: true, // httptest listens on loopback
+	})
+
+	hook := &model.Webhook{ID: "webhook-1", URL: server.URL, Secret: "test-secret"}
+	err := dispatcher.Deliver(context.Background(), hook, events.TaskDeleted, []byte(`{}`))
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	if assert.Len(t, repo.deadLetters, 1) {
+		deadLetter := repo.deadLetters[0]
+		assert.Equal(t, "webhook-1", deadLetter.WebhookID)
+		assert.Equal(t, string(events.TaskDeleted), deadLetter.EventType)
+		assert.Equal(t, 3, deadLetter.Attempts)
+		assert.Contains(t, deadLetter.LastError, "500")
+	}
+}
+
+func TestWebhookPublish_PostsSignedEvent(t *testing.T) {
+	secret := "test-secret"
+
+	type delivery struct {
+		body   []byte
+		header http.Header
+	}
+	received := make(chan delivery, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- delivery{body: body, header: r.Header.Clone()}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepository{active: []*model.Webhook{
+		{ID: "webhook-1", UserID: "user-1", URL: server.URL, Secret: secret, Active: true},
+	}}
+	dispatcher := webhook.NewDispatcher(repo, webhook.Config{
+		MaxRetries:            1,
+		InitialBackoff:        time.Millisecond,
+		Timeout:               time.Second,
+		AllowPrivateAddresses: true, // httptest listens on loopback
+	})
+
+	task := &model.Task{ID: "task-1", UserID: "user-1", Title: "Write report", Status: model.StatusInProgress}
+	assert.NoError(t, dispatcher.Publish(context.Background(), events.NewTaskEvent(events.TaskCreated, task, nil)))
+
+	select {
+	case got := <-received:
+		assert.Equal(t, "application/json", got.header.Get("Content-Type"))
+		assert.Equal(t, string(events.TaskCreated), got.header.Get(webhook.EventHeader))
+		assert.Equal(t, webhook.Sign(secret, got.body), got.header.Get(webhook.SignatureHeader))
+
+		var event events.Event
+		assert.NoError(t, json.Unmarshal(got.body, &event))
+		assert.Equal(t, events.TaskCreated, event.Type)
+		assert.Equal(t, "task-1", event.TaskID)
+		assert.Equal(t, "user-1", event.UserID)
+		assert.Equal(t, model.StatusInProgress, event.Status)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+}
+
+func TestWebhookDeliver_TimesOutEachAttempt(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first attempt outlives the client timeout
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepository{}
+	dispatcher := webhook.NewDispatcher(repo, webhook.Config{
+		MaxRetries:            1,
+		InitialBackoff:        time.Millisecond,
+		Timeout:               50 * time.Millisecond,
+		AllowPrivateAddresses: true, // httptest listens on loopback
+	})
+
+	hook := &model.Webhook{ID: "webhook-1", URL: server.URL, Secret: "test-secret"}
+	err := dispatcher.Deliver(context.Background(), hook, events.TaskUpdated, []byte(`{}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	assert.Empty(t, repo.deadLetters)
+}
+
+func TestWebhookDeliver_RefusesPrivateAddresses(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepository{}
+	dispatcher := webhook.NewDispatcher(repo, webhook.Config{
+		InitialBackoff: time.Millisecond,
+		Timeout:        time.Second,
+	})
+
+	// The check runs on the resolved address, so a hostname is no way around it
+	port := server.URL[strings.LastIndex(server.URL, ":"):]
+	for _, url := range []string{server.URL, "http://localhost" + port} {
+		hook := &model.Webhook{ID: "webhook-1", URL: url, Secret: "test-secret"}
+		err := dispatcher.Deliver(context.Background(), hook, events.TaskCreated, []byte(`{}`))
+		assert.ErrorIs(t, err, webhook.ErrBlockedAddress, url)
+	}
+	assert.Zero(t, atomic.LoadInt32(&hits))
+	assert.Len(t, repo.deadLetters, 2)
+}
+
+func TestWebhookDeliver_DoesNotFollowRedirects(t *testing.T) {
+	var targetHits int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&targetHits, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+	redirector := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer redirector.Close()
+
+	repo := &fakeWebhookRepository{}
+	dispatcher := webhook.NewDispatcher(repo, webhook.Config{
+		InitialBackoff:        time.Millisecond,
+		Timeout:               time.Second,
+		AllowPrivateAddresses: true,
+	})
+
+	hook := &model.Webhook{ID: "webhook-1", URL: redirector.URL, Secret: "test-secret"}
+	err := dispatcher.Deliver(context.Background(), hook, events.TaskCreated, []byte(`{}`))
+
+	assert.Error(t, err)
+	assert.Zero(t, atomic.LoadInt32(&targetHits))
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/amirhasanpour/task-manager/user-service/config"
 	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/cache"
 	"github.com/amirhasanpour/task-manager/user-service/internal/handler"
 	"github.com/amirhasanpour/task-manager/user-service/internal/interceptor"
 	"github.com/amirhasanpour/task-manager/user-service/internal/model"
@@ -20,6 +21,7 @@ import (
 	"github.com/amirhasanpour/task-manager/user-service/pkg/db"
 	"github.com/amirhasanpour/task-manager/user-service/pkg/logger"
 	"github.com/amirhasanpour/task-manager/user-service/pkg/metrics"
+	"github.com/amirhasanpour/task-manager/user-service/pkg/redis"
 	pb "github.com/amirhasanpour/task-manager/user-service/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -55,6 +57,7 @@ func main() {
 		zap.String("version", "1.0.0"),
 		zap.String("environment", os.Getenv("APP_ENV")),
 	)
+	log.Debug("Configuration loaded", zap.Any("config", cfg.Redacted()))
 
 	// Initialize tracing
 	ctx := context.Background()
@@ -89,14 +92,18 @@ func main() {
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
 	}
 
-	database, err := db.NewPostgresConnection(dbConfig)
+	database, err := db.NewPostgresConnectionWithRetry(ctx, dbConfig, db.RetryConfig{
+		Attempts: cfg.Database.ConnectAttempts,
+		Interval: cfg.Database.ConnectInterval,
+		MaxWait:  cfg.Database.ConnectMaxWait,
+	})
 	if err != nil {
 		log.Error("Failed to connect to database", zap.Error(err))
 		os.Exit(1)
 	}
 
 	// Run database migrations
-	if err := db.Migrate(database, &model.User{}); err != nil {
+	if err := db.Migrate(database, &model.User{}, &model.FeedToken{}); err != nil {
 		log.Error("Failed to migrate database", zap.Error(err))
 		os.Exit(1)
 	}
@@ -112,38 +119,120 @@ func main() {
 
 	// Initialize JWT manager
 	jwtManager := auth.NewJWTManager(cfg.JWT.Secret, expirationHours)
+	if cfg.JWT.RefreshTokenTTL > 0 {
+		jwtManager.SetRefreshDuration(cfg.JWT.RefreshTokenTTL)
+	}
 
 	// Initialize repository
 	userRepo := repository.NewUserRepository(database)
+	feedTokenRepo := repository.NewFeedTokenRepository(database)
+
+	// Initialize user cache, token denylist and login throttle
+	userCache := cache.NewNoopUserCache()
+	tokenDenylist := cache.NewNoopTokenDenylist()
+	loginThrottle := cache.NewNoopLoginThrottle()
+	var redisCheck handler.HealthCheck
+	if cfg.Redis.Enabled {
+		redisClient, err := redis.NewRedisClient(redis.Config{
+			Host:         cfg.Redis.Host,
+			Port:         cfg.Redis.Port,
+			Password:     cfg.Redis.Password,
+			DB:           cfg.Redis.DB,
+			PoolSize:     cfg.Redis.PoolSize,
+			MinIdleConns: cfg.Redis.MinIdleConns,
+			DialTimeout:  cfg.Redis.DialTimeout,
+			ReadTimeout:  cfg.Redis.ReadTimeout,
+			WriteTimeout: cfg.Redis.WriteTimeout,
+			CacheTTL:     cfg.Redis.CacheTTL,
+		})
+		if err != nil {
+			log.Error("Failed to connect to Redis", zap.Error(err))
+			os.Exit(1)
+		}
+		defer redisClient.Close()
+
+		userCache = cache.NewUserCache(redisClient)
+		tokenDenylist = cache.NewTokenDenylist(redisClient)
+		if cfg.Login.MaxAttempts > 0 && cfg.Login.Window > 0 && cfg.Login.Cooldown > 0 {
+			loginThrottle = cache.NewLoginThrottle(redisClient, cache.LoginThrottleConfig{
+				MaxAttempts: cfg.Login.MaxAttempts,
+				Window:      cfg.Login.Window,
+				Cooldown:    cfg.Login.Cooldown,
+			})
+		}
+		redisCheck = redisClient.Ping
+	}
 
 	// Initialize service
-	userService := service.NewUserService(userRepo, jwtManager)
+	userService := service.NewUserServiceWithConfig(userRepo, userCache, jwtManager, service.Config{
+		MaxBatchTokens:         cfg.JWT.MaxBatchTokens,
+		PasswordResetTTL:       cfg.JWT.PasswordResetTTL,
+		MaxBatchProfiles:       cfg.Profiles.MaxBatch,
+		IdempotentRegister:     cfg.Registration.Idempotent,
+		RequireStrongPasswords: cfg.Password.RequireStrong,
+		Denylist:               tokenDenylist,
+		LoginThrottle:          loginThrottle,
+	})
+
+	feedTokenService := service.NewFeedTokenService(feedTokenRepo, userRepo)
 
 	// Initialize handler
 	userHandler := handler.NewUserHandler(userService)
+	feedTokenHandler := handler.NewFeedTokenHandler(feedTokenService)
+	userHandler.SetHealthChecks(map[string]handler.HealthCheck{
+		"database": func(ctx context.Context) error { return db.Ping(ctx, database) },
+		"redis":    redisCheck,
+	})
 
 	// Initialize interceptors
 	metricsInterceptor := interceptor.NewMetricsInterceptor(metricsCollector)
 	loggingInterceptor := interceptor.NewLoggingInterceptor()
+	requestIDInterceptor := interceptor.NewRequestIDInterceptor()
 	recoveryInterceptor := interceptor.NewRecoveryInterceptor()
 
+	var authUnary grpc.UnaryServerInterceptor
+	if cfg.Auth.Enabled {
+		if cfg.Auth.GatewayToken == "" {
+			log.Error("Auth interceptor enabled without a gateway token")
+			os.Exit(1)
+		}
+		authUnary = interceptor.NewAuthInterceptor(cfg.Auth.GatewayToken, cfg.Auth.ExemptMethods).Unary()
+	}
+
+	unaryInterceptors, err := interceptor.BuildChain(cfg.Interceptors.Order, map[string]grpc.UnaryServerInterceptor{
+		"recovery":   recoveryInterceptor.Unary(),
+		"request_id": requestIDInterceptor.Unary(),
+		"logging":    loggingInterceptor.Unary(),
+		"metrics":    metricsInterceptor.Unary(),
+		"auth":       authUnary,
+	})
+	if err != nil {
+		log.Error("Invalid interceptor configuration", zap.Error(err))
+		os.Exit(1)
+	}
+
 	// Create gRPC server with interceptors
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			recoveryInterceptor.Unary(),
-			loggingInterceptor.Unary(),
-			metricsInterceptor.Unary(),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	)
 
 	// Register services
 	pb.RegisterUserServiceServer(grpcServer, userHandler)
+	pb.RegisterFeedTokenServiceServer(grpcServer, feedTokenHandler)
 	
 	// Register health service
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	healthServer.SetServingStatus("user-service", grpc_health_v1.HealthCheckResponse_SERVING)
 
+	// Report NOT_SERVING while a dependency is down
+	healthCtx, stopHealthChecks := context.WithCancel(ctx)
+	healthChecksDone := make(chan struct{})
+	go func() {
+		defer close(healthChecksDone)
+		userHandler.WatchServingStatus(healthCtx, healthServer, "user-service", cfg.Health.CheckInterval)
+	}()
+
 	// Register reflection service (for debugging)
 	reflection.Register(grpcServer)
 
@@ -172,6 +261,10 @@ func main() {
 
 	log.Info("Shutting down server...")
 
+	// Stop the dependency checks so they cannot flip the status back
+	stopHealthChecks()
+	<-healthChecksDone
+
 	// Set health status to NOT_SERVING
 	healthServer.SetServingStatus("user-service", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 
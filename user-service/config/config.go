@@ -2,18 +2,27 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Logging  LoggingConfig
-	Metrics  MetricsConfig
-	OTel     OTelConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	Redis        RedisConfig
+	JWT          JWTConfig
+	Registration RegistrationConfig
+	Password     PasswordConfig
+	Profiles     ProfilesConfig
+	Login        LoginConfig
+	Logging      LoggingConfig
+	Metrics      MetricsConfig
+	OTel         OTelConfig
+	Auth         AuthConfig
+	Interceptors InterceptorsConfig
+	Health       HealthConfig
 }
 
 type ServerConfig struct {
@@ -25,17 +34,80 @@ type DatabaseConfig struct {
 	Host            string
 	Port            int
 	User            string
-	Password        string
+	Password        string `sensitive:"true"`
 	Name            string
 	SSLMode         string
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+
+	// ConnectAttempts, ConnectInterval and ConnectMaxWait make startup wait
+	// for the database instead of exiting on the first failed connection.
+	ConnectAttempts int           `mapstructure:"connect_attempts"`
+	ConnectInterval time.Duration `mapstructure:"connect_interval"`
+	ConnectMaxWait  time.Duration `mapstructure:"connect_max_wait"`
+}
+
+// RedisConfig backs the optional user cache used by token validation and
+// GetUser, and the token denylist that Logout needs.
+type RedisConfig struct {
+	Enabled      bool
+	Host         string
+	Port         int
+	Password     string        `sensitive:"true"`
+	DB           int
+	PoolSize     int           `mapstructure:"pool_size"`
+	MinIdleConns int           `mapstructure:"min_idle_conns"`
+	DialTimeout  time.Duration `mapstructure:"dial_timeout"`
+	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	CacheTTL     time.Duration `mapstructure:"cache_ttl"`
 }
 
 type JWTConfig struct {
-	Secret          string
+	Secret          string `sensitive:"true"`
 	ExpirationHours int
+	MaxBatchTokens  int `mapstructure:"max_batch_tokens"`
+	// PasswordResetTTL is how long a password reset token stays valid.
+	PasswordResetTTL time.Duration `mapstructure:"password_reset_ttl"`
+	// RefreshTokenTTL is how long a refresh token stays valid. Access
+	// tokens keep using ExpirationHours.
+	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
+	// MinSecretLength is the shortest secret, in bytes, Validate accepts.
+	MinSecretLength int `mapstructure:"min_secret_length"`
+	// MinSecretDistinct is how many distinct characters the secret must use.
+	MinSecretDistinct int `mapstructure:"min_secret_distinct"`
+}
+
+// RegistrationConfig controls how Register treats retried sign-ups.
+type RegistrationConfig struct {
+	// Idempotent makes a repeated Register with the credentials of an
+	// existing account return that account instead of AlreadyExists.
+	Idempotent bool
+}
+
+// PasswordConfig is the policy every new password has to meet, whether set
+// at registration, by an update or by a reset.
+type PasswordConfig struct {
+	// RequireStrong requires a mix of upper and lower case letters and
+	// digits, and rejects common passwords.
+	RequireStrong bool `mapstructure:"require_strong"`
+}
+
+// ProfilesConfig limits the public profile lookups used for mentions.
+type ProfilesConfig struct {
+	// MaxBatch is how many users one BatchGetPublicProfiles call may ask for.
+	MaxBatch int `mapstructure:"max_batch"`
+}
+
+// LoginConfig controls the lockout after repeated failed logins. It needs
+// Redis; without it no one is locked out.
+type LoginConfig struct {
+	// MaxAttempts failed logins for one email within Window lock that
+	// email out for Cooldown. Zero in any of them disables the lockout.
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	Window      time.Duration
+	Cooldown    time.Duration
 }
 
 type LoggingConfig struct {
@@ -49,11 +121,32 @@ type MetricsConfig struct {
 	Port int
 }
 
+// HealthConfig controls the background dependency checks behind the gRPC
+// health status.
+type HealthConfig struct {
+	// CheckInterval is how often the database and Redis are pinged. While
+	// either fails the service reports NOT_SERVING. Zero disables the
+	// checks and the service always reports SERVING.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+}
+
 type OTelConfig struct {
 	Endpoint    string
 	ServiceName string
 }
 
+// AuthConfig controls the gateway-token check on incoming gRPC calls.
+type AuthConfig struct {
+	Enabled       bool
+	GatewayToken  string   `mapstructure:"gateway_token" sensitive:"true"`
+	ExemptMethods []string `mapstructure:"exempt_methods"`
+}
+
+// InterceptorsConfig lists the unary interceptors by name, outermost first.
+type InterceptorsConfig struct {
+	Order []string
+}
+
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -79,6 +172,9 @@ func LoadConfig() (*Config, error) {
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("error unmarshaling config: %w", err)
 	}
+	if err := config.Validate(os.Getenv("APP_ENV")); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
@@ -96,9 +192,39 @@ func setDefaults() {
 	viper.SetDefault("database.max_open_conns", 25)
 	viper.SetDefault("database.max_idle_conns", 5)
 	viper.SetDefault("database.conn_max_lifetime", "5m")
-
-	viper.SetDefault("jwt.secret", "your-super-secret-jwt-key-change-in-production")
+	viper.SetDefault("database.connect_attempts", 10)
+	viper.SetDefault("database.connect_interval", "1s")
+	viper.SetDefault("database.connect_max_wait", "1m")
+
+	viper.SetDefault("redis.enabled", false)
+	viper.SetDefault("redis.host", "localhost")
+	viper.SetDefault("redis.port", 6379)
+	viper.SetDefault("redis.password", "")
+	viper.SetDefault("redis.db", 0)
+	viper.SetDefault("redis.pool_size", 10)
+	viper.SetDefault("redis.min_idle_conns", 2)
+	viper.SetDefault("redis.dial_timeout", "5s")
+	viper.SetDefault("redis.read_timeout", "3s")
+	viper.SetDefault("redis.write_timeout", "3s")
+	viper.SetDefault("redis.cache_ttl", "1m")
+
+	viper.SetDefault("jwt.secret", DefaultJWTSecret)
 	viper.SetDefault("jwt.expiration_hours", 24)
+	viper.SetDefault("jwt.max_batch_tokens", 100)
+	viper.SetDefault("jwt.password_reset_ttl", "15m")
+	viper.SetDefault("jwt.refresh_token_ttl", "168h")
+	viper.SetDefault("jwt.min_secret_length", 32)
+	viper.SetDefault("jwt.min_secret_distinct", 10)
+
+	viper.SetDefault("registration.idempotent", false)
+
+	viper.SetDefault("password.require_strong", true)
+
+	viper.SetDefault("profiles.max_batch", 100)
+
+	viper.SetDefault("login.max_attempts", 5)
+	viper.SetDefault("login.window", "15m")
+	viper.SetDefault("login.cooldown", "15m")
 
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.encoding", "json")
@@ -109,4 +235,12 @@ func setDefaults() {
 
 	viper.SetDefault("otel.endpoint", "http://localhost:4317")
 	viper.SetDefault("otel.service_name", "user-service")
+
+	viper.SetDefault("auth.enabled", false)
+	viper.SetDefault("auth.gateway_token", "")
+	viper.SetDefault("auth.exempt_methods", []string{"/grpc.health.v1.Health/Check"})
+
+	viper.SetDefault("interceptors.order", []string{"recovery", "request_id", "logging", "metrics", "auth"})
+
+	viper.SetDefault("health.check_interval", "10s")
 }
\ No newline at end of file
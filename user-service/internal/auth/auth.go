@@ -5,39 +5,106 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/amirhasanpour/task-manager/user-service/internal/model"
 	"go.uber.org/zap"
 )
 
+// Reasons reported when a token fails validation.
+const (
+	ReasonExpired   = "expired"
+	ReasonMalformed = "malformed"
+	ReasonInvalid   = "invalid"
+)
+
+// Token types carried in Claims.TokenType. Access tokens leave it empty.
+const (
+	TokenTypeAccess        = ""
+	TokenTypePasswordReset = "password_reset"
+	TokenTypeRefresh       = "refresh"
+)
+
+// DefaultRefreshDuration is how long refresh tokens stay valid unless
+// SetRefreshDuration says otherwise.
+const DefaultRefreshDuration = 7 * 24 * time.Hour
+
 type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Email    string `json:"email"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Role      string `json:"role,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// TokenPair is what a user receives on login: a short-lived access token
+// and a refresh token to obtain new ones.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+var errWrongTokenType = errors.New("wrong token type")
+
 type JWTManager struct {
-	secretKey     string
-	tokenDuration time.Duration
-	logger        *zap.Logger
+	secretKey       string
+	tokenDuration   time.Duration
+	refreshDuration time.Duration
+	logger          *zap.Logger
 }
 
 func NewJWTManager(secretKey string, tokenDurationHours int) *JWTManager {
 	return &JWTManager{
-		secretKey:     secretKey,
-		tokenDuration: time.Duration(tokenDurationHours) * time.Hour,
-		logger:        zap.L().Named("jwt_manager"),
+		secretKey:       secretKey,
+		tokenDuration:   time.Duration(tokenDurationHours) * time.Hour,
+		refreshDuration: DefaultRefreshDuration,
+		logger:          zap.L().Named("jwt_manager"),
 	}
 }
 
+// SetRefreshDuration changes how long newly issued refresh tokens stay
+// valid.
+func (manager *JWTManager) SetRefreshDuration(d time.Duration) {
+	manager.refreshDuration = d
+}
+
 func (manager *JWTManager) Generate(user *model.User) (string, error) {
+	return manager.sign(user, TokenTypeAccess, manager.tokenDuration)
+}
+
+// GenerateTokenPair issues an access token together with a refresh token.
+func (manager *JWTManager) GenerateTokenPair(user *model.User) (*TokenPair, error) {
+	accessToken, err := manager.Generate(user)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := manager.sign(user, TokenTypeRefresh, manager.refreshDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// GeneratePasswordReset issues a token that can only be used to reset the
+// user's password, valid for ttl.
+func (manager *JWTManager) GeneratePasswordReset(user *model.User, ttl time.Duration) (string, error) {
+	return manager.sign(user, TokenTypePasswordReset, ttl)
+}
+
+func (manager *JWTManager) sign(user *model.User, tokenType string, ttl time.Duration) (string, error) {
 	claims := Claims{
-		UserID:   user.ID,
-		Username: user.Username,
-		Email:    user.Email,
+		UserID:    user.ID,
+		Username:  user.Username,
+		Email:     user.Email,
+		Role:      user.Role,
+		TokenType: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(manager.tokenDuration)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			// A unique ID (jti) lets a single token be revoked
+			ID: uuid.NewString(),
 		},
 	}
 
@@ -52,7 +119,22 @@ func (manager *JWTManager) Generate(user *model.User) (string, error) {
 	return tokenString, nil
 }
 
+// Verify checks an access token. Tokens of any other type are rejected.
 func (manager *JWTManager) Verify(accessToken string) (*Claims, error) {
+	return manager.verify(accessToken, TokenTypeAccess)
+}
+
+// VerifyRefresh checks a refresh token issued by GenerateTokenPair.
+func (manager *JWTManager) VerifyRefresh(refreshToken string) (*Claims, error) {
+	return manager.verify(refreshToken, TokenTypeRefresh)
+}
+
+// VerifyPasswordReset checks a token issued by GeneratePasswordReset.
+func (manager *JWTManager) VerifyPasswordReset(resetToken string) (*Claims, error) {
+	return manager.verify(resetToken, TokenTypePasswordReset)
+}
+
+func (manager *JWTManager) verify(accessToken, tokenType string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(
 		accessToken,
 		&Claims{},
@@ -81,6 +163,11 @@ func (manager *JWTManager) Verify(accessToken string) (*Claims, error) {
 		return nil, errors.New("invalid token")
 	}
 
+	if claims.TokenType != tokenType {
+		manager.logger.Warn("Token used for the wrong purpose", zap.String("token_type", claims.TokenType))
+		return nil, errWrongTokenType
+	}
+
 	manager.logger.Debug("Token verified successfully", zap.String("user_id", claims.UserID))
 	return claims, nil
 }
@@ -92,4 +179,16 @@ func (manager *JWTManager) Validate(accessToken string) (bool, *Claims) {
 		return false, nil
 	}
 	return true, claims
+}
+
+// FailureReason classifies an error returned by Verify.
+func FailureReason(err error) string {
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return ReasonExpired
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return ReasonMalformed
+	default:
+		return ReasonInvalid
+	}
 }
\ No newline at end of file
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/user-service/pkg/redis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RevokedTokenKeyPrefix prefixes the Redis key of every revoked token ID.
+// The api-gateway reads the same keys, so the two must agree.
+const RevokedTokenKeyPrefix = "revoked_token:"
+
+// TokenDenylist records revoked token IDs (jti) until the tokens would have
+// expired anyway.
+type TokenDenylist interface {
+	Revoke(ctx context.Context, jti string, ttl time.Duration) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	// Enabled reports whether revocations are actually stored.
+	Enabled() bool
+}
+
+type tokenDenylist struct {
+	redisClient *redis.RedisClient
+	tracer      trace.Tracer
+}
+
+func NewTokenDenylist(redisClient *redis.RedisClient) TokenDenylist {
+	return &tokenDenylist{
+		redisClient: redisClient,
+		tracer:      otel.Tracer("token-denylist"),
+	}
+}
+
+func (d *tokenDenylist) Revoke(ctx context.Context, jti string, ttl time.Duration) error {
+	ctx, span := d.tracer.Start(ctx, "TokenDenylist.Revoke")
+	defer span.End()
+
+	if err := d.redisClient.SetWithTTL(ctx, RevokedTokenKeyPrefix+jti, 1, ttl); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *tokenDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	ctx, span := d.tracer.Start(ctx, "TokenDenylist.IsRevoked")
+	defer span.End()
+
+	revoked, err := d.redisClient.Exists(ctx, RevokedTokenKeyPrefix+jti)
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (d *tokenDenylist) Enabled() bool {
+	return true
+}
+
+type noopTokenDenylist struct{}
+
+// NewNoopTokenDenylist returns a denylist that stores nothing, used when
+// Redis is disabled.
+func NewNoopTokenDenylist() TokenDenylist {
+	return noopTokenDenylist{}
+}
+
+func (noopTokenDenylist) Revoke(ctx context.Context, jti string, ttl time.Duration) error {
+	return nil
+}
+
+func (noopTokenDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return false, nil
+}
+
+func (noopTokenDenylist) Enabled() bool {
+	return false
+}
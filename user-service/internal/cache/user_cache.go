@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/pkg/redis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// UserCache holds users by ID for the read paths that never need the
+// password hash. Implementations must not store it.
+type UserCache interface {
+	GetUser(ctx context.Context, id string) (*model.User, error)
+	SetUser(ctx context.Context, user *model.User) error
+	DeleteUser(ctx context.Context, id string) error
+}
+
+type userCache struct {
+	redisClient *redis.RedisClient
+	logger      *zap.Logger
+	tracer      trace.Tracer
+}
+
+func NewUserCache(redisClient *redis.RedisClient) UserCache {
+	return &userCache{
+		redisClient: redisClient,
+		logger:      zap.L().Named("user_cache"),
+		tracer:      otel.Tracer("user-cache"),
+	}
+}
+
+func (c *userCache) GetUser(ctx context.Context, id string) (*model.User, error) {
+	ctx, span := c.tracer.Start(ctx, "UserCache.GetUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", id))
+
+	cacheKey := c.userKey(id)
+	data, err := c.redisClient.Get(ctx, cacheKey)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if data == "" {
+		return nil, nil
+	}
+
+	var user model.User
+	if err := json.Unmarshal([]byte(data), &user); err != nil {
+		c.logger.Error("Failed to unmarshal cached user",
+			zap.Error(err),
+			zap.String("key", cacheKey),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (c *userCache) SetUser(ctx context.Context, user *model.User) error {
+	ctx, span := c.tracer.Start(ctx, "UserCache.SetUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", user.ID))
+
+	// Password is tagged json:"-", so the hash never reaches Redis
+	data, err := json.Marshal(user)
+	if err != nil {
+		c.logger.Error("Failed to marshal user for cache", zap.Error(err))
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.redisClient.Set(ctx, c.userKey(user.ID), data); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *userCache) DeleteUser(ctx context.Context, id string) error {
+	ctx, span := c.tracer.Start(ctx, "UserCache.DeleteUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", id))
+
+	if err := c.redisClient.Delete(ctx, c.userKey(id)); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *userCache) userKey(id string) string {
+	return fmt.Sprintf("user:%s", id)
+}
+
+type noopUserCache struct{}
+
+// NewNoopUserCache returns a cache that never hits, used when the user
+// cache is disabled.
+func NewNoopUserCache() UserCache {
+	return noopUserCache{}
+}
+
+func (noopUserCache) GetUser(ctx context.Context, id string) (*model.User, error) {
+	return nil, nil
+}
+
+func (noopUserCache) SetUser(ctx context.Context, user *model.User) error {
+	return nil
+}
+
+func (noopUserCache) DeleteUser(ctx context.Context, id string) error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	pb "github.com/amirhasanpour/task-manager/user-service/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FeedTokenHandler struct {
+	pb.UnimplementedFeedTokenServiceServer
+	service service.FeedTokenService
+	logger  *zap.Logger
+	tracer  trace.Tracer
+}
+
+func NewFeedTokenHandler(service service.FeedTokenService) *FeedTokenHandler {
+	return &FeedTokenHandler{
+		service: service,
+		logger:  zap.L().Named("feed_token_handler"),
+		tracer:  trace.NewNoopTracerProvider().Tracer("noop"),
+	}
+}
+
+func (h *FeedTokenHandler) CreateFeedToken(ctx context.Context, req *pb.CreateFeedTokenRequest) (*pb.CreateFeedTokenResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "FeedTokenHandler.CreateFeedToken")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	h.logger.Debug("CreateFeedToken request received", zap.String("user_id", req.UserId))
+
+	feedToken, token, err := h.service.CreateFeedToken(ctx, req.UserId, req.Name)
+	if err != nil {
+		h.logger.Error("Failed to create feed token", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.CreateFeedTokenResponse{
+		FeedToken: feedTokenModelToProto(feedToken),
+		Token:     token,
+	}, nil
+}
+
+func (h *FeedTokenHandler) ListFeedTokens(ctx context.Context, req *pb.ListFeedTokensRequest) (*pb.ListFeedTokensResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "FeedTokenHandler.ListFeedTokens")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", req.UserId))
+
+	feedTokens, err := h.service.ListFeedTokens(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to list feed tokens", zap.Error(err))
+		return nil, err
+	}
+
+	protoTokens := make([]*pb.FeedToken, len(feedTokens))
+	for i, feedToken := range feedTokens {
+		protoTokens[i] = feedTokenModelToProto(feedToken)
+	}
+
+	return &pb.ListFeedTokensResponse{FeedTokens: protoTokens}, nil
+}
+
+func (h *FeedTokenHandler) RevokeFeedToken(ctx context.Context, req *pb.RevokeFeedTokenRequest) (*pb.RevokeFeedTokenResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "FeedTokenHandler.RevokeFeedToken")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("feed_token.id", req.Id))
+
+	if err := h.service.RevokeFeedToken(ctx, req.Id, req.UserId); err != nil {
+		h.logger.Error("Failed to revoke feed token", zap.Error(err), zap.String("id", req.Id))
+		return nil, err
+	}
+
+	return &pb.RevokeFeedTokenResponse{Success: true}, nil
+}
+
+func (h *FeedTokenHandler) ValidateFeedToken(ctx context.Context, req *pb.ValidateFeedTokenRequest) (*pb.ValidateFeedTokenResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "FeedTokenHandler.ValidateFeedToken")
+	defer span.End()
+
+	user, err := h.service.ValidateFeedToken(ctx, req.Token)
+	if err != nil {
+		h.logger.Warn("Failed to validate feed token", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.ValidateFeedTokenResponse{User: modelToProto(user)}, nil
+}
+
+func feedTokenModelToProto(feedToken *model.FeedToken) *pb.FeedToken {
+	if feedToken == nil {
+		return nil
+	}
+
+	protoToken := &pb.FeedToken{
+		Id:        feedToken.ID,
+		UserId:    feedToken.UserID,
+		Name:      feedToken.Name,
+		CreatedAt: timestamppb.New(feedToken.CreatedAt),
+	}
+	if feedToken.LastUsedAt != nil {
+		protoToken.LastUsedAt = timestamppb.New(*feedToken.LastUsedAt)
+	}
+	return protoToken
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 
 	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
 	"github.com/amirhasanpour/task-manager/user-service/internal/service"
 	pb "github.com/amirhasanpour/task-manager/user-service/proto"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -17,6 +20,8 @@ type UserHandler struct {
 	service service.UserService
 	logger  *zap.Logger
 	tracer  trace.Tracer
+
+	healthChecks map[string]HealthCheck
 }
 
 func NewUserHandler(service service.UserService) *UserHandler {
@@ -83,6 +88,40 @@ func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 	return resp, nil
 }
 
+func (h *UserHandler) GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.GetUserResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.GetUserByUsername")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.username", req.Username))
+
+	h.logger.Debug("GetUserByUsername request received", zap.String("username", req.Username))
+
+	user, err := h.service.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		h.logger.Debug("Failed to get user by username", zap.Error(err), zap.String("username", req.Username))
+		return nil, err
+	}
+
+	return &pb.GetUserResponse{User: modelToProto(user)}, nil
+}
+
+func (h *UserHandler) GetUserByEmail(ctx context.Context, req *pb.GetUserByEmailRequest) (*pb.GetUserResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.GetUserByEmail")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.email", req.Email))
+
+	h.logger.Debug("GetUserByEmail request received", zap.String("email", req.Email))
+
+	user, err := h.service.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		h.logger.Debug("Failed to get user by email", zap.Error(err), zap.String("email", req.Email))
+		return nil, err
+	}
+
+	return &pb.GetUserResponse{User: modelToProto(user)}, nil
+}
+
 func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "UserHandler.UpdateUser")
 	defer span.End()
@@ -95,18 +134,41 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		ID: req.Id,
 	}
 
-	// Only set fields that are provided (not empty strings)
-	if req.Username != "" {
-		serviceReq.Username = &req.Username
-	}
-	if req.Email != "" {
-		serviceReq.Email = &req.Email
-	}
-	if req.FullName != "" {
-		serviceReq.FullName = &req.FullName
-	}
-	if req.Password != "" {
-		serviceReq.Password = &req.Password
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		// Set exactly the masked fields, even to empty values
+		for _, path := range paths {
+			switch path {
+			case "username":
+				serviceReq.Username = &req.Username
+			case "email":
+				serviceReq.Email = &req.Email
+			case "full_name":
+				serviceReq.FullName = &req.FullName
+			case "password":
+				serviceReq.Password = &req.Password
+			case "avatar_url":
+				serviceReq.AvatarURL = &req.AvatarUrl
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+			}
+		}
+	} else {
+		// Only set fields that are provided (not empty strings)
+		if req.Username != "" {
+			serviceReq.Username = &req.Username
+		}
+		if req.Email != "" {
+			serviceReq.Email = &req.Email
+		}
+		if req.FullName != "" {
+			serviceReq.FullName = &req.FullName
+		}
+		if req.Password != "" {
+			serviceReq.Password = &req.Password
+		}
+		if req.AvatarUrl != "" {
+			serviceReq.AvatarURL = &req.AvatarUrl
+		}
 	}
 
 	user, err := h.service.UpdateUser(ctx, serviceReq)
@@ -157,12 +219,20 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 	h.logger.Debug("ListUsers request received", 
 		zap.Int32("page", req.Page),
 		zap.Int32("page_size", req.PageSize),
+		zap.String("search", req.Search),
+		zap.String("sort_by", req.SortBy),
+		zap.Bool("sort_desc", req.SortDesc),
 	)
 
 	page := int(req.Page)
 	pageSize := int(req.PageSize)
+	filter := &repository.UserFilter{
+		Search:   req.Search,
+		SortBy:   req.SortBy,
+		SortDesc: req.SortDesc,
+	}
 
-	users, total, err := h.service.ListUsers(ctx, page, pageSize)
+	users, total, err := h.service.ListUsers(ctx, filter, page, pageSize)
 	if err != nil {
 		h.logger.Error("Failed to list users", zap.Error(err))
 		return nil, err
@@ -179,6 +249,7 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	}
+	resp.TotalPages, resp.HasNext = pageInfo(total, req.Page, req.PageSize)
 
 	h.logger.Debug("ListUsers completed successfully", 
 		zap.Int("user_count", len(users)),
@@ -205,15 +276,16 @@ func (h *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		FullName: req.FullName,
 	}
 
-	user, token, err := h.service.Register(ctx, serviceReq)
+	user, tokens, err := h.service.Register(ctx, serviceReq)
 	if err != nil {
 		h.logger.Error("Failed to register user", zap.Error(err))
 		return nil, err
 	}
 
 	resp := &pb.RegisterResponse{
-		User:  modelToProto(user),
-		Token: token,
+		User:         modelToProto(user),
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}
 
 	h.logger.Info("Register completed successfully", zap.String("user_id", user.ID))
@@ -228,21 +300,83 @@ func (h *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 
 	h.logger.Debug("Login request received", zap.String("email", req.Email))
 
-	user, token, err := h.service.Login(ctx, req.Email, req.Password)
+	user, tokens, err := h.service.Login(ctx, req.Email, req.Password)
 	if err != nil {
 		h.logger.Error("Failed to login user", zap.Error(err), zap.String("email", req.Email))
 		return nil, err
 	}
 
 	resp := &pb.LoginResponse{
-		User:  modelToProto(user),
-		Token: token,
+		User:         modelToProto(user),
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}
 
 	h.logger.Info("Login completed successfully", zap.String("user_id", user.ID))
 	return resp, nil
 }
 
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.RequestPasswordReset")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.email", req.Email))
+
+	h.logger.Debug("RequestPasswordReset request received", zap.String("email", req.Email))
+
+	if err := h.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		h.logger.Error("Failed to request password reset", zap.Error(err), zap.String("email", req.Email))
+		return nil, err
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.ResetPassword")
+	defer span.End()
+
+	h.logger.Debug("ResetPassword request received")
+
+	if err := h.service.ResetPassword(ctx, req.Token, req.NewPassword); err != nil {
+		h.logger.Warn("Failed to reset password", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("ResetPassword completed successfully")
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+func (h *UserHandler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.RefreshToken")
+	defer span.End()
+
+	h.logger.Debug("RefreshToken request received")
+
+	token, err := h.service.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		h.logger.Error("Failed to refresh token", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Debug("RefreshToken completed successfully")
+	return &pb.RefreshTokenResponse{Token: token}, nil
+}
+
+func (h *UserHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.Logout")
+	defer span.End()
+
+	h.logger.Debug("Logout request received")
+
+	if err := h.service.Logout(ctx, req.Token, req.RefreshToken); err != nil {
+		h.logger.Error("Failed to logout", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
+
 func (h *UserHandler) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "UserHandler.ValidateToken")
 	defer span.End()
@@ -264,6 +398,69 @@ func (h *UserHandler) ValidateToken(ctx context.Context, req *pb.ValidateTokenRe
 	return resp, nil
 }
 
+func (h *UserHandler) BatchGetPublicProfiles(ctx context.Context, req *pb.BatchGetPublicProfilesRequest) (*pb.BatchGetPublicProfilesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.BatchGetPublicProfiles")
+	defer span.End()
+
+	h.logger.Debug("BatchGetPublicProfiles request received", zap.Int("count", len(req.Ids)))
+
+	profiles, err := h.service.BatchGetPublicProfiles(ctx, req.Ids)
+	if err != nil {
+		h.logger.Error("Failed to get public profiles", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.BatchGetPublicProfilesResponse{
+		Profiles: make([]*pb.PublicProfile, len(profiles)),
+	}
+	for i, profile := range profiles {
+		resp.Profiles[i] = &pb.PublicProfile{
+			Id:       profile.ID,
+			Username: profile.Username,
+			FullName:  profile.FullName,
+			AvatarUrl: profile.AvatarURL,
+		}
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) BatchValidateTokens(ctx context.Context, req *pb.BatchValidateTokensRequest) (*pb.BatchValidateTokensResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "UserHandler.BatchValidateTokens")
+	defer span.End()
+
+	h.logger.Debug("BatchValidateTokens request received", zap.Int("count", len(req.Tokens)))
+
+	results, err := h.service.BatchValidateTokens(ctx, req.Tokens)
+	if err != nil {
+		h.logger.Error("Failed to validate token batch", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.BatchValidateTokensResponse{
+		Results: make([]*pb.TokenValidationResult, len(results)),
+	}
+	for i, result := range results {
+		resp.Results[i] = &pb.TokenValidationResult{
+			Valid:  result.Valid,
+			User:   modelToProto(result.User),
+			Reason: result.Reason,
+		}
+	}
+
+	h.logger.Debug("BatchValidateTokens completed successfully", zap.Int("count", len(results)))
+	return resp, nil
+}
+
+// pageInfo reports how many pages of pageSize total items make, and whether
+// there is one after page. A pageSize below 1 yields no pages.
+func pageInfo(total int64, page, pageSize int32) (int32, bool) {
+	if pageSize < 1 {
+		return 0, false
+	}
+	totalPages := int32((total + int64(pageSize) - 1) / int64(pageSize))
+	return totalPages, max(page, 1) < totalPages
+}
+
 func modelToProto(user *model.User) *pb.User {
 	if user == nil {
 		return nil
@@ -274,6 +471,8 @@ func modelToProto(user *model.User) *pb.User {
 		Username:  user.Username,
 		Email:     user.Email,
 		FullName:  user.FullName,
+		AvatarUrl: user.AvatarURL,
+		Role:      user.Role,
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
 	}
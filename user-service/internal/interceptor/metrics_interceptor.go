@@ -55,7 +55,8 @@ func (mi *MetricsInterceptor) Unary() grpc.UnaryServerInterceptor {
 			switch statusCode {
 			case codes.Internal:
 				mi.metrics.IncrementDatabaseErrors()
-			case codes.Unauthenticated, codes.PermissionDenied:
+			case codes.Unauthenticated, codes.PermissionDenied, codes.ResourceExhausted:
+				// ResourceExhausted is a login refused by the lockout
 				mi.metrics.IncrementAuthenticationErrors()
 			case codes.InvalidArgument, codes.AlreadyExists, codes.NotFound:
 				mi.metrics.IncrementValidationErrors()
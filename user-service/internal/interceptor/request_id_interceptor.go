@@ -0,0 +1,59 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/amirhasanpour/task-manager/user-service/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDMetadataKey carries the ID the API gateway assigns to each HTTP
+// request, so logs can be correlated across services.
+const RequestIDMetadataKey = "x-request-id"
+
+// maxRequestIDLength bounds the IDs taken from callers so they can't bloat
+// every log line.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the request ID of the call, or "" if the
+// caller sent none.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// RequestIDInterceptor picks the request ID up from the incoming metadata
+// and stores it in the context, together with a child logger that tags
+// every entry with it.
+type RequestIDInterceptor struct {
+	logger *zap.Logger
+}
+
+func NewRequestIDInterceptor() *RequestIDInterceptor {
+	return &RequestIDInterceptor{
+		logger: zap.L(),
+	}
+}
+
+func (ri *RequestIDInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		values := md.Get(RequestIDMetadataKey)
+		if len(values) == 0 || values[0] == "" || len(values[0]) > maxRequestIDLength {
+			return handler(ctx, req)
+		}
+
+		requestID := values[0]
+		ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+		ctx = logger.WithContext(ctx, ri.logger.With(zap.String("request_id", requestID)))
+		return handler(ctx, req)
+	}
+}
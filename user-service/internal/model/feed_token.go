@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FeedToken grants read-only access to a user's feeds, such as the task
+// calendar, to clients that cannot send a bearer token. Only the SHA-256
+// of the token is stored; the token itself is shown once, on creation.
+type FeedToken struct {
+	ID         string     `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID     string     `gorm:"type:uuid;not null;index:idx_feed_token_user_id" json:"user_id"`
+	Name       string     `gorm:"type:varchar(100)" json:"name"`
+	TokenHash  string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (t *FeedToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	return nil
+}
@@ -7,20 +7,37 @@ import (
 	"gorm.io/gorm"
 )
 
+// Roles a user can hold. Admins may manage other users and see every task.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 type User struct {
 	ID        string    `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
 	Username  string    `gorm:"type:varchar(100);uniqueIndex;not null" json:"username"`
-	Email     string    `gorm:"type:varchar(100);uniqueIndex;not null" json:"email"`
+	// Email is stored lowercased; the lower(email) index serves
+	// case-insensitive lookups of rows stored before that.
+	Email     string    `gorm:"type:varchar(100);uniqueIndex;index:idx_users_email_lower,expression:lower(email);not null" json:"email"`
 	Password  string    `gorm:"type:varchar(255);not null" json:"-"`
 	FullName  string    `gorm:"type:varchar(200)" json:"full_name"`
+	// AvatarURL is an optional http(s) link to a profile image.
+	AvatarURL string    `gorm:"type:varchar(2048)" json:"avatar_url,omitempty"`
+	Role      string    `gorm:"type:varchar(20);not null;default:'user'" json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// PasswordResetAt is when the password was last reset; reset tokens
+	// issued before it are spent.
+	PasswordResetAt *time.Time `json:"-"`
 }
 
 func (u *User) BeforeCreate(tx *gorm.DB) error {
 	if u.ID == "" {
 		u.ID = uuid.New().String()
 	}
+	if u.Role == "" {
+		u.Role = RoleUser
+	}
 	return nil
 }
 
@@ -30,6 +47,8 @@ func (u *User) ToProto() *User {
 		Username:  u.Username,
 		Email:     u.Email,
 		FullName:  u.FullName,
+		AvatarURL: u.AvatarURL,
+		Role:      u.Role,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
 	}
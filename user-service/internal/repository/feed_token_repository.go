@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type FeedTokenRepository interface {
+	Create(ctx context.Context, token *model.FeedToken) (*model.FeedToken, error)
+	FindByHash(ctx context.Context, tokenHash string) (*model.FeedToken, error)
+	ListByUser(ctx context.Context, userID string) ([]*model.FeedToken, error)
+	DeleteByUser(ctx context.Context, id, userID string) error
+	DeleteAllByUser(ctx context.Context, userID string) error
+	MarkUsed(ctx context.Context, id string, at time.Time) error
+}
+
+type feedTokenRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewFeedTokenRepository(db *gorm.DB) FeedTokenRepository {
+	return &feedTokenRepository{
+		db:     db,
+		logger: zap.L().Named("feed_token_repository"),
+	}
+}
+
+func (r *feedTokenRepository) Create(ctx context.Context, token *model.FeedToken) (*model.FeedToken, error) {
+	r.logger.Debug("Creating feed token", zap.String("user_id", token.UserID))
+
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		r.logger.Error("Failed to create feed token", zap.Error(err), zap.String("user_id", token.UserID))
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (r *feedTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*model.FeedToken, error) {
+	var token model.FeedToken
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find feed token", zap.Error(err))
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *feedTokenRepository) ListByUser(ctx context.Context, userID string) ([]*model.FeedToken, error) {
+	r.logger.Debug("Listing feed tokens", zap.String("user_id", userID))
+
+	var tokens []*model.FeedToken
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&tokens).Error; err != nil {
+		r.logger.Error("Failed to list feed tokens", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// DeleteByUser deletes the feed token with id if it belongs to userID. It
+// returns gorm.ErrRecordNotFound when there is no such token.
+func (r *feedTokenRepository) DeleteByUser(ctx context.Context, id, userID string) error {
+	r.logger.Debug("Deleting feed token", zap.String("id", id), zap.String("user_id", userID))
+
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&model.FeedToken{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete feed token", zap.Error(result.Error), zap.String("id", id))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	r.logger.Info("Feed token deleted successfully", zap.String("id", id))
+	return nil
+}
+
+// DeleteAllByUser deletes every feed token belonging to userID.
+func (r *feedTokenRepository) DeleteAllByUser(ctx context.Context, userID string) error {
+	r.logger.Debug("Deleting all feed tokens", zap.String("user_id", userID))
+
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.FeedToken{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete feed tokens", zap.Error(result.Error), zap.String("user_id", userID))
+		return result.Error
+	}
+
+	r.logger.Info("Feed tokens deleted", zap.String("user_id", userID), zap.Int64("count", result.RowsAffected))
+	return nil
+}
+
+func (r *feedTokenRepository) MarkUsed(ctx context.Context, id string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.FeedToken{}).
+		Where("id = ?", id).
+		Update("last_used_at", at).Error
+}
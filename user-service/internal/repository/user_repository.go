@@ -3,20 +3,63 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+// Errors returned by Create and Update when the unique index on email or
+// username rejects the row, e.g. when two registrations race past the
+// service's existence checks.
+var (
+	ErrDuplicateEmail    = errors.New("email already exists")
+	ErrDuplicateUsername = errors.New("username already exists")
+)
+
+// uniqueViolation is the Postgres error code for a unique index violation.
+const uniqueViolation = "23505"
+
+// translateDuplicate maps a unique violation on the users table's email or
+// username index to its sentinel and returns any other error unchanged.
+func translateDuplicate(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolation {
+		return err
+	}
+	switch {
+	case strings.HasSuffix(pgErr.ConstraintName, "_email"):
+		return ErrDuplicateEmail
+	case strings.HasSuffix(pgErr.ConstraintName, "_username"):
+		return ErrDuplicateUsername
+	}
+	return err
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) (*model.User, error)
 	FindByID(ctx context.Context, id string) (*model.User, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*model.User, error)
 	FindByEmail(ctx context.Context, email string) (*model.User, error)
 	FindByUsername(ctx context.Context, username string) (*model.User, error)
 	Update(ctx context.Context, user *model.User) (*model.User, error)
+	ResetPassword(ctx context.Context, id, hashedPassword string, tokenIssuedAt, resetAt time.Time) (bool, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, page, pageSize int) ([]*model.User, int64, error)
+	List(ctx context.Context, filter *UserFilter, page, pageSize int) ([]*model.User, int64, error)
+}
+
+// UserFilter narrows and orders List. The zero value lists every user,
+// newest first.
+type UserFilter struct {
+	// Search matches case-insensitively anywhere in the username, email or
+	// full name.
+	Search   string
+	SortBy   string
+	SortDesc bool
 }
 
 type userRepository struct {
@@ -36,7 +79,7 @@ func (r *userRepository) Create(ctx context.Context, user *model.User) (*model.U
 	
 	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
 		r.logger.Error("Failed to create user", zap.Error(err), zap.String("email", user.Email))
-		return nil, err
+		return nil, translateDuplicate(err)
 	}
 	
 	r.logger.Info("User created successfully", zap.String("id", user.ID), zap.String("email", user.Email))
@@ -59,11 +102,27 @@ func (r *userRepository) FindByID(ctx context.Context, id string) (*model.User,
 	return &user, nil
 }
 
+// FindByIDs returns the users with the given IDs, in no particular order.
+// IDs without a user are skipped.
+func (r *userRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.User, error) {
+	r.logger.Debug("Finding users by IDs", zap.Int("count", len(ids)))
+
+	var users []*model.User
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error; err != nil {
+		r.logger.Error("Failed to find users by IDs", zap.Error(err))
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// FindByEmail matches email case-insensitively, so rows stored before
+// emails were normalized are still found.
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	r.logger.Debug("Finding user by email", zap.String("email", email))
 	
 	var user model.User
-	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("LOWER(email) = LOWER(?)", email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.logger.Debug("User not found by email", zap.String("email", email))
 			return nil, nil
@@ -97,7 +156,7 @@ func (r *userRepository) Update(ctx context.Context, user *model.User) (*model.U
 	result := r.db.WithContext(ctx).Save(user)
 	if result.Error != nil {
 		r.logger.Error("Failed to update user", zap.Error(result.Error), zap.String("id", user.ID))
-		return nil, result.Error
+		return nil, translateDuplicate(result.Error)
 	}
 	
 	if result.RowsAffected == 0 {
@@ -109,25 +168,60 @@ func (r *userRepository) Update(ctx context.Context, user *model.User) (*model.U
 	return user, nil
 }
 
-func (r *userRepository) Delete(ctx context.Context, id string) error {
-	r.logger.Debug("Deleting user", zap.String("id", id))
-	
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.User{})
+// ResetPassword stores a new password hash and stamps resetAt, unless the
+// password was already reset at or after tokenIssuedAt. It reports whether
+// the reset was applied; checking and writing in one statement keeps a
+// reset token from being used twice concurrently.
+func (r *userRepository) ResetPassword(ctx context.Context, id, hashedPassword string, tokenIssuedAt, resetAt time.Time) (bool, error) {
+	r.logger.Debug("Resetting password", zap.String("id", id))
+
+	result := r.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ? AND (password_reset_at IS NULL OR password_reset_at < ?)", id, tokenIssuedAt).
+		Updates(map[string]any{
+			"password":          hashedPassword,
+			"password_reset_at": resetAt,
+			"updated_at":        resetAt,
+		})
 	if result.Error != nil {
-		r.logger.Error("Failed to delete user", zap.Error(result.Error), zap.String("id", id))
-		return result.Error
+		r.logger.Error("Failed to reset password", zap.Error(result.Error), zap.String("id", id))
+		return false, result.Error
 	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *userRepository) Delete(ctx context.Context, id string) error {
+	r.logger.Debug("Deleting user", zap.String("id", id))
 	
-	if result.RowsAffected == 0 {
-		r.logger.Warn("No rows affected when deleting user", zap.String("id", id))
-		return errors.New("no user found to delete")
+	// The user's feed tokens go in the same transaction, so no rows keyed
+	// by their id outlive them
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := NewFeedTokenRepository(tx).DeleteAllByUser(ctx, id); err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&model.User{})
+		if result.Error != nil {
+			r.logger.Error("Failed to delete user", zap.Error(result.Error), zap.String("id", id))
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			r.logger.Warn("No rows affected when deleting user", zap.String("id", id))
+			return errors.New("no user found to delete")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 	
 	r.logger.Info("User deleted successfully", zap.String("id", id))
 	return nil
 }
 
-func (r *userRepository) List(ctx context.Context, page, pageSize int) ([]*model.User, int64, error) {
+func (r *userRepository) List(ctx context.Context, filter *UserFilter, page, pageSize int) ([]*model.User, int64, error) {
 	r.logger.Debug("Listing users", zap.Int("page", page), zap.Int("pageSize", pageSize))
 	
 	offset := (page - 1) * pageSize
@@ -135,18 +229,65 @@ func (r *userRepository) List(ctx context.Context, page, pageSize int) ([]*model
 	var users []*model.User
 	var total int64
 	
+	query := applyUserFilter(r.db.WithContext(ctx).Model(&model.User{}), filter)
+
 	// Get total count
-	if err := r.db.WithContext(ctx).Model(&model.User{}).Count(&total).Error; err != nil {
+	if err := query.Count(&total).Error; err != nil {
 		r.logger.Error("Failed to count users", zap.Error(err))
 		return nil, 0, err
 	}
 	
 	// Get paginated users
-	if err := r.db.WithContext(ctx).Offset(offset).Limit(pageSize).Find(&users).Error; err != nil {
+	if err := applyUserSorting(query, filter).Offset(offset).Limit(pageSize).Find(&users).Error; err != nil {
 		r.logger.Error("Failed to list users", zap.Error(err))
 		return nil, 0, err
 	}
 	
 	r.logger.Debug("Users listed successfully", zap.Int64("total", total), zap.Int("count", len(users)))
 	return users, total, nil
-}
\ No newline at end of file
+}
+
+// applyUserFilter matches the search term with LOWER and LIKE rather than
+// ILIKE so the query also runs on SQLite.
+func applyUserFilter(query *gorm.DB, filter *UserFilter) *gorm.DB {
+	if filter == nil || filter.Search == "" {
+		return query
+	}
+	pattern := "%" + escapeLike(strings.ToLower(filter.Search)) + "%"
+	return query.Where(
+		`(LOWER(username) LIKE ? ESCAPE '\' OR LOWER(email) LIKE ? ESCAPE '\' OR LOWER(full_name) LIKE ? ESCAPE '\')`,
+		pattern, pattern, pattern,
+	)
+}
+
+// escapeLike makes LIKE wildcards in user input match literally.
+func escapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term)
+}
+
+func applyUserSorting(query *gorm.DB, filter *UserFilter) *gorm.DB {
+	if filter == nil || filter.SortBy == "" {
+		return query.Order("created_at DESC")
+	}
+
+	order := "ASC"
+	if filter.SortDesc {
+		order = "DESC"
+	}
+	return query.Order(fmt.Sprintf("%s %s", mapUserSortField(filter.SortBy), order))
+}
+
+func mapUserSortField(field string) string {
+	switch strings.ToLower(field) {
+	case "username":
+		return "username"
+	case "email":
+		return "email"
+	case "full_name":
+		return "full_name"
+	case "updated_at":
+		return "updated_at"
+	default:
+		return "created_at"
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// FeedTokenService manages feed tokens. A feed token only identifies its
+// user to read-only feeds; it is never accepted in place of an access token.
+type FeedTokenService interface {
+	CreateFeedToken(ctx context.Context, userID, name string) (*model.FeedToken, string, error)
+	ListFeedTokens(ctx context.Context, userID string) ([]*model.FeedToken, error)
+	RevokeFeedToken(ctx context.Context, id, userID string) error
+	ValidateFeedToken(ctx context.Context, token string) (*model.User, error)
+}
+
+type feedTokenService struct {
+	repo   repository.FeedTokenRepository
+	users  repository.UserRepository
+	logger *zap.Logger
+	tracer trace.Tracer
+}
+
+func NewFeedTokenService(repo repository.FeedTokenRepository, users repository.UserRepository) FeedTokenService {
+	return &feedTokenService{
+		repo:   repo,
+		users:  users,
+		logger: zap.L().Named("feed_token_service"),
+		tracer: otel.Tracer("feed-token-service"),
+	}
+}
+
+// CreateFeedToken issues a new feed token for the user and returns it along
+// with its record. The token cannot be retrieved again.
+func (s *feedTokenService) CreateFeedToken(ctx context.Context, userID, name string) (*model.FeedToken, string, error) {
+	ctx, span := s.tracer.Start(ctx, "FeedTokenService.CreateFeedToken")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	if userID == "" {
+		return nil, "", status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(name) > 100 {
+		return nil, "", status.Error(codes.InvalidArgument, "name must be less than 100 characters")
+	}
+
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user for feed token", zap.Error(err), zap.String("user_id", userID))
+		span.RecordError(err)
+		return nil, "", status.Error(codes.Internal, "failed to create feed token")
+	}
+	if user == nil {
+		return nil, "", status.Error(codes.NotFound, "user not found")
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		s.logger.Error("Failed to generate feed token", zap.Error(err))
+		span.RecordError(err)
+		return nil, "", status.Error(codes.Internal, "failed to create feed token")
+	}
+	token := hex.EncodeToString(raw)
+
+	created, err := s.repo.Create(ctx, &model.FeedToken{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashFeedToken(token),
+	})
+	if err != nil {
+		s.logger.Error("Failed to create feed token in repository", zap.Error(err))
+		span.RecordError(err)
+		return nil, "", status.Error(codes.Internal, "failed to create feed token")
+	}
+
+	s.logger.Info("Feed token created",
+		zap.String("id", created.ID),
+		zap.String("user_id", userID),
+	)
+	return created, token, nil
+}
+
+func (s *feedTokenService) ListFeedTokens(ctx context.Context, userID string) ([]*model.FeedToken, error) {
+	ctx, span := s.tracer.Start(ctx, "FeedTokenService.ListFeedTokens")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", userID))
+
+	tokens, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list feed tokens from repository", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list feed tokens")
+	}
+
+	return tokens, nil
+}
+
+func (s *feedTokenService) RevokeFeedToken(ctx context.Context, id, userID string) error {
+	ctx, span := s.tracer.Start(ctx, "FeedTokenService.RevokeFeedToken")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("feed_token.id", id),
+		attribute.String("user.id", userID),
+	)
+
+	if err := s.repo.DeleteByUser(ctx, id, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "feed token not found")
+		}
+		s.logger.Error("Failed to delete feed token from repository", zap.Error(err))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to revoke feed token")
+	}
+
+	s.logger.Info("Feed token revoked", zap.String("id", id), zap.String("user_id", userID))
+	return nil
+}
+
+// ValidateFeedToken returns the user a feed token belongs to and records
+// that the token was used.
+func (s *feedTokenService) ValidateFeedToken(ctx context.Context, token string) (*model.User, error) {
+	ctx, span := s.tracer.Start(ctx, "FeedTokenService.ValidateFeedToken")
+	defer span.End()
+
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid feed token")
+	}
+
+	feedToken, err := s.repo.FindByHash(ctx, hashFeedToken(token))
+	if err != nil {
+		s.logger.Error("Failed to find feed token", zap.Error(err))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to validate feed token")
+	}
+	if feedToken == nil {
+		s.logger.Warn("Invalid feed token")
+		return nil, status.Error(codes.Unauthenticated, "invalid feed token")
+	}
+
+	span.SetAttributes(attribute.String("user.id", feedToken.UserID))
+
+	user, err := s.users.FindByID(ctx, feedToken.UserID)
+	if err != nil {
+		s.logger.Error("Failed to get user for feed token", zap.Error(err), zap.String("user_id", feedToken.UserID))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to validate feed token")
+	}
+	if user == nil {
+		s.logger.Warn("Feed token of a deleted user", zap.String("user_id", feedToken.UserID))
+		return nil, status.Error(codes.Unauthenticated, "invalid feed token")
+	}
+
+	if err := s.repo.MarkUsed(ctx, feedToken.ID, time.Now().UTC()); err != nil {
+		s.logger.Warn("Failed to record feed token use", zap.Error(err), zap.String("id", feedToken.ID))
+	}
+
+	user.Password = ""
+	return user, nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -3,11 +3,17 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/cache"
 	"github.com/amirhasanpour/task-manager/user-service/internal/model"
 	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
 	"github.com/amirhasanpour/task-manager/user-service/pkg/hash"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -20,21 +26,110 @@ import (
 type UserService interface {
 	CreateUser(ctx context.Context, req *CreateUserRequest) (*model.User, error)
 	GetUser(ctx context.Context, id string) (*model.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest) (*model.User, error)
 	DeleteUser(ctx context.Context, id string) error
-	ListUsers(ctx context.Context, page, pageSize int) ([]*model.User, int64, error)
-	Register(ctx context.Context, req *RegisterRequest) (*model.User, string, error)
-	Login(ctx context.Context, email, password string) (*model.User, string, error)
+	ListUsers(ctx context.Context, filter *repository.UserFilter, page, pageSize int) ([]*model.User, int64, error)
+	Register(ctx context.Context, req *RegisterRequest) (*model.User, *auth.TokenPair, error)
+	Login(ctx context.Context, email, password string) (*model.User, *auth.TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (string, error)
+	Logout(ctx context.Context, accessToken, refreshToken string) error
 	ValidateToken(ctx context.Context, token string) (*model.User, error)
+	BatchValidateTokens(ctx context.Context, tokens []string) ([]*ValidationResult, error)
+	BatchGetPublicProfiles(ctx context.Context, ids []string) ([]*PublicProfile, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
 }
 
 type userService struct {
 	repo       repository.UserRepository
+	cache      cache.UserCache
 	jwtManager *auth.JWTManager
+	config     Config
 	logger     *zap.Logger
 	tracer     trace.Tracer
 }
 
+// Config holds the tunable limits of the user service.
+type Config struct {
+	// MaxBatchTokens bounds how many tokens a single BatchValidateTokens
+	// call may carry.
+	MaxBatchTokens int
+	// PasswordResetTTL is how long a password reset token stays valid.
+	PasswordResetTTL time.Duration
+	// MaxBatchProfiles bounds how many users a single
+	// BatchGetPublicProfiles call may ask for.
+	MaxBatchProfiles int
+	// ResetNotifier delivers password reset tokens. Nil logs them, which is
+	// only suitable for development.
+	ResetNotifier PasswordResetNotifier
+	// IdempotentRegister lets a retried Register succeed: when the email,
+	// username and password match an existing account, that account is
+	// returned with a fresh token instead of AlreadyExists.
+	IdempotentRegister bool
+	// Denylist stores revoked token IDs. Nil disables Logout.
+	Denylist cache.TokenDenylist
+	// RequireStrongPasswords makes every new password pass
+	// hash.ValidatePasswordStrength.
+	RequireStrongPasswords bool
+	// LoginThrottle locks an email out of Login, and of idempotent Register
+	// retries, after repeated failures.
+	// Nil never locks anyone out.
+	LoginThrottle cache.LoginThrottle
+}
+
+// DefaultConfig returns the limits used by NewUserService.
+func DefaultConfig() Config {
+	return Config{
+		MaxBatchTokens:   100,
+		PasswordResetTTL: 15 * time.Minute,
+		MaxBatchProfiles: 100,
+	}
+}
+
+// PasswordResetNotifier sends a password reset token to the user it was
+// issued for, e.g. by email.
+type PasswordResetNotifier interface {
+	SendPasswordReset(ctx context.Context, user *model.User, token string) error
+}
+
+type logResetNotifier struct {
+	logger *zap.Logger
+}
+
+func (n logResetNotifier) SendPasswordReset(ctx context.Context, user *model.User, token string) error {
+	n.logger.Debug("Password reset token issued",
+		zap.String("user_id", user.ID),
+		zap.String("token", token),
+	)
+	return nil
+}
+
+// ReasonUserNotFound is reported for a well-formed token whose user no
+// longer exists.
+const ReasonUserNotFound = "user_not_found"
+
+// ReasonRevoked is reported for a token that was revoked by Logout.
+const ReasonRevoked = "revoked"
+
+// ValidationResult is the outcome for one token of a batch. Reason is empty
+// when the token is valid.
+type ValidationResult struct {
+	Valid  bool
+	User   *model.User
+	Reason string
+}
+
+// PublicProfile is the part of a user anyone may see. It deliberately
+// leaves out the email and role.
+type PublicProfile struct {
+	ID        string
+	Username  string
+	FullName  string
+	AvatarURL string
+}
+
 type CreateUserRequest struct {
 	Username string
 	Email    string
@@ -43,11 +138,12 @@ type CreateUserRequest struct {
 }
 
 type UpdateUserRequest struct {
-	ID       string
-	Username *string
-	Email    *string
-	Password *string
-	FullName *string
+	ID        string
+	Username  *string
+	Email     *string
+	Password  *string
+	FullName  *string
+	AvatarURL *string
 }
 
 type RegisterRequest struct {
@@ -58,9 +154,24 @@ type RegisterRequest struct {
 }
 
 func NewUserService(repo repository.UserRepository, jwtManager *auth.JWTManager) UserService {
+	return NewUserServiceWithConfig(repo, cache.NewNoopUserCache(), jwtManager, DefaultConfig())
+}
+
+func NewUserServiceWithConfig(repo repository.UserRepository, userCache cache.UserCache, jwtManager *auth.JWTManager, config Config) UserService {
+	if config.ResetNotifier == nil {
+		config.ResetNotifier = logResetNotifier{logger: zap.L().Named("password_reset")}
+	}
+	if config.Denylist == nil {
+		config.Denylist = cache.NewNoopTokenDenylist()
+	}
+	if config.LoginThrottle == nil {
+		config.LoginThrottle = cache.NewNoopLoginThrottle()
+	}
 	return &userService{
 		repo:       repo,
+		cache:      userCache,
 		jwtManager: jwtManager,
+		config:     config,
 		logger:     zap.L().Named("user_service"),
 		tracer:     otel.Tracer("user-service"),
 	}
@@ -70,6 +181,11 @@ func (s *userService) CreateUser(ctx context.Context, req *CreateUserRequest) (*
 	ctx, span := s.tracer.Start(ctx, "UserService.CreateUser")
 	defer span.End()
 
+	req.Email = normalizeEmail(req.Email)
+	if err := s.checkPasswordStrength(req.Password); err != nil {
+		return nil, err
+	}
+
 	span.SetAttributes(
 		attribute.String("user.email", req.Email),
 		attribute.String("user.username", req.Username),
@@ -118,6 +234,10 @@ func (s *userService) CreateUser(ctx context.Context, req *CreateUserRequest) (*
 	}
 
 	createdUser, err := s.repo.Create(ctx, user)
+	if dupErr := duplicateUserError(err); dupErr != nil {
+		s.logger.Warn("User created concurrently", zap.Error(err), zap.String("email", req.Email))
+		return nil, dupErr
+	}
 	if err != nil {
 		s.logger.Error("Failed to create user in repository", zap.Error(err))
 		span.RecordError(err)
@@ -140,7 +260,7 @@ func (s *userService) GetUser(ctx context.Context, id string) (*model.User, erro
 
 	s.logger.Debug("Getting user", zap.String("id", id))
 
-	user, err := s.repo.FindByID(ctx, id)
+	user, err := s.findUserCached(ctx, id)
 	if err != nil {
 		s.logger.Error("Failed to get user", zap.Error(err), zap.String("id", id))
 		span.RecordError(err)
@@ -152,10 +272,69 @@ func (s *userService) GetUser(ctx context.Context, id string) (*model.User, erro
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
+	s.logger.Debug("User retrieved successfully", zap.String("id", id))
+	return user, nil
+}
+
+// GetUserByUsername looks a user up by their exact username.
+func (s *userService) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	ctx, span := s.tracer.Start(ctx, "UserService.GetUserByUsername")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.username", username))
+
+	s.logger.Debug("Getting user by username", zap.String("username", username))
+
+	if username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+
+	user, err := s.repo.FindByUsername(ctx, username)
+	if err != nil {
+		s.logger.Error("Failed to get user by username", zap.Error(err), zap.String("username", username))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	if user == nil {
+		s.logger.Debug("User not found by username", zap.String("username", username))
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
 	// Clear password before returning
 	user.Password = ""
+	return user, nil
+}
 
-	s.logger.Debug("User retrieved successfully", zap.String("id", id))
+// GetUserByEmail looks a user up by email, ignoring case.
+func (s *userService) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	ctx, span := s.tracer.Start(ctx, "UserService.GetUserByEmail")
+	defer span.End()
+
+	email = normalizeEmail(email)
+
+	span.SetAttributes(attribute.String("user.email", email))
+
+	s.logger.Debug("Getting user by email", zap.String("email", email))
+
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	user, err := s.repo.FindByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("Failed to get user by email", zap.Error(err), zap.String("email", email))
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	if user == nil {
+		s.logger.Debug("User not found by email", zap.String("email", email))
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	// Clear password before returning
+	user.Password = ""
 	return user, nil
 }
 
@@ -167,6 +346,32 @@ func (s *userService) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*
 
 	s.logger.Debug("Updating user", zap.String("id", req.ID))
 
+	if req.Email != nil {
+		email := normalizeEmail(*req.Email)
+		req.Email = &email
+	}
+
+	// Only the full name may be cleared
+	if req.Username != nil && *req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username cannot be empty")
+	}
+	if req.Email != nil && *req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email cannot be empty")
+	}
+	if req.Password != nil && *req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "password cannot be empty")
+	}
+	if req.AvatarURL != nil {
+		if err := validateAvatarURL(*req.AvatarURL); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Password != nil {
+		if err := s.checkPasswordStrength(*req.Password); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get existing user
 	user, err := s.repo.FindByID(ctx, req.ID)
 	if err != nil {
@@ -225,8 +430,16 @@ func (s *userService) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*
 		user.FullName = *req.FullName
 	}
 
+	if req.AvatarURL != nil {
+		user.AvatarURL = *req.AvatarURL
+	}
+
 	// Update user
 	updatedUser, err := s.repo.Update(ctx, user)
+	if dupErr := duplicateUserError(err); dupErr != nil {
+		s.logger.Warn("Username or email taken concurrently", zap.Error(err), zap.String("id", req.ID))
+		return nil, dupErr
+	}
 	if err != nil {
 		s.logger.Error("Failed to update user", zap.Error(err), zap.String("id", req.ID))
 		span.RecordError(err)
@@ -236,6 +449,8 @@ func (s *userService) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*
 	// Clear password before returning
 	updatedUser.Password = ""
 
+	s.invalidateUser(ctx, req.ID)
+
 	s.logger.Info("User updated successfully", zap.String("id", req.ID))
 	return updatedUser, nil
 }
@@ -258,11 +473,13 @@ func (s *userService) DeleteUser(ctx context.Context, id string) error {
 		return status.Error(codes.Internal, "failed to delete user")
 	}
 
+	s.invalidateUser(ctx, id)
+
 	s.logger.Info("User deleted successfully", zap.String("id", id))
 	return nil
 }
 
-func (s *userService) ListUsers(ctx context.Context, page, pageSize int) ([]*model.User, int64, error) {
+func (s *userService) ListUsers(ctx context.Context, filter *repository.UserFilter, page, pageSize int) ([]*model.User, int64, error) {
 	ctx, span := s.tracer.Start(ctx, "UserService.ListUsers")
 	defer span.End()
 
@@ -284,7 +501,11 @@ func (s *userService) ListUsers(ctx context.Context, page, pageSize int) ([]*mod
 		pageSize = 100
 	}
 
-	users, total, err := s.repo.List(ctx, page, pageSize)
+	if filter != nil && filter.Search != "" {
+		span.SetAttributes(attribute.String("search", filter.Search))
+	}
+
+	users, total, err := s.repo.List(ctx, filter, page, pageSize)
 	if err != nil {
 		s.logger.Error("Failed to list users", zap.Error(err))
 		span.RecordError(err)
@@ -300,10 +521,15 @@ func (s *userService) ListUsers(ctx context.Context, page, pageSize int) ([]*mod
 	return users, total, nil
 }
 
-func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*model.User, string, error) {
+func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*model.User, *auth.TokenPair, error) {
 	ctx, span := s.tracer.Start(ctx, "UserService.Register")
 	defer span.End()
 
+	req.Email = normalizeEmail(req.Email)
+	if err := s.checkPasswordStrength(req.Password); err != nil {
+		return nil, nil, err
+	}
+
 	span.SetAttributes(
 		attribute.String("user.email", req.Email),
 		attribute.String("user.username", req.Username),
@@ -316,11 +542,14 @@ func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*mode
 	if err != nil {
 		s.logger.Error("Failed to check existing user by email", zap.Error(err))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to check existing user")
+		return nil, nil, status.Error(codes.Internal, "failed to check existing user")
 	}
 	if existingUser != nil {
+		if s.config.IdempotentRegister && existingUser.Username == req.Username {
+			return s.registerRetry(ctx, existingUser, req.Password)
+		}
 		s.logger.Warn("User with email already exists", zap.String("email", req.Email))
-		return nil, "", status.Error(codes.AlreadyExists, "user with this email already exists")
+		return nil, nil, status.Error(codes.AlreadyExists, "user with this email already exists")
 	}
 
 	// Check if user with username already exists
@@ -328,11 +557,11 @@ func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*mode
 	if err != nil {
 		s.logger.Error("Failed to check existing user by username", zap.Error(err))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to check existing user")
+		return nil, nil, status.Error(codes.Internal, "failed to check existing user")
 	}
 	if existingUser != nil {
 		s.logger.Warn("User with username already exists", zap.String("username", req.Username))
-		return nil, "", status.Error(codes.AlreadyExists, "user with this username already exists")
+		return nil, nil, status.Error(codes.AlreadyExists, "user with this username already exists")
 	}
 
 	// Hash password
@@ -340,7 +569,7 @@ func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*mode
 	if err != nil {
 		s.logger.Error("Failed to hash password", zap.Error(err))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to process password")
+		return nil, nil, status.Error(codes.Internal, "failed to process password")
 	}
 
 	// Create user
@@ -352,18 +581,22 @@ func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*mode
 	}
 
 	createdUser, err := s.repo.Create(ctx, user)
+	if dupErr := duplicateUserError(err); dupErr != nil {
+		s.logger.Warn("User registered concurrently", zap.Error(err), zap.String("email", req.Email))
+		return nil, nil, dupErr
+	}
 	if err != nil {
 		s.logger.Error("Failed to create user in repository", zap.Error(err))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to create user")
+		return nil, nil, status.Error(codes.Internal, "failed to create user")
 	}
 
-	// Generate JWT token
-	token, err := s.jwtManager.Generate(createdUser)
+	// Generate access and refresh tokens
+	tokens, err := s.jwtManager.GenerateTokenPair(createdUser)
 	if err != nil {
 		s.logger.Error("Failed to generate token", zap.Error(err))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to generate token")
+		return nil, nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
 	// Clear password before returning
@@ -371,42 +604,97 @@ func (s *userService) Register(ctx context.Context, req *RegisterRequest) (*mode
 
 	s.logger.Info("User registered successfully", zap.String("id", createdUser.ID))
 	span.SetAttributes(attribute.String("user.id", createdUser.ID))
-	return createdUser, token, nil
+	return createdUser, tokens, nil
+}
+
+// registerRetry answers a repeated registration with the account the first
+// attempt created. A retry proves the password just like Login does, so it
+// goes through the same login throttle.
+func (s *userService) registerRetry(ctx context.Context, user *model.User, password string) (*model.User, *auth.TokenPair, error) {
+	span := trace.SpanFromContext(ctx)
+
+	lockedUntil, err := s.config.LoginThrottle.LockedUntil(ctx, user.Email)
+	if err != nil {
+		s.logger.Warn("Failed to check login throttle", zap.Error(err), zap.String("email", user.Email))
+	} else if !lockedUntil.IsZero() {
+		s.logger.Warn("Registration retry on locked account", zap.String("email", user.Email), zap.Time("locked_until", lockedUntil))
+		return nil, nil, status.Error(codes.ResourceExhausted, "account temporarily locked")
+	}
+
+	if !hash.CheckPasswordHash(password, user.Password) {
+		s.logger.Warn("User with email already exists", zap.String("email", user.Email))
+		s.recordLoginFailure(ctx, user.Email)
+		return nil, nil, status.Error(codes.AlreadyExists, "user with this email already exists")
+	}
+
+	if err := s.config.LoginThrottle.Reset(ctx, user.Email); err != nil {
+		s.logger.Warn("Failed to reset login throttle", zap.Error(err), zap.String("email", user.Email))
+	}
+
+	tokens, err := s.jwtManager.GenerateTokenPair(user)
+	if err != nil {
+		s.logger.Error("Failed to generate token", zap.Error(err))
+		span.RecordError(err)
+		return nil, nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	user.Password = ""
+
+	s.logger.Info("Repeated registration matched existing user", zap.String("id", user.ID))
+	span.SetAttributes(attribute.String("user.id", user.ID))
+	return user, tokens, nil
 }
 
-func (s *userService) Login(ctx context.Context, email, password string) (*model.User, string, error) {
+func (s *userService) Login(ctx context.Context, email, password string) (*model.User, *auth.TokenPair, error) {
 	ctx, span := s.tracer.Start(ctx, "UserService.Login")
 	defer span.End()
 
+	email = normalizeEmail(email)
+
 	span.SetAttributes(attribute.String("user.email", email))
 
 	s.logger.Debug("User login attempt", zap.String("email", email))
 
+	// A throttle we cannot reach must not lock everyone out
+	lockedUntil, err := s.config.LoginThrottle.LockedUntil(ctx, email)
+	if err != nil {
+		s.logger.Warn("Failed to check login throttle", zap.Error(err), zap.String("email", email))
+	} else if !lockedUntil.IsZero() {
+		s.logger.Warn("Login attempt on locked account", zap.String("email", email), zap.Time("locked_until", lockedUntil))
+		return nil, nil, status.Error(codes.ResourceExhausted, "account temporarily locked")
+	}
+
 	// Find user by email
 	user, err := s.repo.FindByEmail(ctx, email)
 	if err != nil {
 		s.logger.Error("Failed to find user by email", zap.Error(err), zap.String("email", email))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to find user")
+		return nil, nil, status.Error(codes.Internal, "failed to find user")
 	}
 
 	if user == nil {
 		s.logger.Warn("User not found for login", zap.String("email", email))
-		return nil, "", status.Error(codes.NotFound, "invalid credentials")
+		s.recordLoginFailure(ctx, email)
+		return nil, nil, status.Error(codes.NotFound, "invalid credentials")
 	}
 
 	// Check password
 	if !hash.CheckPasswordHash(password, user.Password) {
 		s.logger.Warn("Invalid password attempt", zap.String("email", email))
-		return nil, "", status.Error(codes.Unauthenticated, "invalid credentials")
+		s.recordLoginFailure(ctx, email)
+		return nil, nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
-	// Generate JWT token
-	token, err := s.jwtManager.Generate(user)
+	if err := s.config.LoginThrottle.Reset(ctx, email); err != nil {
+		s.logger.Warn("Failed to reset login throttle", zap.Error(err), zap.String("email", email))
+	}
+
+	// Generate access and refresh tokens
+	tokens, err := s.jwtManager.GenerateTokenPair(user)
 	if err != nil {
 		s.logger.Error("Failed to generate token", zap.Error(err))
 		span.RecordError(err)
-		return nil, "", status.Error(codes.Internal, "failed to generate token")
+		return nil, nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
 	// Clear password before returning
@@ -414,7 +702,201 @@ func (s *userService) Login(ctx context.Context, email, password string) (*model
 
 	s.logger.Info("User logged in successfully", zap.String("id", user.ID))
 	span.SetAttributes(attribute.String("user.id", user.ID))
-	return user, token, nil
+	return user, tokens, nil
+}
+
+// BatchGetPublicProfiles returns the public profiles of the users with the
+// given IDs, in request order and without duplicates. Unknown and
+// malformed IDs are left out rather than failing the batch.
+func (s *userService) BatchGetPublicProfiles(ctx context.Context, ids []string) ([]*PublicProfile, error) {
+	ctx, span := s.tracer.Start(ctx, "UserService.BatchGetPublicProfiles")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("ids.count", len(ids)))
+
+	s.logger.Debug("Getting public profiles", zap.Int("count", len(ids)))
+
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one id is required")
+	}
+	if len(ids) > s.config.MaxBatchProfiles {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d profiles may be fetched at once", s.config.MaxBatchProfiles)
+	}
+
+	// The id column is a uuid, so anything else would fail the whole query
+	seen := make(map[string]bool, len(ids))
+	var lookup []string
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, err := uuid.Parse(id); err == nil {
+			lookup = append(lookup, id)
+		}
+	}
+
+	byID := make(map[string]*model.User, len(lookup))
+	if len(lookup) > 0 {
+		users, err := s.repo.FindByIDs(ctx, lookup)
+		if err != nil {
+			s.logger.Error("Failed to find users by IDs", zap.Error(err))
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to get profiles")
+		}
+		for _, user := range users {
+			byID[user.ID] = user
+		}
+	}
+
+	profiles := make([]*PublicProfile, 0, len(byID))
+	for _, id := range lookup {
+		if user, ok := byID[id]; ok {
+			profiles = append(profiles, &PublicProfile{
+				ID:        user.ID,
+				Username:  user.Username,
+				FullName:  user.FullName,
+				AvatarURL: user.AvatarURL,
+			})
+		}
+	}
+
+	s.logger.Debug("Public profiles retrieved", zap.Int("requested", len(ids)), zap.Int("found", len(profiles)))
+	return profiles, nil
+}
+
+// checkPasswordStrength rejects a weak password with InvalidArgument when
+// strong passwords are required.
+func (s *userService) checkPasswordStrength(password string) error {
+	if !s.config.RequireStrongPasswords {
+		return nil
+	}
+	if err := hash.ValidatePasswordStrength(password); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed login for email. Errors are only logged:
+// the caller already has a failure to report.
+func (s *userService) recordLoginFailure(ctx context.Context, email string) {
+	locked, err := s.config.LoginThrottle.RecordFailure(ctx, email)
+	if err != nil {
+		s.logger.Warn("Failed to record login failure", zap.Error(err), zap.String("email", email))
+		return
+	}
+	if locked {
+		s.logger.Warn("Account temporarily locked after failed logins", zap.String("email", email))
+	}
+}
+
+// RefreshToken exchanges a refresh token for a new access token, as long as
+// the user it was issued to still exists.
+func (s *userService) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	ctx, span := s.tracer.Start(ctx, "UserService.RefreshToken")
+	defer span.End()
+
+	s.logger.Debug("Refreshing access token")
+
+	claims, err := s.jwtManager.VerifyRefresh(refreshToken)
+	if err != nil {
+		s.logger.Warn("Invalid refresh token", zap.String("reason", auth.FailureReason(err)))
+		return "", status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	span.SetAttributes(attribute.String("user.id", claims.UserID))
+
+	if s.isRevoked(ctx, claims) {
+		s.logger.Warn("Revoked refresh token used", zap.String("user_id", claims.UserID))
+		return "", status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	user, err := s.findUserCached(ctx, claims.UserID)
+	if err != nil {
+		s.logger.Error("Failed to find user by ID", zap.Error(err), zap.String("user_id", claims.UserID))
+		span.RecordError(err)
+		return "", status.Error(codes.Internal, "failed to refresh token")
+	}
+
+	if user == nil {
+		s.logger.Warn("User not found for token refresh", zap.String("user_id", claims.UserID))
+		return "", status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	// Sign from the stored user, not the refresh claims, so a role change
+	// reaches the next access token
+	accessToken, err := s.jwtManager.Generate(user)
+	if err != nil {
+		s.logger.Error("Failed to generate token", zap.Error(err))
+		span.RecordError(err)
+		return "", status.Error(codes.Internal, "failed to generate token")
+	}
+
+	s.logger.Debug("Access token refreshed", zap.String("user_id", claims.UserID))
+	return accessToken, nil
+}
+
+// Logout revokes an access token and, when given, the refresh token issued
+// with it. Both stay on the denylist until they would have expired.
+func (s *userService) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	ctx, span := s.tracer.Start(ctx, "UserService.Logout")
+	defer span.End()
+
+	if !s.config.Denylist.Enabled() {
+		return status.Error(codes.FailedPrecondition, "token revocation is not enabled")
+	}
+
+	claims, err := s.jwtManager.Verify(accessToken)
+	if err != nil {
+		s.logger.Warn("Invalid token on logout", zap.String("reason", auth.FailureReason(err)))
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	span.SetAttributes(attribute.String("user.id", claims.UserID))
+
+	revoke := []*auth.Claims{claims}
+	if refreshToken != "" {
+		refreshClaims, err := s.jwtManager.VerifyRefresh(refreshToken)
+		if err != nil || refreshClaims.UserID != claims.UserID {
+			s.logger.Warn("Invalid refresh token on logout", zap.String("user_id", claims.UserID))
+			return status.Error(codes.InvalidArgument, "invalid refresh token")
+		}
+		revoke = append(revoke, refreshClaims)
+	}
+
+	for _, c := range revoke {
+		if c.ID == "" || c.ExpiresAt == nil {
+			return status.Error(codes.InvalidArgument, "token was issued without an ID and cannot be revoked")
+		}
+		// A zero TTL would keep the entry forever
+		ttl := time.Until(c.ExpiresAt.Time)
+		if ttl <= 0 {
+			continue
+		}
+		if err := s.config.Denylist.Revoke(ctx, c.ID, ttl); err != nil {
+			s.logger.Error("Failed to revoke token", zap.Error(err), zap.String("user_id", claims.UserID))
+			span.RecordError(err)
+			return status.Error(codes.Internal, "failed to revoke token")
+		}
+	}
+
+	s.logger.Info("User logged out", zap.String("user_id", claims.UserID), zap.Int("revoked", len(revoke)))
+	return nil
+}
+
+// isRevoked reports whether the token behind claims was revoked. A denylist
+// failure is logged and the token let through, as with other cache errors.
+func (s *userService) isRevoked(ctx context.Context, claims *auth.Claims) bool {
+	if claims.ID == "" {
+		return false
+	}
+
+	revoked, err := s.config.Denylist.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		s.logger.Warn("Failed to check token denylist", zap.Error(err), zap.String("user_id", claims.UserID))
+		return false
+	}
+	return revoked
 }
 
 func (s *userService) ValidateToken(ctx context.Context, token string) (*model.User, error) {
@@ -429,8 +911,13 @@ func (s *userService) ValidateToken(ctx context.Context, token string) (*model.U
 		return nil, status.Error(codes.Unauthenticated, "invalid token")
 	}
 
-	// Get user from database
-	user, err := s.repo.FindByID(ctx, claims.UserID)
+	if s.isRevoked(ctx, claims) {
+		s.logger.Warn("Revoked token used", zap.String("user_id", claims.UserID))
+		return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+
+	// Get user from cache or database
+	user, err := s.findUserCached(ctx, claims.UserID)
 	if err != nil {
 		s.logger.Error("Failed to find user by ID", zap.Error(err), zap.String("user_id", claims.UserID))
 		span.RecordError(err)
@@ -442,10 +929,223 @@ func (s *userService) ValidateToken(ctx context.Context, token string) (*model.U
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
-	// Clear password before returning
-	user.Password = ""
-
 	s.logger.Debug("Token validated successfully", zap.String("user_id", user.ID))
 	span.SetAttributes(attribute.String("user.id", user.ID))
 	return user, nil
-}
\ No newline at end of file
+}
+
+func (s *userService) BatchValidateTokens(ctx context.Context, tokens []string) ([]*ValidationResult, error) {
+	ctx, span := s.tracer.Start(ctx, "UserService.BatchValidateTokens")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("tokens.count", len(tokens)))
+
+	s.logger.Debug("Validating token batch", zap.Int("count", len(tokens)))
+
+	if len(tokens) > s.config.MaxBatchTokens {
+		s.logger.Warn("Token batch too large",
+			zap.Int("count", len(tokens)),
+			zap.Int("max", s.config.MaxBatchTokens),
+		)
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tokens may be validated at once", s.config.MaxBatchTokens)
+	}
+
+	// Several tokens frequently belong to the same user, so look each user
+	// up only once.
+	users := make(map[string]*model.User)
+	results := make([]*ValidationResult, len(tokens))
+	for i, token := range tokens {
+		claims, err := s.jwtManager.Verify(token)
+		if err != nil {
+			results[i] = &ValidationResult{Reason: auth.FailureReason(err)}
+			continue
+		}
+
+		if s.isRevoked(ctx, claims) {
+			results[i] = &ValidationResult{Reason: ReasonRevoked}
+			continue
+		}
+
+		user, seen := users[claims.UserID]
+		if !seen {
+			user, err = s.findUserCached(ctx, claims.UserID)
+			if err != nil {
+				s.logger.Error("Failed to find user by ID", zap.Error(err), zap.String("user_id", claims.UserID))
+				span.RecordError(err)
+				return nil, status.Error(codes.Internal, "failed to validate user")
+			}
+			users[claims.UserID] = user
+		}
+
+		if user == nil {
+			results[i] = &ValidationResult{Reason: ReasonUserNotFound}
+			continue
+		}
+
+		results[i] = &ValidationResult{Valid: true, User: user}
+	}
+
+	s.logger.Debug("Token batch validated", zap.Int("count", len(tokens)))
+	return results, nil
+}
+
+// RequestPasswordReset issues a reset token for the user with email and hands
+// it to the reset notifier. Unknown emails succeed silently so the call
+// cannot be used to probe for accounts.
+func (s *userService) RequestPasswordReset(ctx context.Context, email string) error {
+	ctx, span := s.tracer.Start(ctx, "UserService.RequestPasswordReset")
+	defer span.End()
+
+	email = normalizeEmail(email)
+
+	span.SetAttributes(attribute.String("user.email", email))
+
+	s.logger.Debug("Password reset requested", zap.String("email", email))
+
+	user, err := s.repo.FindByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("Failed to find user by email", zap.Error(err), zap.String("email", email))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to request password reset")
+	}
+
+	if user == nil {
+		s.logger.Info("Password reset requested for unknown email", zap.String("email", email))
+		return nil
+	}
+
+	token, err := s.jwtManager.GeneratePasswordReset(user, s.config.PasswordResetTTL)
+	if err != nil {
+		s.logger.Error("Failed to generate reset token", zap.Error(err))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to request password reset")
+	}
+
+	if err := s.config.ResetNotifier.SendPasswordReset(ctx, user, token); err != nil {
+		s.logger.Error("Failed to send password reset", zap.Error(err), zap.String("user_id", user.ID))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to send password reset")
+	}
+
+	s.logger.Info("Password reset token issued", zap.String("user_id", user.ID))
+	return nil
+}
+
+// ResetPassword sets a new password for the user a reset token was issued
+// to. Each token works once: a successful reset spends every reset token
+// issued up to that moment.
+func (s *userService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	ctx, span := s.tracer.Start(ctx, "UserService.ResetPassword")
+	defer span.End()
+
+	s.logger.Debug("Password reset attempt")
+
+	if newPassword == "" {
+		return status.Error(codes.InvalidArgument, "new password is required")
+	}
+	if err := s.checkPasswordStrength(newPassword); err != nil {
+		return err
+	}
+
+	claims, err := s.jwtManager.VerifyPasswordReset(token)
+	if err != nil || claims.IssuedAt == nil {
+		s.logger.Warn("Invalid password reset token", zap.String("reason", auth.FailureReason(err)))
+		return status.Error(codes.InvalidArgument, "invalid or expired reset token")
+	}
+
+	span.SetAttributes(attribute.String("user.id", claims.UserID))
+
+	hashedPassword, err := hash.HashPassword(newPassword)
+	if err != nil {
+		s.logger.Error("Failed to hash password", zap.Error(err))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to process password")
+	}
+
+	applied, err := s.repo.ResetPassword(ctx, claims.UserID, hashedPassword, claims.IssuedAt.Time.UTC(), time.Now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to reset password", zap.Error(err), zap.String("user_id", claims.UserID))
+		span.RecordError(err)
+		return status.Error(codes.Internal, "failed to reset password")
+	}
+
+	if !applied {
+		// Either the user is gone or the token was already spent
+		s.logger.Warn("Password reset token already used", zap.String("user_id", claims.UserID))
+		return status.Error(codes.InvalidArgument, "invalid or expired reset token")
+	}
+
+	s.invalidateUser(ctx, claims.UserID)
+
+	s.logger.Info("Password reset successfully", zap.String("user_id", claims.UserID))
+	return nil
+}
+
+// findUserCached reads a user through the cache. The returned user never
+// carries the password hash, so it must not be used as the base of an
+// update. Cache failures fall back to the database.
+func (s *userService) findUserCached(ctx context.Context, id string) (*model.User, error) {
+	cached, err := s.cache.GetUser(ctx, id)
+	if err != nil {
+		s.logger.Warn("Failed to get user from cache", zap.Error(err), zap.String("id", id))
+	} else if cached != nil {
+		return cached, nil
+	}
+
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	// Clear password before caching and returning
+	user.Password = ""
+
+	if err := s.cache.SetUser(ctx, user); err != nil {
+		s.logger.Warn("Failed to cache user", zap.Error(err), zap.String("id", id))
+	}
+
+	return user, nil
+}
+
+func (s *userService) invalidateUser(ctx context.Context, id string) {
+	if err := s.cache.DeleteUser(ctx, id); err != nil {
+		s.logger.Warn("Failed to invalidate cached user", zap.Error(err), zap.String("id", id))
+	}
+}
+
+// maxAvatarURLLength matches the avatar_url column.
+const maxAvatarURLLength = 2048
+
+// validateAvatarURL accepts an empty URL, which clears the avatar, or an
+// absolute http or https URL.
+func validateAvatarURL(raw string) error {
+	if raw == "" {
+		return nil
+	}
+	if len(raw) > maxAvatarURLLength {
+		return fmt.Errorf("avatar_url must be at most %d characters", maxAvatarURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return errors.New("avatar_url must be an http or https URL")
+	}
+	return nil
+}
+
+// normalizeEmail trims and lowercases email so addresses differing only in
+// case name the same account.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// duplicateUserError maps the repository's duplicate sentinels to
+// AlreadyExists. It returns nil for any other error.
+func duplicateUserError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrDuplicateEmail):
+		return status.Error(codes.AlreadyExists, "user with this email already exists")
+	case errors.Is(err, repository.ErrDuplicateUsername):
+		return status.Error(codes.AlreadyExists, "user with this username already exists")
+	}
+	return nil
+}
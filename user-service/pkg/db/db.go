@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -50,6 +51,78 @@ func NewPostgresConnection(cfg Config) (*gorm.DB, error) {
 	return db, nil
 }
 
+// RetryConfig controls how long startup waits for the database. Each wait
+// doubles the previous one, starting at Interval.
+type RetryConfig struct {
+	// Attempts is the most connection attempts made; below 1 means one.
+	Attempts int
+	Interval time.Duration
+	// MaxWait gives up once this much time has passed since the first
+	// attempt, even with attempts left. Zero means no limit.
+	MaxWait time.Duration
+}
+
+// NewPostgresConnectionWithRetry is NewPostgresConnection retried per retry,
+// for when the database may still be starting.
+func NewPostgresConnectionWithRetry(ctx context.Context, cfg Config, retry RetryConfig) (*gorm.DB, error) {
+	var db *gorm.DB
+	err := Retry(ctx, retry, func(ctx context.Context) error {
+		var err error
+		db, err = NewPostgresConnection(cfg)
+		return err
+	})
+	return db, err
+}
+
+// Retry calls attempt until it succeeds, the attempts or MaxWait run out,
+// or ctx is done, and returns the last error.
+func Retry(ctx context.Context, retry RetryConfig, attempt func(ctx context.Context) error) error {
+	deadline := time.Time{}
+	if retry.MaxWait > 0 {
+		deadline = time.Now().Add(retry.MaxWait)
+	}
+
+	wait := retry.Interval
+	for i := 1; ; i++ {
+		err := attempt(ctx)
+		if err == nil {
+			return nil
+		}
+		if i >= retry.Attempts {
+			return err
+		}
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return err
+			}
+			wait = min(wait, remaining)
+		}
+
+		zap.L().Warn("Database not ready, retrying",
+			zap.Error(err),
+			zap.Int("attempt", i),
+			zap.Duration("wait", wait),
+		)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// Ping checks that the database is reachable.
+func Ping(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 func Migrate(db *gorm.DB, models ...any) error {
 	// Create UUID extension if not exists
 	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\"").Error; err != nil {
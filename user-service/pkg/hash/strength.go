@@ -0,0 +1,58 @@
+package hash
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+	"unicode"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords holds the lowercased entries of common_passwords.txt.
+var commonPasswords = func() map[string]bool {
+	set := make(map[string]bool)
+	for _, line := range strings.Split(commonPasswordList, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			set[strings.ToLower(line)] = true
+		}
+	}
+	return set
+}()
+
+var (
+	ErrPasswordNoUpper   = errors.New("password must contain an uppercase letter")
+	ErrPasswordNoLower   = errors.New("password must contain a lowercase letter")
+	ErrPasswordNoDigit   = errors.New("password must contain a digit")
+	ErrPasswordTooCommon = errors.New("password is too common")
+)
+
+// ValidatePasswordStrength requires password to mix upper and lower case
+// letters and digits, and rejects well-known passwords regardless of case.
+// Length is left to the callers, which already enforce a minimum.
+func ValidatePasswordStrength(password string) error {
+	var upper, lower, digit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+
+	switch {
+	case !upper:
+		return ErrPasswordNoUpper
+	case !lower:
+		return ErrPasswordNoLower
+	case !digit:
+		return ErrPasswordNoDigit
+	case commonPasswords[strings.ToLower(password)]:
+		return ErrPasswordTooCommon
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	Host         string
+	Port         int
+	Password     string
+	DB           int
+	PoolSize     int
+	MinIdleConns int
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	CacheTTL     time.Duration
+}
+
+type RedisClient struct {
+	client   *redis.Client
+	logger   *zap.Logger
+	cacheTTL time.Duration
+}
+
+func NewRedisClient(cfg Config) (*RedisClient, error) {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:         addr,
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		PoolSize:     cfg.PoolSize,
+		MinIdleConns: cfg.MinIdleConns,
+		DialTimeout:  cfg.DialTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+	})
+
+	// Test connection
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	logger := zap.L().Named("redis")
+	logger.Info("Successfully connected to Redis",
+		zap.String("address", addr),
+		zap.Int("db", cfg.DB),
+	)
+
+	return &RedisClient{
+		client:   rdb,
+		logger:   logger,
+		cacheTTL: cfg.CacheTTL,
+	}, nil
+}
+
+func (r *RedisClient) Set(ctx context.Context, key string, value any) error {
+	r.logger.Debug("Setting cache key", zap.String("key", key))
+
+	if err := r.client.Set(ctx, key, value, r.cacheTTL).Err(); err != nil {
+		r.logger.Error("Failed to set cache key", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+// SetWithTTL stores value under key for ttl instead of the cache TTL.
+func (r *RedisClient) SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) error {
+	r.logger.Debug("Setting key", zap.String("key", key), zap.Duration("ttl", ttl))
+
+	if err := r.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		r.logger.Error("Failed to set key", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+func (r *RedisClient) Exists(ctx context.Context, key string) (bool, error) {
+	count, err := r.client.Exists(ctx, key).Result()
+	if err != nil {
+		r.logger.Error("Failed to check key", zap.Error(err), zap.String("key", key))
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
+	r.logger.Debug("Getting cache key", zap.String("key", key))
+
+	value, err := r.client.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			r.logger.Debug("Cache miss", zap.String("key", key))
+			return "", nil
+		}
+		r.logger.Error("Failed to get cache key", zap.Error(err), zap.String("key", key))
+		return "", err
+	}
+
+	r.logger.Debug("Cache hit", zap.String("key", key))
+	return value, nil
+}
+
+// IncrWithTTL increments the counter at key and, when this created it,
+// expires it after ttl.
+func (r *RedisClient) IncrWithTTL(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	count, err := r.client.Incr(ctx, key).Result()
+	if err != nil {
+		r.logger.Error("Failed to increment cache key", zap.Error(err), zap.String("key", key))
+		return 0, err
+	}
+
+	if count == 1 {
+		if err := r.client.Expire(ctx, key, ttl).Err(); err != nil {
+			r.logger.Error("Failed to expire cache key", zap.Error(err), zap.String("key", key))
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+func (r *RedisClient) Delete(ctx context.Context, key string) error {
+	r.logger.Debug("Deleting cache key", zap.String("key", key))
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		r.logger.Error("Failed to delete cache key", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	return nil
+}
+
+// Ping checks that Redis is reachable.
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
+func (r *RedisClient) Close() error {
+	r.logger.Info("Closing Redis connection")
+	return r.client.Close()
+}
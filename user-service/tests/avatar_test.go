@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/handler"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	pb "github.com/amirhasanpour/task-manager/user-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateUser_AvatarURL(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+	userHandler := handler.NewUserHandler(userService)
+
+	user, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	resp, err := userHandler.UpdateUser(ctx, &pb.UpdateUserRequest{Id: user.ID, AvatarUrl: "https://cdn.example.com/avatars/test.png"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/avatars/test.png", resp.User.AvatarUrl)
+
+	profiles, err := userService.BatchGetPublicProfiles(ctx, []string{user.ID})
+	require.NoError(t, err)
+	require.Len(t, profiles, 1)
+	assert.Equal(t, "https://cdn.example.com/avatars/test.png", profiles[0].AvatarURL)
+
+	// Clearing takes a mask, since an empty field otherwise means "unchanged"
+	resp, err = userHandler.UpdateUser(ctx, &pb.UpdateUserRequest{
+		Id:         user.ID,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"avatar_url"}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.User.AvatarUrl)
+}
+
+func TestUpdateUser_RejectsInvalidAvatarURL(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+
+	user, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	for _, avatarURL := range []string{
+		"javascript:alert(1)",
+		"ftp://example.com/avatar.png",
+		"/avatars/test.png",
+		"https://",
+		"not a url",
+	} {
+		_, err := userService.UpdateUser(ctx, &service.UpdateUserRequest{ID: user.ID, AvatarURL: &avatarURL})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), avatarURL)
+	}
+
+	stored, err := userService.GetUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.AvatarURL)
+}
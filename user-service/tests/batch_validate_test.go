@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchValidateTokens(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, "batch_validate")
+	userService, repo := newUserServiceOn(db, service.DefaultConfig()), repository.NewUserRepository(db)
+
+	user, err := repo.Create(ctx, &model.User{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "hashedpassword",
+	})
+	require.NoError(t, err)
+
+	validToken, err := auth.NewJWTManager(testJWTSecret, 1).Generate(user)
+	require.NoError(t, err)
+	expiredToken, err := auth.NewJWTManager(testJWTSecret, -1).Generate(user)
+	require.NoError(t, err)
+	foreignToken, err := auth.NewJWTManager("other-secret", 1).Generate(user)
+	require.NoError(t, err)
+	orphanToken, err := auth.NewJWTManager(testJWTSecret, 1).Generate(&model.User{ID: "missing-user"})
+	require.NoError(t, err)
+
+	results, err := userService.BatchValidateTokens(ctx, []string{
+		validToken,
+		expiredToken,
+		"not-a-jwt",
+		foreignToken,
+		orphanToken,
+		validToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 6)
+
+	assert.True(t, results[0].Valid)
+	assert.Empty(t, results[0].Reason)
+	if assert.NotNil(t, results[0].User) {
+		assert.Equal(t, user.ID, results[0].User.ID)
+		assert.Empty(t, results[0].User.Password)
+	}
+
+	assert.False(t, results[1].Valid)
+	assert.Equal(t, auth.ReasonExpired, results[1].Reason)
+	assert.Nil(t, results[1].User)
+
+	assert.False(t, results[2].Valid)
+	assert.Equal(t, auth.ReasonMalformed, results[2].Reason)
+
+	assert.False(t, results[3].Valid)
+	assert.Equal(t, auth.ReasonInvalid, results[3].Reason)
+
+	assert.False(t, results[4].Valid)
+	assert.Equal(t, service.ReasonUserNotFound, results[4].Reason)
+
+	assert.True(t, results[5].Valid)
+}
+
+func TestBatchValidateTokens_RejectsOversizedBatch(t *testing.T) {
+	userService := newTestUserService(t, service.Config{MaxBatchTokens: 2})
+
+	_, err := userService.BatchValidateTokens(context.Background(), []string{"a", "b", "c"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// newDuplicateTestDB opens an empty users table whose inserts fail the way
+// Postgres fails them when a concurrent insert has just taken the constraint,
+// so the service's existence checks pass but the insert does not.
+func newDuplicateTestDB(t *testing.T, constraint string) *gorm.DB {
+	db := newTestDB(t, "duplicate_"+constraint)
+	require.NoError(t, db.Callback().Create().Before("gorm:create").Register("test:unique_violation", func(tx *gorm.DB) {
+		tx.AddError(&pgconn.PgError{Code: "23505", ConstraintName: constraint})
+	}))
+	return db
+}
+
+func TestUserRepositoryCreate_TranslatesUniqueViolation(t *testing.T) {
+	for constraint, want := range map[string]error{
+		"idx_users_email":    repository.ErrDuplicateEmail,
+		"idx_users_username": repository.ErrDuplicateUsername,
+	} {
+		repo := repository.NewUserRepository(newDuplicateTestDB(t, constraint))
+
+		_, err := repo.Create(context.Background(), &model.User{Username: "racer", Email: "racer@example.com", Password: "hash"})
+		assert.ErrorIs(t, err, want, constraint)
+	}
+}
+
+func TestRegister_ConcurrentDuplicateIsAlreadyExists(t *testing.T) {
+	for _, constraint := range []string{"idx_users_email", "idx_users_username"} {
+		repo := repository.NewUserRepository(newDuplicateTestDB(t, constraint))
+		userService := service.NewUserService(repo, auth.NewJWTManager(testJWTSecret, 1))
+
+		_, _, err := userService.Register(context.Background(), registerRequest("password123"))
+		assert.Equal(t, codes.AlreadyExists, status.Code(err), constraint)
+
+		_, err = userService.CreateUser(context.Background(), &service.CreateUserRequest{
+			Username: "racer",
+			Email:    "racer@example.com",
+			Password: "password123",
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err), constraint)
+	}
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegister_MixedCaseEmail(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+
+	user, _, err := userService.Register(ctx, &service.RegisterRequest{
+		Username: "mixedcase",
+		Email:    "  User@Example.COM ",
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", user.Email)
+
+	loggedIn, _, err := userService.Login(ctx, "user@example.com", "password123")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, loggedIn.ID)
+
+	_, _, err = userService.Login(ctx, "USER@EXAMPLE.com", "password123")
+	assert.NoError(t, err)
+
+	// The same address in another case is taken
+	_, _, err = userService.Register(ctx, &service.RegisterRequest{
+		Username: "someoneelse",
+		Email:    "user@EXAMPLE.com",
+		Password: "password123",
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestFindByEmail_MatchesStoredMixedCase(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewUserRepository(newTestDB(t, "email_case"))
+
+	// Stored before emails were normalized
+	legacy, err := repo.Create(ctx, &model.User{Username: "legacy", Email: "Legacy@Example.com", Password: "hash"})
+	require.NoError(t, err)
+
+	found, err := repo.FindByEmail(ctx, "legacy@example.com")
+	require.NoError(t, err)
+	if assert.NotNil(t, found) {
+		assert.Equal(t, legacy.ID, found.ID)
+	}
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFeedTokens(t *testing.T) {
+	ctx := context.Background()
+
+	db := newTestDB(t, "feed_tokens")
+	userService := newUserServiceOn(db, service.DefaultConfig())
+	feedTokenService := service.NewFeedTokenService(repository.NewFeedTokenRepository(db), repository.NewUserRepository(db))
+
+	user, _, err := userService.Register(ctx, &service.RegisterRequest{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "password",
+	})
+	require.NoError(t, err)
+
+	// Create
+	feedToken, token, err := feedTokenService.CreateFeedToken(ctx, user.ID, "Phone calendar")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.NotEqual(t, token, feedToken.TokenHash)
+	assert.Equal(t, "Phone calendar", feedToken.Name)
+	assert.Nil(t, feedToken.LastUsedAt)
+
+	// Use
+	found, err := feedTokenService.ValidateFeedToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, found.ID)
+	assert.Empty(t, found.Password)
+
+	tokens, err := feedTokenService.ListFeedTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, feedToken.ID, tokens[0].ID)
+	assert.NotNil(t, tokens[0].LastUsedAt)
+
+	// Feed tokens are not access tokens
+	_, err = userService.ValidateToken(ctx, token)
+	assert.Error(t, err)
+
+	// Another user cannot revoke it
+	err = feedTokenService.RevokeFeedToken(ctx, feedToken.ID, "00000000-0000-0000-0000-000000000000")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Revoke
+	require.NoError(t, feedTokenService.RevokeFeedToken(ctx, feedToken.ID, user.ID))
+
+	_, err = feedTokenService.ValidateFeedToken(ctx, token)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	tokens, err = feedTokenService.ListFeedTokens(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	err = feedTokenService.RevokeFeedToken(ctx, feedToken.ID, user.ID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateFeedToken_UnknownUser(t *testing.T) {
+	db := newTestDB(t, "feed_tokens_unknown")
+	feedTokenService := service.NewFeedTokenService(repository.NewFeedTokenRepository(db), repository.NewUserRepository(db))
+
+	_, _, err := feedTokenService.CreateFeedToken(context.Background(), "00000000-0000-0000-0000-000000000000", "")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = feedTokenService.ValidateFeedToken(context.Background(), "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestDeleteUser_RemovesFeedTokens(t *testing.T) {
+	ctx := context.Background()
+
+	db := newTestDB(t, "feed_tokens_delete_user")
+	feedTokenRepo := repository.NewFeedTokenRepository(db)
+	userService := newUserServiceOn(db, service.DefaultConfig())
+	feedTokenService := service.NewFeedTokenService(feedTokenRepo, repository.NewUserRepository(db))
+
+	user, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+	for _, name := range []string{"Phone", "Laptop"} {
+		_, _, err = feedTokenService.CreateFeedToken(ctx, user.ID, name)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, userService.DeleteUser(ctx, user.ID))
+
+	tokens, err := feedTokenRepo.ListByUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/interceptor"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestBuildChain_RejectsEnabledInterceptorMissingFromOrder(t *testing.T) {
+	noop := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	available := map[string]grpc.UnaryServerInterceptor{
+		"recovery": noop,
+		"logging":  noop,
+		"auth":     noop,
+	}
+
+	_, err := interceptor.BuildChain([]string{"recovery", "logging"}, available)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "auth")
+	}
+
+	// Switched off interceptors may be left out
+	available["auth"] = nil
+	chain, err := interceptor.BuildChain([]string{"recovery", "logging"}, available)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 2)
+
+	_, err = interceptor.BuildChain([]string{"recovery", "unknown"}, available)
+	assert.Error(t, err)
+}
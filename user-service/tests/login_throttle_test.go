@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/amirhasanpour/task-manager/user-service/internal/cache"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/amirhasanpour/task-manager/user-service/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeClock is a settable time source.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newLoginThrottleTestService(t *testing.T, clock *fakeClock) service.UserService {
+	return newThrottledTestService(t, clock, service.DefaultConfig())
+}
+
+func newThrottledTestService(t *testing.T, clock *fakeClock, config service.Config) service.UserService {
+	mr := miniredis.RunT(t)
+	port, err := strconv.Atoi(mr.Port())
+	require.NoError(t, err)
+
+	client, err := redis.NewRedisClient(redis.Config{Host: mr.Host(), Port: port})
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	config.LoginThrottle = cache.NewLoginThrottle(client, cache.LoginThrottleConfig{
+		MaxAttempts: 3,
+		Window:      15 * time.Minute,
+		Cooldown:    10 * time.Minute,
+		Now:         clock.Now,
+	})
+	userService := newTestUserService(t, config)
+
+	_, _, err = userService.Register(context.Background(), registerRequest("password123"))
+	require.NoError(t, err)
+	return userService
+}
+
+func TestLogin_LocksOutAfterRepeatedFailures(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	userService := newLoginThrottleTestService(t, clock)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := userService.Login(ctx, "test@example.com", "wrong-password")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	// Even the right password is refused while locked, whatever the case
+	_, _, err := userService.Login(ctx, "Test@Example.com", "password123")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "account temporarily locked")
+
+	clock.Advance(9 * time.Minute)
+	_, _, err = userService.Login(ctx, "test@example.com", "password123")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The lock lifts on its own after the cooldown
+	clock.Advance(time.Minute)
+	_, _, err = userService.Login(ctx, "test@example.com", "password123")
+	assert.NoError(t, err)
+}
+
+func TestLogin_SuccessClearsFailures(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	userService := newLoginThrottleTestService(t, clock)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := userService.Login(ctx, "test@example.com", "wrong-password")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	_, _, err := userService.Login(ctx, "test@example.com", "password123")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = userService.Login(ctx, "test@example.com", "wrong-password")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	_, _, err = userService.Login(ctx, "test@example.com", "password123")
+	assert.NoError(t, err)
+}
+
+func TestLogin_FailuresOutsideWindowDoNotLock(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	userService := newLoginThrottleTestService(t, clock)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := userService.Login(ctx, "test@example.com", "wrong-password")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	clock.Advance(15 * time.Minute)
+	_, _, err := userService.Login(ctx, "test@example.com", "wrong-password")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, _, err = userService.Login(ctx, "test@example.com", "password123")
+	assert.NoError(t, err)
+}
+
+func TestLogin_UnknownEmailCountsTowardsLockout(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	userService := newLoginThrottleTestService(t, clock)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := userService.Login(ctx, "nobody@example.com", "password123")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	}
+
+	_, _, err := userService.Login(ctx, "nobody@example.com", "password123")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Other accounts are unaffected
+	_, _, err = userService.Login(ctx, "test@example.com", "password123")
+	assert.NoError(t, err)
+}
+
+func TestRegister_IdempotentRetryRespectsLockout(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	config := service.DefaultConfig()
+	config.IdempotentRegister = true
+	userService := newThrottledTestService(t, clock, config)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := userService.Login(ctx, "test@example.com", "wrong-password")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	// The right password gets no tokens through Register while locked
+	_, tokens, err := userService.Register(ctx, registerRequest("password123"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Nil(t, tokens)
+}
+
+func TestRegister_IdempotentRetryFailuresCountTowardsLockout(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	config := service.DefaultConfig()
+	config.IdempotentRegister = true
+	userService := newThrottledTestService(t, clock, config)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := userService.Register(ctx, registerRequest("wrong-password"))
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	}
+
+	_, _, err := userService.Login(ctx, "test@example.com", "password123")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryDenylist keeps revoked token IDs in memory.
+type memoryDenylist struct {
+	revoked map[string]time.Duration
+}
+
+func (d *memoryDenylist) Revoke(ctx context.Context, jti string, ttl time.Duration) error {
+	d.revoked[jti] = ttl
+	return nil
+}
+
+func (d *memoryDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	_, ok := d.revoked[jti]
+	return ok, nil
+}
+
+func (d *memoryDenylist) Enabled() bool {
+	return true
+}
+
+func TestLogout_RevokesOnlyThatToken(t *testing.T) {
+	ctx := context.Background()
+	denylist := &memoryDenylist{revoked: make(map[string]time.Duration)}
+	config := service.DefaultConfig()
+	config.Denylist = denylist
+	userService := newTestUserService(t, config)
+
+	_, session, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+	_, otherSession, err := userService.Login(ctx, "test@example.com", "password123")
+	require.NoError(t, err)
+
+	require.NoError(t, userService.Logout(ctx, session.AccessToken, session.RefreshToken))
+
+	// Entries expire with the tokens they block
+	assert.Len(t, denylist.revoked, 2)
+	for _, ttl := range denylist.revoked {
+		assert.Greater(t, ttl, time.Duration(0))
+	}
+
+	_, err = userService.ValidateToken(ctx, session.AccessToken)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = userService.RefreshToken(ctx, session.RefreshToken)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	results, err := userService.BatchValidateTokens(ctx, []string{session.AccessToken, otherSession.AccessToken})
+	require.NoError(t, err)
+	assert.Equal(t, service.ReasonRevoked, results[0].Reason)
+	assert.True(t, results[1].Valid)
+
+	_, err = userService.ValidateToken(ctx, otherSession.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestLogout_RejectsForeignRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	config := service.DefaultConfig()
+	config.Denylist = &memoryDenylist{revoked: make(map[string]time.Duration)}
+	userService := newTestUserService(t, config)
+
+	_, session, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+	other := registerRequest("password123")
+	other.Username = "someoneelse"
+	other.Email = "other@example.com"
+	_, otherSession, err := userService.Register(ctx, other)
+	require.NoError(t, err)
+
+	err = userService.Logout(ctx, session.AccessToken, otherSession.RefreshToken)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestLogout_RequiresDenylist(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+
+	_, session, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	err = userService.Logout(ctx, session.AccessToken, "")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
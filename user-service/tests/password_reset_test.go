@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// capturingNotifier records the last reset token instead of sending it.
+type capturingNotifier struct {
+	user  *model.User
+	token string
+}
+
+func (n *capturingNotifier) SendPasswordReset(ctx context.Context, user *model.User, token string) error {
+	n.user = user
+	n.token = token
+	return nil
+}
+
+func newResetTestService(t *testing.T) (service.UserService, *capturingNotifier, *model.User) {
+	notifier := &capturingNotifier{}
+	config := service.DefaultConfig()
+	config.ResetNotifier = notifier
+
+	userService := newTestUserService(t, config)
+
+	user, _, err := userService.Register(context.Background(), &service.RegisterRequest{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "old-password",
+	})
+	require.NoError(t, err)
+
+	return userService, notifier, user
+}
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+	userService, notifier, user := newResetTestService(t)
+
+	require.NoError(t, userService.RequestPasswordReset(ctx, "test@example.com"))
+	require.NotEmpty(t, notifier.token)
+	assert.Equal(t, user.ID, notifier.user.ID)
+
+	require.NoError(t, userService.ResetPassword(ctx, notifier.token, "new-password"))
+
+	_, _, err := userService.Login(ctx, "test@example.com", "old-password")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, _, err = userService.Login(ctx, "test@example.com", "new-password")
+	assert.NoError(t, err)
+}
+
+func TestResetPassword_TokenIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	userService, notifier, _ := newResetTestService(t)
+
+	require.NoError(t, userService.RequestPasswordReset(ctx, "test@example.com"))
+	require.NoError(t, userService.ResetPassword(ctx, notifier.token, "new-password"))
+
+	err := userService.ResetPassword(ctx, notifier.token, "another-password")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, _, err = userService.Login(ctx, "test@example.com", "new-password")
+	assert.NoError(t, err)
+}
+
+func TestResetPassword_ExpiredToken(t *testing.T) {
+	userService, _, user := newResetTestService(t)
+
+	token, err := auth.NewJWTManager(testJWTSecret, 1).GeneratePasswordReset(user, -time.Minute)
+	require.NoError(t, err)
+
+	err = userService.ResetPassword(context.Background(), token, "new-password")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResetPassword_TokenTypesDoNotMix(t *testing.T) {
+	ctx := context.Background()
+	userService, notifier, user := newResetTestService(t)
+
+	accessToken, err := auth.NewJWTManager(testJWTSecret, 1).Generate(user)
+	require.NoError(t, err)
+
+	err = userService.ResetPassword(ctx, accessToken, "new-password")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	require.NoError(t, userService.RequestPasswordReset(ctx, "test@example.com"))
+	_, err = userService.ValidateToken(ctx, notifier.token)
+	assert.Error(t, err)
+}
+
+func TestRequestPasswordReset_UnknownEmail(t *testing.T) {
+	userService, notifier, _ := newResetTestService(t)
+
+	err := userService.RequestPasswordReset(context.Background(), "nobody@example.com")
+
+	assert.NoError(t, err)
+	assert.Empty(t, notifier.token)
+}
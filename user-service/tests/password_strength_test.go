@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/amirhasanpour/task-manager/user-service/pkg/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidatePasswordStrength_Weak(t *testing.T) {
+	for password, want := range map[string]error{
+		"lowercase123": hash.ErrPasswordNoUpper,
+		"UPPERCASE123": hash.ErrPasswordNoLower,
+		"NoDigitsHere": hash.ErrPasswordNoDigit,
+		"":             hash.ErrPasswordNoUpper,
+	} {
+		assert.ErrorIs(t, hash.ValidatePasswordStrength(password), want, password)
+	}
+}
+
+func TestValidatePasswordStrength_Common(t *testing.T) {
+	for _, password := range []string{"Password123", "PASSword1", "Qwerty123", "P@ssw0rd", "Welcome1"} {
+		assert.ErrorIs(t, hash.ValidatePasswordStrength(password), hash.ErrPasswordTooCommon, password)
+	}
+}
+
+func TestValidatePasswordStrength_Strong(t *testing.T) {
+	for _, password := range []string{"SecurePass123", "correctHorse9Battery", "Zürich2Bern"} {
+		assert.NoError(t, hash.ValidatePasswordStrength(password), password)
+	}
+}
+
+func TestRegister_RejectsWeakPasswordWhenRequired(t *testing.T) {
+	ctx := context.Background()
+	config := service.DefaultConfig()
+	config.RequireStrongPasswords = true
+	userService := newTestUserService(t, config)
+
+	_, _, err := userService.Register(ctx, registerRequest("password123"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "uppercase")
+
+	user, _, err := userService.Register(ctx, registerRequest("SecurePass123"))
+	require.NoError(t, err)
+
+	weak := "Password123"
+	_, err = userService.UpdateUser(ctx, &service.UpdateUserRequest{ID: user.ID, Password: &weak})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "too common")
+}
+
+func TestCreateUser_AcceptsWeakPasswordWhenNotRequired(t *testing.T) {
+	userService := newTestUserService(t, service.DefaultConfig())
+
+	_, err := userService.CreateUser(context.Background(), &service.CreateUserRequest{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "password123",
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/handler"
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	pb "github.com/amirhasanpour/task-manager/user-service/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestBatchGetPublicProfiles_OnlyPublicFields(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, "public_profile")
+	userService, repo := newUserServiceOn(db, service.DefaultConfig()), repository.NewUserRepository(db)
+	userHandler := handler.NewUserHandler(userService)
+
+	alice, err := repo.Create(ctx, &model.User{Username: "alice", Email: "alice@example.com", Password: "hashedpassword", FullName: "Alice Liddell", Role: model.RoleAdmin})
+	require.NoError(t, err)
+	bob, err := repo.Create(ctx, &model.User{Username: "bob", Email: "bob@example.com", Password: "hashedpassword"})
+	require.NoError(t, err)
+
+	// Order follows the request; duplicates, unknown and malformed ids drop out
+	resp, err := userHandler.BatchGetPublicProfiles(ctx, &pb.BatchGetPublicProfilesRequest{
+		Ids: []string{bob.ID, uuid.New().String(), alice.ID, "not-a-uuid", bob.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Profiles, 2)
+	assert.Equal(t, "bob", resp.Profiles[0].Username)
+	assert.Equal(t, alice.ID, resp.Profiles[1].Id)
+	assert.Equal(t, "Alice Liddell", resp.Profiles[1].FullName)
+
+	encoded, err := protojson.Marshal(resp)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "example.com")
+	assert.NotContains(t, string(encoded), model.RoleAdmin)
+	assert.NotContains(t, string(encoded), "hashedpassword")
+}
+
+func TestBatchGetPublicProfiles_Limits(t *testing.T) {
+	config := service.DefaultConfig()
+	config.MaxBatchProfiles = 2
+	userService := newTestUserService(t, config)
+
+	_, err := userService.BatchGetPublicProfiles(context.Background(), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = userService.BatchGetPublicProfiles(context.Background(), []string{uuid.New().String(), uuid.New().String(), uuid.New().String()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGenerateTokenPair(t *testing.T) {
+	manager := auth.NewJWTManager(testJWTSecret, 1)
+	user := &model.User{ID: "user-1", Username: "testuser", Email: "test@example.com", Role: model.RoleAdmin}
+
+	tokens, err := manager.GenerateTokenPair(user)
+	require.NoError(t, err)
+
+	claims := &auth.Claims{}
+	_, _, err = jwt.NewParser().ParseUnverified(tokens.RefreshToken, claims)
+	require.NoError(t, err)
+	assert.Equal(t, auth.TokenTypeRefresh, claims.TokenType)
+	assert.NotEmpty(t, claims.ID)
+
+	refreshClaims, err := manager.VerifyRefresh(tokens.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, refreshClaims.UserID)
+
+	accessClaims, err := manager.Verify(tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, accessClaims.UserID)
+	assert.Equal(t, model.RoleAdmin, accessClaims.Role)
+}
+
+func TestTokenPair_TypesDoNotMix(t *testing.T) {
+	manager := auth.NewJWTManager(testJWTSecret, 1)
+
+	tokens, err := manager.GenerateTokenPair(&model.User{ID: "user-1"})
+	require.NoError(t, err)
+
+	_, err = manager.VerifyRefresh(tokens.AccessToken)
+	assert.Error(t, err)
+
+	_, err = manager.Verify(tokens.RefreshToken)
+	assert.Error(t, err)
+}
+
+func TestRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+
+	user, tokens, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	accessToken, err := userService.RefreshToken(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+
+	validated, err := userService.ValidateToken(ctx, accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, validated.ID)
+
+	_, err = userService.RefreshToken(ctx, tokens.AccessToken)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = userService.ValidateToken(ctx, tokens.RefreshToken)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRefreshToken_PicksUpRoleChange(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, "refresh_role")
+	userService := newUserServiceOn(db, service.DefaultConfig())
+
+	user, tokens, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&model.User{}).Where("id = ?", user.ID).Update("role", model.RoleAdmin).Error)
+
+	accessToken, err := userService.RefreshToken(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+
+	claims, err := auth.NewJWTManager(testJWTSecret, 1).Verify(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, model.RoleAdmin, claims.Role)
+
+	// And back again: a demoted admin stops getting admin tokens
+	require.NoError(t, db.Model(&model.User{}).Where("id = ?", user.ID).Update("role", model.RoleUser).Error)
+	accessToken, err = userService.RefreshToken(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+
+	claims, err = auth.NewJWTManager(testJWTSecret, 1).Verify(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, model.RoleUser, claims.Role)
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func registerRequest(password string) *service.RegisterRequest {
+	return &service.RegisterRequest{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: password,
+	}
+}
+
+func TestRegister_RetryIsRejectedByDefault(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+
+	user, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+	assert.Equal(t, model.RoleUser, user.Role)
+
+	_, _, err = userService.Register(ctx, registerRequest("password123"))
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestRegister_IdempotentRetry(t *testing.T) {
+	ctx := context.Background()
+	config := service.DefaultConfig()
+	config.IdempotentRegister = true
+	userService := newTestUserService(t, config)
+
+	first, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	retried, tokens, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, retried.ID)
+	assert.Empty(t, retried.Password)
+
+	validated, err := userService.ValidateToken(ctx, tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, validated.ID)
+}
+
+func TestRegister_IdempotentConflictingCredentials(t *testing.T) {
+	ctx := context.Background()
+	config := service.DefaultConfig()
+	config.IdempotentRegister = true
+	userService := newTestUserService(t, config)
+
+	_, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	_, _, err = userService.Register(ctx, registerRequest("different-password"))
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	otherUsername := registerRequest("password123")
+	otherUsername.Username = "someoneelse"
+	_, _, err = userService.Register(ctx, otherUsername)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
@@ -2,16 +2,23 @@ package tests
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/cache"
 	"github.com/amirhasanpour/task-manager/user-service/internal/model"
 	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+const testJWTSecret = "test-secret"
+
 type UserRepositoryTestSuite struct {
 	suite.Suite
 	db     *gorm.DB
@@ -24,11 +31,9 @@ func (suite *UserRepositoryTestSuite) SetupTest() {
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
 	assert.NoError(suite.T(), err)
 
-	// Create UUID extension (not needed for SQLite)
-	// db.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\"")
-
-	// Auto migrate
-	err = db.AutoMigrate(&model.User{})
+	err = createUsersTable(db)
+	assert.NoError(suite.T(), err)
+	err = createFeedTokensTable(db)
 	assert.NoError(suite.T(), err)
 
 	suite.db = db
@@ -36,6 +41,64 @@ func (suite *UserRepositoryTestSuite) SetupTest() {
 	suite.ctx = context.Background()
 }
 
+// createUsersTable creates the users table by hand: SQLite has no
+// uuid_generate_v4(), which the model uses as the column default. IDs come
+// from BeforeCreate.
+func createUsersTable(db *gorm.DB) error {
+	return db.Exec(`CREATE TABLE users (
+		id TEXT PRIMARY KEY,
+		username VARCHAR(100) NOT NULL UNIQUE,
+		email VARCHAR(100) NOT NULL UNIQUE,
+		password VARCHAR(255) NOT NULL,
+		full_name VARCHAR(200),
+		avatar_url VARCHAR(2048),
+		role VARCHAR(20) NOT NULL DEFAULT 'user',
+		created_at DATETIME,
+		updated_at DATETIME,
+		password_reset_at DATETIME
+	)`).Error
+}
+
+// createFeedTokensTable creates the feed_tokens table by hand for the same
+// reason as createUsersTable.
+func createFeedTokensTable(db *gorm.DB) error {
+	return db.Exec(`CREATE TABLE feed_tokens (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		name VARCHAR(100),
+		token_hash VARCHAR(64) NOT NULL UNIQUE,
+		last_used_at DATETIME,
+		created_at DATETIME
+	)`).Error
+}
+
+// newTestDB opens an in-memory database called name with the users and
+// feed_tokens tables, closed when the test ends. Names must be unique among
+// the databases open at once.
+func newTestDB(t *testing.T, name string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file:"+name+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, createUsersTable(db))
+	require.NoError(t, createFeedTokensTable(db))
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	})
+	return db
+}
+
+// newTestUserService returns an uncached user service over a fresh
+// database of its own.
+func newTestUserService(t *testing.T, config service.Config) service.UserService {
+	return newUserServiceOn(newTestDB(t, strings.ReplaceAll(t.Name(), "/", "_")), config)
+}
+
+// newUserServiceOn returns an uncached user service over db, for tests that
+// also reach into the database.
+func newUserServiceOn(db *gorm.DB, config service.Config) service.UserService {
+	return service.NewUserServiceWithConfig(repository.NewUserRepository(db), cache.NewNoopUserCache(), auth.NewJWTManager(testJWTSecret, 1), config)
+}
+
 func (suite *UserRepositoryTestSuite) TearDownTest() {
 	// Clean up
 	sqlDB, err := suite.db.DB()
@@ -149,12 +212,53 @@ func (suite *UserRepositoryTestSuite) TestListUsers() {
 	}
 
 	// List users with pagination
-	users, total, err := suite.repo.List(suite.ctx, 1, 10)
+	users, total, err := suite.repo.List(suite.ctx, nil, 1, 10)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), int64(5), total)
 	assert.Len(suite.T(), users, 5)
 }
 
+func (suite *UserRepositoryTestSuite) TestListUsers_Search() {
+	for _, user := range []*model.User{
+		{Username: "alice", Email: "alice@example.com", FullName: "Alice Liddell"},
+		{Username: "bob", Email: "bob@wonderland.org", FullName: "Bob Builder"},
+		{Username: "carol", Email: "carol@example.com", FullName: "Carol Wonder"},
+		{Username: "dave_x", Email: "dave@example.com", FullName: "Dave"},
+	} {
+		user.Password = "hashedpassword"
+		_, err := suite.repo.Create(suite.ctx, user)
+		suite.Require().NoError(err)
+	}
+
+	usernames := func(users []*model.User) []string {
+		var names []string
+		for _, user := range users {
+			names = append(names, user.Username)
+		}
+		return names
+	}
+
+	// Matches the email of one user and the full name of another, in any case
+	users, total, err := suite.repo.List(suite.ctx, &repository.UserFilter{Search: "WONDER", SortBy: "username"}, 1, 10)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Equal(suite.T(), []string{"bob", "carol"}, usernames(users))
+
+	users, total, err = suite.repo.List(suite.ctx, &repository.UserFilter{Search: "example.com", SortBy: "username", SortDesc: true}, 1, 2)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(3), total)
+	assert.Equal(suite.T(), []string{"dave_x", "carol"}, usernames(users))
+
+	// LIKE wildcards in the term match literally
+	users, _, err = suite.repo.List(suite.ctx, &repository.UserFilter{Search: "_x"}, 1, 10)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{"dave_x"}, usernames(users))
+
+	_, total, err = suite.repo.List(suite.ctx, &repository.UserFilter{Search: "nobody"}, 1, 10)
+	suite.Require().NoError(err)
+	assert.Zero(suite.T(), total)
+}
+
 func TestUserRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(UserRepositoryTestSuite))
 }
\ No newline at end of file
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/handler"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	pb "github.com/amirhasanpour/task-manager/user-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateUser_UpdateMask(t *testing.T) {
+	ctx := context.Background()
+
+	userService := newTestUserService(t, service.DefaultConfig())
+	userHandler := handler.NewUserHandler(userService)
+
+	user, _, err := userService.Register(ctx, &service.RegisterRequest{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "password",
+		FullName: "Test User",
+	})
+	require.NoError(t, err)
+
+	// Without a mask an empty full name means "not provided"
+	resp, err := userHandler.UpdateUser(ctx, &pb.UpdateUserRequest{Id: user.ID, FullName: ""})
+	require.NoError(t, err)
+	assert.Equal(t, "Test User", resp.User.FullName)
+
+	// With a mask it clears the field, and unmasked fields are left alone
+	resp, err = userHandler.UpdateUser(ctx, &pb.UpdateUserRequest{
+		Id:         user.ID,
+		Username:   "ignored",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"full_name"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", resp.User.FullName)
+	assert.Equal(t, "testuser", resp.User.Username)
+
+	stored, err := userService.GetUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "", stored.FullName)
+
+	tests := []struct {
+		name  string
+		paths []string
+	}{
+		{"empty username", []string{"username"}},
+		{"empty email", []string{"email"}},
+		{"empty password", []string{"password"}},
+		{"unknown path", []string{"role"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := userHandler.UpdateUser(ctx, &pb.UpdateUserRequest{
+				Id:         user.ID,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
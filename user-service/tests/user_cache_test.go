@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/auth"
+	"github.com/amirhasanpour/task-manager/user-service/internal/model"
+	"github.com/amirhasanpour/task-manager/user-service/internal/repository"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRepository counts FindByID calls that reach the database.
+type countingRepository struct {
+	repository.UserRepository
+	findByIDCalls int
+}
+
+func (r *countingRepository) FindByID(ctx context.Context, id string) (*model.User, error) {
+	r.findByIDCalls++
+	return r.UserRepository.FindByID(ctx, id)
+}
+
+type memoryUserCache struct {
+	users map[string]model.User
+}
+
+func (c *memoryUserCache) GetUser(ctx context.Context, id string) (*model.User, error) {
+	user, ok := c.users[id]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+func (c *memoryUserCache) SetUser(ctx context.Context, user *model.User) error {
+	c.users[user.ID] = *user
+	return nil
+}
+
+func (c *memoryUserCache) DeleteUser(ctx context.Context, id string) error {
+	delete(c.users, id)
+	return nil
+}
+
+func TestValidateTokenUsesUserCache(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &countingRepository{UserRepository: repository.NewUserRepository(newTestDB(t, "user_cache"))}
+	userCache := &memoryUserCache{users: map[string]model.User{}}
+	jwtManager := auth.NewJWTManager(testJWTSecret, 1)
+	userService := service.NewUserServiceWithConfig(repo, userCache, jwtManager, service.DefaultConfig())
+
+	user, err := repo.Create(ctx, &model.User{
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "hashedpassword",
+		FullName: "Test User",
+	})
+	require.NoError(t, err)
+	token, err := jwtManager.Generate(user)
+	require.NoError(t, err)
+
+	first, err := userService.ValidateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, repo.findByIDCalls)
+	assert.Empty(t, first.Password)
+	assert.Empty(t, userCache.users[user.ID].Password)
+
+	second, err := userService.ValidateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, repo.findByIDCalls, "second validation should be served from the cache")
+	assert.Equal(t, user.ID, second.ID)
+
+	// Updating the user drops the cached copy
+	newName := "Renamed User"
+	_, err = userService.UpdateUser(ctx, &service.UpdateUserRequest{ID: user.ID, FullName: &newName})
+	require.NoError(t, err)
+	assert.NotContains(t, userCache.users, user.ID)
+
+	third, err := userService.ValidateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed User", third.FullName)
+}
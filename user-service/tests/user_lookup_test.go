@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirhasanpour/task-manager/user-service/internal/handler"
+	"github.com/amirhasanpour/task-manager/user-service/internal/service"
+	pb "github.com/amirhasanpour/task-manager/user-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetUserByUsernameAndEmail(t *testing.T) {
+	ctx := context.Background()
+	userService := newTestUserService(t, service.DefaultConfig())
+	userHandler := handler.NewUserHandler(userService)
+
+	user, _, err := userService.Register(ctx, registerRequest("password123"))
+	require.NoError(t, err)
+
+	resp, err := userHandler.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{Username: "testuser"})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, resp.User.Id)
+	assert.Equal(t, "test@example.com", resp.User.Email)
+
+	resp, err = userHandler.GetUserByEmail(ctx, &pb.GetUserByEmailRequest{Email: " Test@Example.com "})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, resp.User.Id)
+
+	// The password never leaves the service
+	found, err := userService.GetUserByUsername(ctx, "testuser")
+	require.NoError(t, err)
+	assert.Empty(t, found.Password)
+}
+
+func TestGetUserByUsernameAndEmail_NotFound(t *testing.T) {
+	ctx := context.Background()
+	userHandler := handler.NewUserHandler(newTestUserService(t, service.DefaultConfig()))
+
+	_, err := userHandler.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{Username: "nobody"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = userHandler.GetUserByEmail(ctx, &pb.GetUserByEmailRequest{Email: "nobody@example.com"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = userHandler.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}